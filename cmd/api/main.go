@@ -14,6 +14,7 @@ import (
 	"ai-platform/internal/common"
 	"ai-platform/internal/database"
 	"ai-platform/internal/server"
+	"ai-platform/internal/worker"
 )
 
 func gracefulShutdown(apiServer *http.Server, done chan bool) {
@@ -46,6 +47,16 @@ func main() {
 		fx.Provide(database.New),
 		common.Module,
 		fx.Provide(server.NewServer),
+		fx.Invoke(func(outboxDispatcher *worker.OutboxDispatcher) {
+			// The server below blocks in its invoke, so the dispatcher is started here and not in a lifecycle hook
+			go outboxDispatcher.Run(context.Background())
+		}),
+		fx.Invoke(func(finetuneScheduler *worker.FinetuneScheduler) {
+			go finetuneScheduler.Run(context.Background())
+		}),
+		fx.Invoke(func(qualityScoringWorker *worker.QualityScoringWorker) {
+			go qualityScoringWorker.Run(context.Background())
+		}),
 		fx.Invoke(func(server *http.Server) {
 			// Create a done channel to signal when the shutdown is complete
 			done := make(chan bool, 1)
@@ -18,24 +18,55 @@ type FinetuneIndexData struct {
 	ProjectName string
 	FinetuneID  string
 	Finetune    FinetuneData
+	// Metrics is nil when they could not be loaded
+	Metrics *FinetuneMetricsData
 }
 
 type FinetuneData struct {
 	ID                               uuid.UUID              `json:"id"`
 	Version                          int                    `json:"version"`
 	Status                           string                 `json:"status"`
+	FailureReason                    *string                `json:"failure_reason,omitempty"`
+	Backend                          string                 `json:"backend"`
+	Priority                         string                 `json:"priority"`
+	QueuePosition                    *int                   `json:"queue_position,omitempty"`
 	BaseModelName                    string                 `json:"base_model_name"`
 	ModelName                        string                 `json:"model_name"`
 	TrainingDatasetID                uuid.UUID              `json:"training_dataset_id"`
 	TrainingDatasetNumberExamples    *int                   `json:"training_dataset_number_examples"`
 	TrainingDatasetSelectRandom      bool                   `json:"training_dataset_select_random"`
+	TrainingDatasetMinQualityScore   *float64               `json:"training_dataset_min_quality_score,omitempty"`
+	Hyperparameters                  *HyperparametersData   `json:"hyperparameters,omitempty"`
 	ModelSizeGB                      *int                   `json:"model_size_gb"`
 	ModelSizeParameter               *int                   `json:"model_size_parameter"`
 	ModelDtype                       *string                `json:"model_dtype"`
 	ModelQuantization                *string                `json:"model_quantization"`
+	ModelFileSizeBytes               *int64                 `json:"model_file_size_bytes,omitempty"`
+	ModelSHA256                      *string                `json:"model_sha256,omitempty"`
 	InferenceSamples                 []InferenceSample      `json:"inference_samples"`
 	TrainingTimeSeconds              *float64               `json:"training_time_seconds"`
 	DeploymentID                     *uuid.UUID             `json:"deployment_id,omitempty"`
+	DispatchEvents                   []DispatchEventData    `json:"dispatch_events"`
+}
+
+type DispatchEventData struct {
+	Type      string    `json:"type"`
+	Message   *string   `json:"message,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type HyperparametersData struct {
+	Epochs                    int      `json:"epochs"`
+	LearningRate              float64  `json:"learning_rate"`
+	BatchSize                 int      `json:"batch_size"`
+	GradientAccumulationSteps int      `json:"gradient_accumulation_steps"`
+	LoraRank                  int      `json:"lora_rank"`
+	LoraAlpha                 int      `json:"lora_alpha"`
+	LoraDropout               float64  `json:"lora_dropout"`
+	TargetModules             []string `json:"target_modules"`
+	MaxSeqLength              int      `json:"max_seq_length"`
+	WarmupSteps               int      `json:"warmup_steps"`
+	Seed                      int      `json:"seed"`
 }
 
 type InferenceSample struct {
@@ -95,11 +126,15 @@ func FinetuneIndexHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The metrics are optional, the page is still useful without them and they are streamed while training
+	metrics, _ := fetchFinetuneMetrics(r, token, projectID, finetuneID)
+
 	indexData := FinetuneIndexData{
 		ProjectID:   projectIDStr,
 		ProjectName: projectName,
 		FinetuneID:  finetuneIDStr,
 		Finetune:    *finetuneData,
+		Metrics:     metrics,
 	}
 
 	templ.Handler(FinetuneIndex(indexData)).ServeHTTP(w, r)
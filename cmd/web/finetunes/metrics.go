@@ -0,0 +1,152 @@
+package finetunes
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/cmd/web"
+)
+
+const metricsStreamInterval = 2 * time.Second
+
+type FinetuneMetricData struct {
+	Step         int      `json:"step"`
+	Epoch        float64  `json:"epoch"`
+	TrainLoss    *float64 `json:"train_loss,omitempty"`
+	EvalLoss     *float64 `json:"eval_loss,omitempty"`
+	LearningRate *float64 `json:"learning_rate,omitempty"`
+	GPUMemoryGB  *float64 `json:"gpu_memory_gb,omitempty"`
+}
+
+type FinetuneMetricsData struct {
+	Status           string               `json:"status"`
+	Metrics          []FinetuneMetricData `json:"metrics"`
+	InferenceSamples []InferenceSample    `json:"inference_samples"`
+}
+
+// LatestMetric returns the metric of the last reported step, nil before the first report
+func (d *FinetuneMetricsData) LatestMetric() *FinetuneMetricData {
+	if len(d.Metrics) == 0 {
+		return nil
+	}
+	return &d.Metrics[len(d.Metrics)-1]
+}
+
+// isFinetuneTraining returns true while the trainer reports metrics
+func isFinetuneTraining(status string) bool {
+	return status == "PLANNING" || status == "RUNNING"
+}
+
+// formatOptionalMetric renders a metric that the trainer may not report
+func formatOptionalMetric(value *float64, format string) string {
+	if value == nil {
+		return "-"
+	}
+	return fmt.Sprintf(format, *value)
+}
+
+// FinetuneMetricsStreamHandler streams the training metrics as server-sent events. Like the training dataset progress
+// stream, the handler polls the API because the browser can not send the token with an EventSource.
+func FinetuneMetricsStreamHandler(w http.ResponseWriter, r *http.Request) {
+	token := web.GetTokenFromCookie(r)
+	if token == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract project ID and finetune ID from URL path
+	// Expected format: /web/projects/{project_id}/finetunes/{finetune_id}/metrics/stream
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 8 || pathParts[3] == "" || pathParts[5] == "" {
+		http.Error(w, "Invalid URL format", http.StatusBadRequest)
+		return
+	}
+
+	projectID, err := uuid.Parse(pathParts[3])
+	if err != nil {
+		http.Error(w, "Invalid project ID format", http.StatusBadRequest)
+		return
+	}
+
+	finetuneID, err := uuid.Parse(pathParts[5])
+	if err != nil {
+		http.Error(w, "Invalid finetune ID format", http.StatusBadRequest)
+		return
+	}
+
+	// The stream outlives the write timeout of the server
+	responseController := http.NewResponseController(w)
+	if err := responseController.SetWriteDeadline(time.Time{}); err != nil {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	ticker := time.NewTicker(metricsStreamInterval)
+	defer ticker.Stop()
+
+	for {
+		metrics, err := fetchFinetuneMetrics(r, token, projectID, finetuneID)
+		if err != nil {
+			fmt.Fprintf(w, "event: metrics-error\ndata: %q\n\n", "Failed to load metrics")
+			responseController.Flush()
+			return
+		}
+
+		data, err := json.Marshal(metrics)
+		if err != nil {
+			return
+		}
+		fmt.Fprintf(w, "event: metrics\ndata: %s\n\n", data)
+
+		if !isFinetuneTraining(metrics.Status) {
+			fmt.Fprintf(w, "event: done\ndata: %q\n\n", metrics.Status)
+			responseController.Flush()
+			return
+		}
+		responseController.Flush()
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func fetchFinetuneMetrics(r *http.Request, token string, projectID uuid.UUID, finetuneID uuid.UUID) (*FinetuneMetricsData, error) {
+	apiBaseURL := web.GetAPIBaseURL(r)
+
+	req, err := http.NewRequestWithContext(r.Context(), "GET", fmt.Sprintf("%s/api/projects/%s/finetunes/%s/metrics", apiBaseURL, projectID, finetuneID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var metrics FinetuneMetricsData
+	if err := json.NewDecoder(resp.Body).Decode(&metrics); err != nil {
+		return nil, err
+	}
+
+	return &metrics, nil
+}
@@ -0,0 +1,255 @@
+package prompts
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/google/uuid"
+
+	"ai-platform/cmd/web"
+)
+
+type PromptsIndexData struct {
+	ProjectID   string
+	ProjectName string
+	Prompts     []PromptData
+	// Diff is set when two versions were selected for comparison
+	Diff *PromptDiffData
+}
+
+type PromptTrainingDatasetData struct {
+	TrainingDatasetID      string `json:"training_dataset_id"`
+	TrainingDatasetVersion int    `json:"training_dataset_version"`
+	Status                 string `json:"status"`
+	Generated              bool   `json:"generated"`
+	Items                  int    `json:"items"`
+}
+
+type PromptData struct {
+	ID               string                      `json:"id"`
+	Version          int                         `json:"version"`
+	PreviousPromptID *string                     `json:"previous_prompt_id,omitempty"`
+	Text             string                      `json:"text"`
+	CreatedAt        time.Time                   `json:"created_at"`
+	TrainingDatasets []PromptTrainingDatasetData `json:"training_datasets"`
+}
+
+type PromptDiffLineData struct {
+	Operation string `json:"operation"`
+	Text      string `json:"text"`
+}
+
+type PromptDiffData struct {
+	From  PromptData           `json:"from"`
+	To    PromptData           `json:"to"`
+	Lines []PromptDiffLineData `json:"lines"`
+}
+
+// latestPromptText is the text the next version is edited from, the prompts are ordered the latest first
+func latestPromptText(prompts []PromptData) string {
+	if len(prompts) == 0 {
+		return ""
+	}
+	return prompts[0].Text
+}
+
+// promptDiffFromID preselects the compared version, without a diff the version before the latest
+func promptDiffFromID(data PromptsIndexData) string {
+	if data.Diff != nil {
+		return data.Diff.From.ID
+	}
+	if len(data.Prompts) > 1 {
+		return data.Prompts[1].ID
+	}
+	return ""
+}
+
+// promptDiffToID preselects the version compared with, without a diff the latest version
+func promptDiffToID(data PromptsIndexData) string {
+	if data.Diff != nil {
+		return data.Diff.To.ID
+	}
+	if len(data.Prompts) > 0 {
+		return data.Prompts[0].ID
+	}
+	return ""
+}
+
+func promptDiffMarker(operation string) string {
+	switch operation {
+	case "insert":
+		return "+ "
+	case "delete":
+		return "- "
+	default:
+		return "  "
+	}
+}
+
+// promptUsageDescription describes how a training dataset uses a prompt version
+func promptUsageDescription(usage PromptTrainingDatasetData) string {
+	if usage.Generated {
+		return fmt.Sprintf("generated with this version, %d items", usage.Items)
+	}
+	return fmt.Sprintf("%d items copied from an earlier version", usage.Items)
+}
+
+func PromptsIndexHandler(w http.ResponseWriter, r *http.Request) {
+	token := web.GetTokenFromCookie(r)
+	if token == "" {
+		http.Redirect(w, r, "/web/login", http.StatusSeeOther)
+		return
+	}
+
+	// Extract project ID from URL path
+	// Expected format: /web/projects/{project_id}/prompts?from={prompt_id}&to={prompt_id}
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 5 || pathParts[3] == "" {
+		http.Error(w, "Invalid URL format", http.StatusBadRequest)
+		return
+	}
+
+	projectIDStr := pathParts[3]
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		http.Error(w, "Invalid project ID format", http.StatusBadRequest)
+		return
+	}
+
+	prompts, err := fetchPrompts(r, token, projectID)
+	if err != nil {
+		web.ClearTokenCookie(w)
+		http.Redirect(w, r, "/web/login", http.StatusSeeOther)
+		return
+	}
+
+	projectName, err := fetchProjectName(r, token, projectID)
+	if err != nil {
+		web.ClearTokenCookie(w)
+		http.Redirect(w, r, "/web/login", http.StatusSeeOther)
+		return
+	}
+
+	indexData := PromptsIndexData{
+		ProjectID:   projectIDStr,
+		ProjectName: projectName,
+		Prompts:     prompts,
+	}
+
+	fromStr, toStr := r.URL.Query().Get("from"), r.URL.Query().Get("to")
+	if fromStr != "" && toStr != "" {
+		fromPromptID, err := uuid.Parse(fromStr)
+		if err != nil {
+			http.Error(w, "Invalid prompt ID format", http.StatusBadRequest)
+			return
+		}
+		toPromptID, err := uuid.Parse(toStr)
+		if err != nil {
+			http.Error(w, "Invalid prompt ID format", http.StatusBadRequest)
+			return
+		}
+
+		indexData.Diff, err = fetchPromptDiff(r, token, projectID, fromPromptID, toPromptID)
+		if err != nil {
+			http.Error(w, "Failed to compare prompts", http.StatusBadGateway)
+			return
+		}
+	}
+
+	templ.Handler(PromptsIndex(indexData)).ServeHTTP(w, r)
+}
+
+func fetchPrompts(r *http.Request, token string, projectID uuid.UUID) ([]PromptData, error) {
+	apiBaseURL := web.GetAPIBaseURL(r)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/projects/%s/prompts", apiBaseURL, projectID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var response struct {
+		Prompts []PromptData `json:"prompts"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+
+	return response.Prompts, nil
+}
+
+func fetchPromptDiff(r *http.Request, token string, projectID uuid.UUID, fromPromptID uuid.UUID, toPromptID uuid.UUID) (*PromptDiffData, error) {
+	apiBaseURL := web.GetAPIBaseURL(r)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/projects/%s/prompts/%s/diff/%s", apiBaseURL, projectID, fromPromptID, toPromptID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var diff PromptDiffData
+	if err := json.NewDecoder(resp.Body).Decode(&diff); err != nil {
+		return nil, err
+	}
+
+	return &diff, nil
+}
+
+func fetchProjectName(r *http.Request, token string, projectID uuid.UUID) (string, error) {
+	apiBaseURL := web.GetAPIBaseURL(r)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/projects/%s", apiBaseURL, projectID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var project struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&project); err != nil {
+		return "", err
+	}
+
+	return project.Name, nil
+}
@@ -0,0 +1,147 @@
+package training_datasets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/google/uuid"
+
+	"ai-platform/cmd/web"
+)
+
+type TrainingDatasetDiffPageData struct {
+	ProjectID   string
+	ProjectName string
+	Diff        TrainingDatasetDiffData
+}
+
+type TrainingDatasetDiffVersionData struct {
+	ID         string   `json:"id"`
+	Version    int      `json:"version"`
+	FieldNames []string `json:"field_names"`
+}
+
+type TrainingDatasetDiffItemData struct {
+	ID       string                    `json:"id"`
+	Values   []string                  `json:"values"`
+	Messages []ConversationMessageData `json:"messages,omitempty"`
+}
+
+type TrainingDatasetDiffChangeData struct {
+	From          TrainingDatasetDiffItemData `json:"from"`
+	To            TrainingDatasetDiffItemData `json:"to"`
+	ChangedFields []string                    `json:"changed_fields"`
+}
+
+type TrainingDatasetDiffData struct {
+	From              TrainingDatasetDiffVersionData  `json:"from"`
+	To                TrainingDatasetDiffVersionData  `json:"to"`
+	FieldNamesAdded   []string                        `json:"field_names_added"`
+	FieldNamesRemoved []string                        `json:"field_names_removed"`
+	FieldOrderChanged bool                            `json:"field_order_changed"`
+	Added             []TrainingDatasetDiffItemData   `json:"added"`
+	Removed           []TrainingDatasetDiffItemData   `json:"removed"`
+	Modified          []TrainingDatasetDiffChangeData `json:"modified"`
+	Corrected         []TrainingDatasetDiffChangeData `json:"corrected"`
+	Unchanged         int                             `json:"unchanged"`
+}
+
+// fieldValue returns the value of a field by name, or an empty string if the version does not have the field
+func fieldValue(fieldNames []string, values []string, fieldName string) string {
+	for i, name := range fieldNames {
+		if name == fieldName && i < len(values) {
+			return values[i]
+		}
+	}
+	return ""
+}
+
+func TrainingDatasetDiffHandler(w http.ResponseWriter, r *http.Request) {
+	token := web.GetTokenFromCookie(r)
+	if token == "" {
+		http.Redirect(w, r, "/web/login", http.StatusSeeOther)
+		return
+	}
+
+	// Extract project ID and both training dataset IDs from URL path
+	// Expected format: /web/projects/{project_id}/training-datasets/{training_dataset_id}/diff/{other_training_dataset_id}
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 8 || pathParts[3] == "" || pathParts[5] == "" || pathParts[7] == "" {
+		http.Error(w, "Invalid URL format", http.StatusBadRequest)
+		return
+	}
+
+	projectIDStr := pathParts[3]
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		http.Error(w, "Invalid project ID format", http.StatusBadRequest)
+		return
+	}
+
+	fromTrainingDatasetID, err := uuid.Parse(pathParts[5])
+	if err != nil {
+		http.Error(w, "Invalid training dataset ID format", http.StatusBadRequest)
+		return
+	}
+
+	toTrainingDatasetID, err := uuid.Parse(pathParts[7])
+	if err != nil {
+		http.Error(w, "Invalid training dataset ID format", http.StatusBadRequest)
+		return
+	}
+
+	diff, err := fetchTrainingDatasetDiff(r, token, projectID, fromTrainingDatasetID, toTrainingDatasetID)
+	if err != nil {
+		web.ClearTokenCookie(w)
+		http.Redirect(w, r, "/web/login", http.StatusSeeOther)
+		return
+	}
+
+	projectName, err := fetchProjectName(r, token, projectID)
+	if err != nil {
+		web.ClearTokenCookie(w)
+		http.Redirect(w, r, "/web/login", http.StatusSeeOther)
+		return
+	}
+
+	pageData := TrainingDatasetDiffPageData{
+		ProjectID:   projectIDStr,
+		ProjectName: projectName,
+		Diff:        *diff,
+	}
+
+	templ.Handler(TrainingDatasetDiff(pageData)).ServeHTTP(w, r)
+}
+
+func fetchTrainingDatasetDiff(r *http.Request, token string, projectID uuid.UUID, fromTrainingDatasetID uuid.UUID, toTrainingDatasetID uuid.UUID) (*TrainingDatasetDiffData, error) {
+	apiBaseURL := web.GetAPIBaseURL(r)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/projects/%s/training-datasets/%s/diff/%s", apiBaseURL, projectID, fromTrainingDatasetID, toTrainingDatasetID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var diff TrainingDatasetDiffData
+	if err := json.NewDecoder(resp.Body).Decode(&diff); err != nil {
+		return nil, err
+	}
+
+	return &diff, nil
+}
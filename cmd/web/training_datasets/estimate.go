@@ -0,0 +1,136 @@
+package training_datasets
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/google/uuid"
+
+	"ai-platform/cmd/web"
+)
+
+type TrainingDatasetEstimateData struct {
+	GenerationRequests    int     `json:"generation_requests"`
+	TokensIn              int     `json:"tokens_in"`
+	TokensOut             int     `json:"tokens_out"`
+	GenerationTimeSeconds float64 `json:"generation_time_seconds"`
+	GPUPricePerHour       float64 `json:"gpu_price_per_hour"`
+	CostUSD               float64 `json:"cost_usd"`
+	HistoricalDatasets    int     `json:"historical_datasets"`
+}
+
+// TrainingDatasetEstimateHandler renders the estimate for the step 4 form, it is loaded via htmx whenever the number
+// of examples or the GPU price changes
+func TrainingDatasetEstimateHandler(w http.ResponseWriter, r *http.Request) {
+	token := web.GetTokenFromCookie(r)
+	if token == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract project ID from URL path
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 4 || pathParts[3] == "" {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	projectID, err := uuid.Parse(pathParts[3])
+	if err != nil {
+		http.Error(w, "Invalid project ID format", http.StatusBadRequest)
+		return
+	}
+
+	examplesCount, err := strconv.Atoi(r.FormValue("examples_count"))
+	if err != nil || examplesCount < 1 || examplesCount > 1000 {
+		w.Write([]byte(`<div class="text-sm text-gray-500">Enter a number of examples between 1 and 1000 to see an estimate</div>`))
+		return
+	}
+
+	expectedOutputSizeChars, err := strconv.Atoi(r.FormValue("expected_output_size_chars"))
+	if err != nil || expectedOutputSizeChars < 1 {
+		w.Write([]byte(`<div class="text-sm text-gray-500">No estimate without a valid expected output size</div>`))
+		return
+	}
+
+	var jsonFieldsMap map[string]string
+	if err := json.Unmarshal([]byte(r.FormValue("json_object_fields")), &jsonFieldsMap); err != nil {
+		w.Write([]byte(`<div class="text-sm text-gray-500">No estimate without valid JSON object fields</div>`))
+		return
+	}
+
+	// An empty price leaves the price to the server configuration
+	var gpuPricePerHour *float64
+	if value := strings.TrimSpace(r.FormValue("gpu_price_per_hour")); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || parsed < 0 {
+			w.Write([]byte(`<div class="text-sm text-gray-500">Enter a valid GPU price per hour to see an estimate</div>`))
+			return
+		}
+		gpuPricePerHour = &parsed
+	}
+
+	estimateReq := struct {
+		CorpusName              string            `json:"corpus_name"`
+		JSONObjectFields        map[string]string `json:"json_object_fields"`
+		ExpectedOutputSizeChars int               `json:"expected_output_size_chars"`
+		GeneratePrompt          string            `json:"generate_prompt"`
+		GenerateExamplesNumber  int               `json:"generate_examples_number"`
+		GPUPricePerHour         *float64          `json:"gpu_price_per_hour,omitempty"`
+	}{
+		CorpusName:              r.FormValue("corpus"),
+		JSONObjectFields:        jsonFieldsMap,
+		ExpectedOutputSizeChars: expectedOutputSizeChars,
+		GeneratePrompt:          r.FormValue("prompt"),
+		GenerateExamplesNumber:  examplesCount,
+		GPUPricePerHour:         gpuPricePerHour,
+	}
+
+	estimate, err := fetchTrainingDatasetEstimate(r, token, projectID, estimateReq)
+	if err != nil {
+		w.Write([]byte(`<div class="text-sm text-gray-500">The estimate is not available</div>`))
+		return
+	}
+
+	templ.Handler(TrainingDatasetEstimate(*estimate)).ServeHTTP(w, r)
+}
+
+func fetchTrainingDatasetEstimate(r *http.Request, token string, projectID uuid.UUID, estimateReq interface{}) (*TrainingDatasetEstimateData, error) {
+	jsonData, err := json.Marshal(estimateReq)
+	if err != nil {
+		return nil, err
+	}
+
+	apiBaseURL := web.GetAPIBaseURL(r)
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/projects/%s/training-datasets/estimate", apiBaseURL, projectID), bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var estimate TrainingDatasetEstimateData
+	if err := json.NewDecoder(resp.Body).Decode(&estimate); err != nil {
+		return nil, err
+	}
+
+	return &estimate, nil
+}
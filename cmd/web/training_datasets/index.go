@@ -22,10 +22,14 @@ type TrainingDatasetIndexData struct {
 	TrainingDatasetID   string
 	TrainingDataset     TrainingDatasetData
 	TotalDataItems      int
+	Stats               *TrainingDatasetStatsData
+	Progress            *TrainingDatasetProgressData
+	PartialResults      *TrainingDatasetPartialResultsData
 }
 
 type TrainingDatasetData struct {
 	Version                int         `json:"version"`
+	PreviousVersionID      *string     `json:"previous_version_id,omitempty"`
 	GeneratePrompt         string      `json:"generate_prompt"`
 	InputField             string      `json:"input_field"`
 	OutputField            string      `json:"output_field"`
@@ -33,10 +37,69 @@ type TrainingDatasetData struct {
 	CorpusName             string      `json:"corpus_name"`
 	LanguageISO            string      `json:"language_iso"`
 	Status                 string      `json:"status"`
+	FailureReason          *string     `json:"failure_reason,omitempty"`
+	ExtendsTrainingDatasetID *string   `json:"extends_training_dataset_id,omitempty"`
+	GeneratePromptVersion  int         `json:"generate_prompt_version"`
 	FieldNames             []string    `json:"field_names"`
 	TokensIn               *int        `json:"tokens_in,omitempty"`
 	TokensOut              *int        `json:"tokens_out,omitempty"`
 	DataItemsSample        [][]string  `json:"data_items_sample"`
+	Type                   string      `json:"type"`
+	Conversation           *ConversationConfigData `json:"conversation,omitempty"`
+	ConversationsSample    [][]ConversationMessageData `json:"conversations_sample,omitempty"`
+}
+
+type ConversationConfigData struct {
+	SystemPrompt string `json:"system_prompt"`
+	Turns        int    `json:"turns"`
+}
+
+type ConversationMessageData struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// HasItems tells whether the dataset has items to show in the table
+func (d TrainingDatasetData) HasItems() bool {
+	return len(d.DataItemsSample) > 0 || len(d.ConversationsSample) > 0
+}
+
+type DistributionData struct {
+	Count int     `json:"count"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Mean  float64 `json:"mean"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P99   float64 `json:"p99"`
+}
+
+type TrainingDatasetFieldStatsData struct {
+	FieldName   string           `json:"field_name"`
+	EmptyValues int              `json:"empty_values"`
+	Characters  DistributionData `json:"characters"`
+	Tokens      DistributionData `json:"tokens"`
+}
+
+type TrainingDatasetStatsData struct {
+	TotalItems                 int                             `json:"total_items"`
+	ActiveItems                int                             `json:"active_items"`
+	DeletedItems               int                             `json:"deleted_items"`
+	CorrectedItems             int                             `json:"corrected_items"`
+	Fields                     []TrainingDatasetFieldStatsData `json:"fields"`
+	SourceDocuments            int                             `json:"source_documents"`
+	ItemsWithoutSourceDocument int                             `json:"items_without_source_document"`
+	ItemsPerSourceDocument     DistributionData                `json:"items_per_source_document"`
+	LanguageISO                string                          `json:"language_iso"`
+	LanguageChecked            bool                            `json:"language_checked"`
+	LanguageUndetectedItems    int                             `json:"language_undetected_items"`
+	LanguageMismatchItemIDs    []string                        `json:"language_mismatch_item_ids"`
+	GenerationTimeSeconds      DistributionData                `json:"generation_time_seconds"`
+}
+
+// formatDistribution renders min / p50 / p90 / max of a distribution with the given number format
+func formatDistribution(distribution DistributionData, format string) string {
+	return fmt.Sprintf(format+" / "+format+" / "+format+" / "+format, distribution.Min, distribution.P50, distribution.P90, distribution.Max)
 }
 
 func TrainingDatasetIndexHandler(w http.ResponseWriter, r *http.Request) {
@@ -89,12 +152,29 @@ func TrainingDatasetIndexHandler(w http.ResponseWriter, r *http.Request) {
 	// Calculate total data items (for display purposes, we use the GenerateExamplesNumber)
 	totalDataItems := trainingDatasetData.GenerateExamplesNumber
 
+	// The stats are optional, the page is still useful without them
+	var stats *TrainingDatasetStatsData
+	if trainingDatasetData.Status == "DONE" {
+		stats, _ = fetchTrainingDatasetStats(r, token, projectID, trainingDatasetID)
+	}
+
+	// The progress and the preview of the generated items are optional as well, the progress is streamed afterwards
+	var progress *TrainingDatasetProgressData
+	var partialResults *TrainingDatasetPartialResultsData
+	if isTrainingDatasetGenerating(trainingDatasetData.Status) {
+		progress, _ = fetchTrainingDatasetProgress(r, token, projectID, trainingDatasetID)
+		partialResults, _ = fetchTrainingDatasetPartialResults(r, token, projectID, trainingDatasetID)
+	}
+
 	indexData := TrainingDatasetIndexData{
 		ProjectID:           projectIDStr,
 		ProjectName:         projectName,
 		TrainingDatasetID:   trainingDatasetIDStr,
 		TrainingDataset:     *trainingDatasetData,
 		TotalDataItems:      totalDataItems,
+		Stats:               stats,
+		Progress:            progress,
+		PartialResults:      partialResults,
 	}
 
 	templ.Handler(TrainingDatasetIndex(indexData)).ServeHTTP(w, r)
@@ -129,6 +209,35 @@ func fetchTrainingDatasetData(r *http.Request, token string, projectID uuid.UUID
 	return &trainingDataset, nil
 }
 
+func fetchTrainingDatasetStats(r *http.Request, token string, projectID uuid.UUID, trainingDatasetID uuid.UUID) (*TrainingDatasetStatsData, error) {
+	apiBaseURL := web.GetAPIBaseURL(r)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/projects/%s/training-datasets/%s/stats", apiBaseURL, projectID, trainingDatasetID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var stats TrainingDatasetStatsData
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
 func CreateFinetuneHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -160,6 +269,8 @@ func CreateFinetuneHandler(w http.ResponseWriter, r *http.Request) {
 	examplesCountStr := r.FormValue("examples-count")
 	randomSelection := r.FormValue("random-selection") == "on"
 	trainingDatasetIDStr := r.FormValue("training-dataset-id")
+	minQualityScoreStr := r.FormValue("min-quality-score")
+	redactPII := r.FormValue("redact-pii") == "on"
 
 	// Validate required fields
 	if baseModel == "" || examplesCountStr == "" || trainingDatasetIDStr == "" {
@@ -179,17 +290,43 @@ func CreateFinetuneHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var minQualityScore *float64
+	if minQualityScoreStr != "" {
+		score, err := strconv.ParseFloat(minQualityScoreStr, 64)
+		if err != nil {
+			w.Write([]byte(`<div class="bg-red-50 border border-red-200 text-red-700 px-4 py-3 rounded">Please enter a valid minimum quality score</div>`))
+			return
+		}
+		minQualityScore = &score
+	}
+
+	hyperparameters, err := hyperparametersFromForm(r)
+	if err != nil {
+		w.Write([]byte(fmt.Sprintf(`<div class="bg-red-50 border border-red-200 text-red-700 px-4 py-3 rounded">%s</div>`, err.Error())))
+		return
+	}
+
 	// Create finetune request
 	createReq := struct {
 		BaseModelName                    string    `json:"base_model_name"`
 		TrainingDatasetID                uuid.UUID `json:"training_dataset_id"`
 		TrainingDatasetNumberExamples    int       `json:"training_dataset_number_examples"`
 		TrainingDatasetSelectRandom      bool      `json:"training_dataset_select_random"`
+		TrainingDatasetMinQualityScore   *float64  `json:"training_dataset_min_quality_score,omitempty"`
+		RedactPII                        bool      `json:"redact_pii"`
+		Hyperparameters                  map[string]interface{} `json:"hyperparameters,omitempty"`
+		Backend                          string    `json:"backend,omitempty"`
+		Priority                         string    `json:"priority,omitempty"`
 	}{
 		BaseModelName:                 baseModel,
 		TrainingDatasetID:             trainingDatasetID,
 		TrainingDatasetNumberExamples: examplesCount,
 		TrainingDatasetSelectRandom:   randomSelection,
+		TrainingDatasetMinQualityScore: minQualityScore,
+		RedactPII:                      redactPII,
+		Hyperparameters:                hyperparameters,
+		Backend:                        r.FormValue("backend"),
+		Priority:                       r.FormValue("priority"),
 	}
 
 	jsonData, err := json.Marshal(createReq)
@@ -238,5 +375,43 @@ func CreateFinetuneHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Success response
-	w.Write([]byte(`<div class="bg-green-50 border border-green-200 text-green-700 px-4 py-3 rounded">Fine-tuning started successfully! <a href="/web/home" class="underline">Check status on home page</a></div>`))
-}
\ No newline at end of file
+	w.Write([]byte(`<div class="bg-green-50 border border-green-200 text-green-700 px-4 py-3 rounded">Fine-tuning queued successfully! <a href="/web/home" class="underline">Check status on home page</a></div>`))
+}
+
+// hyperparametersFromForm collects the filled in advanced settings of the finetune form, empty fields keep the defaults
+func hyperparametersFromForm(r *http.Request) (map[string]interface{}, error) {
+	hyperparameters := make(map[string]interface{})
+	for _, name := range []string{"epochs", "batch_size", "gradient_accumulation_steps", "lora_rank", "lora_alpha", "max_seq_length", "warmup_steps", "seed"} {
+		value := strings.TrimSpace(r.FormValue(name))
+		if value == "" {
+			continue
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("Please enter a whole number for %s", strings.ReplaceAll(name, "_", " "))
+		}
+		hyperparameters[name] = number
+	}
+	for _, name := range []string{"learning_rate", "lora_dropout"} {
+		value := strings.TrimSpace(r.FormValue(name))
+		if value == "" {
+			continue
+		}
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Please enter a number for %s", strings.ReplaceAll(name, "_", " "))
+		}
+		hyperparameters[name] = number
+	}
+	if value := strings.TrimSpace(r.FormValue("target_modules")); value != "" {
+		var modules []string
+		for _, module := range strings.Split(value, ",") {
+			if module = strings.TrimSpace(module); module != "" {
+				modules = append(modules, module)
+			}
+		}
+		hyperparameters["target_modules"] = modules
+	}
+
+	return hyperparameters, nil
+}
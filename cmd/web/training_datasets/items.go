@@ -0,0 +1,138 @@
+package training_datasets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/google/uuid"
+
+	"ai-platform/cmd/web"
+)
+
+const trainingDataItemsPageSize = 25
+
+type TrainingDataItemData struct {
+	ID             string                    `json:"id"`
+	Values         []string                  `json:"values"`
+	Messages       []ConversationMessageData `json:"messages,omitempty"`
+	CorrectsID     *string                   `json:"corrects_id,omitempty"`
+	SourceDocument *string                   `json:"source_document,omitempty"`
+	Deleted        bool                      `json:"deleted"`
+}
+
+type TrainingDataItemsPageData struct {
+	FieldNames []string               `json:"field_names"`
+	Items      []TrainingDataItemData `json:"items"`
+	TotalItems int                    `json:"total_items"`
+	NextCursor *string                `json:"next_cursor"`
+}
+
+// itemColumns returns the number of columns of the items table, conversations have a single column for their messages
+func itemColumns(fieldNames []string) int {
+	if len(fieldNames) == 0 {
+		return 2
+	}
+	return len(fieldNames) + 1
+}
+
+type TrainingDataItemsRowsData struct {
+	Page TrainingDataItemsPageData
+	// FirstPage is set when the rows replace the table instead of being appended to it
+	FirstPage bool
+	// NextURL loads the next page with the same filters
+	NextURL string
+}
+
+// TrainingDataItemsHandler renders one page of table rows for the items of a training dataset, it is loaded via htmx
+func TrainingDataItemsHandler(w http.ResponseWriter, r *http.Request) {
+	token := web.GetTokenFromCookie(r)
+	if token == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract project ID and training dataset ID from URL path
+	// Expected format: /web/projects/{project_id}/training-datasets/{training_dataset_id}/items
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 7 || pathParts[3] == "" || pathParts[5] == "" {
+		http.Error(w, "Invalid URL format", http.StatusBadRequest)
+		return
+	}
+
+	projectID, err := uuid.Parse(pathParts[3])
+	if err != nil {
+		http.Error(w, "Invalid project ID format", http.StatusBadRequest)
+		return
+	}
+
+	trainingDatasetID, err := uuid.Parse(pathParts[5])
+	if err != nil {
+		http.Error(w, "Invalid training dataset ID format", http.StatusBadRequest)
+		return
+	}
+
+	// The filters are passed through to the API, the cursor selects the page
+	query := url.Values{}
+	for _, name := range []string{"q", "deleted", "corrected", "source_document"} {
+		if value := strings.TrimSpace(r.URL.Query().Get(name)); value != "" {
+			query.Set(name, value)
+		}
+	}
+	query.Set("page_size", fmt.Sprintf("%d", trainingDataItemsPageSize))
+
+	cursor := r.URL.Query().Get("cursor")
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+
+	page, err := fetchTrainingDataItems(r, token, projectID, trainingDatasetID, query)
+	if err != nil {
+		http.Error(w, "Failed to load training data items", http.StatusBadGateway)
+		return
+	}
+
+	rowsData := TrainingDataItemsRowsData{
+		Page:      *page,
+		FirstPage: cursor == "",
+	}
+	if page.NextCursor != nil {
+		query.Set("cursor", *page.NextCursor)
+		rowsData.NextURL = r.URL.Path + "?" + query.Encode()
+	}
+
+	templ.Handler(TrainingDataItemsRows(rowsData)).ServeHTTP(w, r)
+}
+
+func fetchTrainingDataItems(r *http.Request, token string, projectID uuid.UUID, trainingDatasetID uuid.UUID, query url.Values) (*TrainingDataItemsPageData, error) {
+	apiBaseURL := web.GetAPIBaseURL(r)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/projects/%s/training-datasets/%s/items?%s", apiBaseURL, projectID, trainingDatasetID, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var page TrainingDataItemsPageData
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
@@ -0,0 +1,184 @@
+package training_datasets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/cmd/web"
+)
+
+const (
+	partialResultsPreviewLimit = 5
+	progressStreamInterval     = 2 * time.Second
+)
+
+type TrainingDatasetProgressValuesData struct {
+	ItemsGenerated  int      `json:"items_generated"`
+	ChunksProcessed int      `json:"chunks_processed"`
+	ChunksTotal     *int     `json:"chunks_total,omitempty"`
+	TokensIn        int      `json:"tokens_in"`
+	TokensOut       int      `json:"tokens_out"`
+	EtaSeconds      *float64 `json:"eta_seconds,omitempty"`
+}
+
+type TrainingDatasetProgressData struct {
+	Status                 string                             `json:"status"`
+	GenerateExamplesNumber int                                `json:"generate_examples_number"`
+	CompletedFraction      float64                            `json:"completed_fraction"`
+	Progress               *TrainingDatasetProgressValuesData `json:"progress"`
+}
+
+type TrainingDatasetPartialResultsData struct {
+	FieldNames     []string               `json:"field_names"`
+	ItemsGenerated int                    `json:"items_generated"`
+	Items          []TrainingDataItemData `json:"items"`
+}
+
+// isTrainingDatasetGenerating returns true while the dataset reports progress
+func isTrainingDatasetGenerating(status string) bool {
+	return status == "PLANNING" || status == "RUNNING"
+}
+
+// formatEta renders the remaining seconds as minutes and seconds
+func formatEta(etaSeconds *float64) string {
+	if etaSeconds == nil {
+		return "unknown"
+	}
+	return (time.Duration(*etaSeconds) * time.Second).Round(time.Second).String()
+}
+
+// TrainingDatasetProgressStreamHandler streams the generation progress as server-sent events. The browser can not
+// send the token with an EventSource, so the handler polls the API and forwards the progress until the generation
+// has finished.
+func TrainingDatasetProgressStreamHandler(w http.ResponseWriter, r *http.Request) {
+	token := web.GetTokenFromCookie(r)
+	if token == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract project ID and training dataset ID from URL path
+	// Expected format: /web/projects/{project_id}/training-datasets/{training_dataset_id}/progress/stream
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 8 || pathParts[3] == "" || pathParts[5] == "" {
+		http.Error(w, "Invalid URL format", http.StatusBadRequest)
+		return
+	}
+
+	projectID, err := uuid.Parse(pathParts[3])
+	if err != nil {
+		http.Error(w, "Invalid project ID format", http.StatusBadRequest)
+		return
+	}
+
+	trainingDatasetID, err := uuid.Parse(pathParts[5])
+	if err != nil {
+		http.Error(w, "Invalid training dataset ID format", http.StatusBadRequest)
+		return
+	}
+
+	// The stream outlives the write timeout of the server
+	responseController := http.NewResponseController(w)
+	if err := responseController.SetWriteDeadline(time.Time{}); err != nil {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	ticker := time.NewTicker(progressStreamInterval)
+	defer ticker.Stop()
+
+	for {
+		progress, err := fetchTrainingDatasetProgress(r, token, projectID, trainingDatasetID)
+		if err != nil {
+			fmt.Fprintf(w, "event: progress-error\ndata: %q\n\n", "Failed to load progress")
+			responseController.Flush()
+			return
+		}
+
+		data, err := json.Marshal(progress)
+		if err != nil {
+			return
+		}
+		fmt.Fprintf(w, "event: progress\ndata: %s\n\n", data)
+
+		if !isTrainingDatasetGenerating(progress.Status) {
+			fmt.Fprintf(w, "event: done\ndata: %q\n\n", progress.Status)
+			responseController.Flush()
+			return
+		}
+		responseController.Flush()
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func fetchTrainingDatasetProgress(r *http.Request, token string, projectID uuid.UUID, trainingDatasetID uuid.UUID) (*TrainingDatasetProgressData, error) {
+	apiBaseURL := web.GetAPIBaseURL(r)
+
+	req, err := http.NewRequestWithContext(r.Context(), "GET", fmt.Sprintf("%s/api/projects/%s/training-datasets/%s/progress", apiBaseURL, projectID, trainingDatasetID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var progress TrainingDatasetProgressData
+	if err := json.NewDecoder(resp.Body).Decode(&progress); err != nil {
+		return nil, err
+	}
+
+	return &progress, nil
+}
+
+func fetchTrainingDatasetPartialResults(r *http.Request, token string, projectID uuid.UUID, trainingDatasetID uuid.UUID) (*TrainingDatasetPartialResultsData, error) {
+	apiBaseURL := web.GetAPIBaseURL(r)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/projects/%s/training-datasets/%s/partial-results?limit=%d", apiBaseURL, projectID, trainingDatasetID, partialResultsPreviewLimit), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var partialResults TrainingDatasetPartialResultsData
+	if err := json.NewDecoder(resp.Body).Decode(&partialResults); err != nil {
+		return nil, err
+	}
+
+	return &partialResults, nil
+}
@@ -12,6 +12,12 @@ import (
 	"ai-platform/cmd/web"
 )
 
+type CorpusOptionData struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Shared bool   `json:"shared"`
+}
+
 func TrainingDatasetStep1Handler(w http.ResponseWriter, r *http.Request) {
 	token := web.GetTokenFromCookie(r)
 	if token == "" {
@@ -43,7 +49,44 @@ func TrainingDatasetStep1Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	templ.Handler(TrainingDatasetStep1(projectIDStr, projectName)).ServeHTTP(w, r)
+	// The document collections are optional, the page still works without them
+	corpora, err := fetchCorpora(r, token, projectID)
+	if err != nil {
+		corpora = []CorpusOptionData{}
+	}
+
+	templ.Handler(TrainingDatasetStep1(projectIDStr, projectName, corpora)).ServeHTTP(w, r)
+}
+
+func fetchCorpora(r *http.Request, token string, projectID uuid.UUID) ([]CorpusOptionData, error) {
+	apiBaseURL := web.GetAPIBaseURL(r)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/corpora?project_id=%s", apiBaseURL, projectID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var response struct {
+		Corpora []CorpusOptionData `json:"corpora"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+
+	return response.Corpora, nil
 }
 
 func fetchProjectName(r *http.Request, token string, projectID uuid.UUID) (string, error) {
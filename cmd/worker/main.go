@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+
+	"ai-platform/internal/common"
+	"ai-platform/internal/database"
+	"ai-platform/internal/worker"
+)
+
+// The worker generates training datasets in-process instead of the external runner
+func main() {
+	app := fx.New(
+		fx.Provide(database.New),
+		common.Module,
+		fx.Invoke(func(lc fx.Lifecycle, trainingDatasetWorker *worker.TrainingDatasetWorker) {
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+
+			lc.Append(fx.Hook{
+				OnStart: func(context.Context) error {
+					go func() {
+						defer close(done)
+						trainingDatasetWorker.Run(ctx)
+					}()
+					return nil
+				},
+				OnStop: func(stopCtx context.Context) error {
+					// Stop polling and wait for the running job to give up
+					cancel()
+					select {
+					case <-done:
+					case <-stopCtx.Done():
+					}
+					return nil
+				},
+			})
+		}),
+	)
+
+	app.Run()
+}
@@ -0,0 +1,59 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type mockAbortTrainingDatasetUseCase struct {
+	err error
+}
+
+func (m *mockAbortTrainingDatasetUseCase) AbortTrainingDataset(ctx context.Context, command in.AbortTrainingDatasetCommand) error {
+	return m.err
+}
+
+func TestAbortTrainingDatasetController_AbortTrainingDataset(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{"success", nil, http.StatusOK},
+		{"not found", errors.New("training dataset not found"), http.StatusNotFound},
+		{"access denied", errors.New("access denied"), http.StatusForbidden},
+		{"invalid transition", errors.New("invalid status transition from DONE to ABORTED"), http.StatusConflict},
+		{"cancel failed", errors.New("failed to cancel training dataset job: timeout"), http.StatusInternalServerError},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := &AbortTrainingDatasetController{
+				AbortTrainingDatasetUseCase: &mockAbortTrainingDatasetUseCase{err: tt.err},
+			}
+
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Set("user_id", uuid.New())
+				c.Next()
+			})
+			router.POST("/projects/:project_id/training-datasets/:training_dataset_id/abort", controller.AbortTrainingDataset)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/projects/"+uuid.New().String()+"/training-datasets/"+uuid.New().String()+"/abort", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+		})
+	}
+}
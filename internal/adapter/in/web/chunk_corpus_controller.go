@@ -0,0 +1,69 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type ChunkCorpusController struct {
+	ChunkCorpusUseCase in.ChunkCorpusUseCase
+}
+
+func (c *ChunkCorpusController) ChunkCorpus(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	corpusIDStr := ctx.Param("corpus_id")
+	corpusID, err := uuid.Parse(corpusIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid corpus ID format",
+		})
+		return
+	}
+
+	var request ChunkingConfigRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	command := in.ChunkCorpusCommand{
+		CorpusID: corpusID,
+		OwnerID:  userID,
+		Config:   request.ToEntity(),
+	}
+
+	result, err := c.ChunkCorpusUseCase.ChunkCorpus(ctx.Request.Context(), command)
+	if err != nil {
+		switch {
+		case err.Error() == "corpus not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case isChunkingConfigValidationError(err):
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to chunk corpus",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToChunkCorpusResponse(result))
+}
@@ -0,0 +1,17 @@
+package web
+
+import "ai-platform/internal/application/domain/entities"
+
+type ChunkingConfigRequest struct {
+	Strategy        string `json:"strategy" binding:"required"`
+	ChunkSizeTokens int    `json:"chunk_size_tokens" binding:"required"`
+	OverlapTokens   int    `json:"overlap_tokens"`
+}
+
+func (r *ChunkingConfigRequest) ToEntity() entities.CorpusChunkingConfig {
+	return entities.CorpusChunkingConfig{
+		Strategy:        entities.CorpusChunkingStrategy(r.Strategy),
+		ChunkSizeTokens: r.ChunkSizeTokens,
+		OverlapTokens:   r.OverlapTokens,
+	}
+}
@@ -0,0 +1,35 @@
+package web
+
+import "ai-platform/internal/application/domain/entities"
+
+type ConversationConfigRequest struct {
+	SystemPrompt string `json:"system_prompt"`
+	Turns        int    `json:"turns" binding:"required"`
+}
+
+func (r *ConversationConfigRequest) ToEntity() entities.ConversationConfig {
+	return entities.ConversationConfig{
+		SystemPrompt: r.SystemPrompt,
+		Turns:        r.Turns,
+	}
+}
+
+type ConversationMessageRequest struct {
+	Role    string `json:"role" binding:"required"`
+	Content string `json:"content"`
+}
+
+func ToConversationMessages(requests []ConversationMessageRequest) []entities.ConversationMessage {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	messages := make([]entities.ConversationMessage, len(requests))
+	for i, request := range requests {
+		messages[i] = entities.ConversationMessage{
+			Role:    entities.ConversationMessageRole(request.Role),
+			Content: request.Content,
+		}
+	}
+	return messages
+}
@@ -0,0 +1,35 @@
+package web
+
+import "ai-platform/internal/application/domain/entities"
+
+type ConversationConfigResponse struct {
+	SystemPrompt string `json:"system_prompt"`
+	Turns        int    `json:"turns"`
+}
+
+type ConversationMessageResponse struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+func ToConversationConfigResponse(config entities.ConversationConfig) ConversationConfigResponse {
+	return ConversationConfigResponse{
+		SystemPrompt: config.SystemPrompt,
+		Turns:        config.Turns,
+	}
+}
+
+func ToConversationMessageResponses(messages []entities.ConversationMessage) []ConversationMessageResponse {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	responses := make([]ConversationMessageResponse, len(messages))
+	for i, message := range messages {
+		responses[i] = ConversationMessageResponse{
+			Role:    string(message.Role),
+			Content: message.Content,
+		}
+	}
+	return responses
+}
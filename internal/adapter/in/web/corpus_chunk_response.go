@@ -0,0 +1,94 @@
+package web
+
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/in"
+)
+
+type ChunkingConfigResponse struct {
+	Strategy        string `json:"strategy"`
+	ChunkSizeTokens int    `json:"chunk_size_tokens"`
+	OverlapTokens   int    `json:"overlap_tokens"`
+}
+
+type CorpusChunkResponse struct {
+	ID         uuid.UUID `json:"id"`
+	FileName   string    `json:"file_name"`
+	Index      int       `json:"index"`
+	Start      int       `json:"start"`
+	End        int       `json:"end"`
+	TokenCount int       `json:"token_count"`
+	Text       string    `json:"text"`
+}
+
+type PreviewCorpusChunksResponse struct {
+	Config      ChunkingConfigResponse `json:"config"`
+	ConfigKey   string                 `json:"config_key"`
+	FileName    string                 `json:"file_name"`
+	TotalChunks int                    `json:"total_chunks"`
+	Chunks      []CorpusChunkResponse  `json:"chunks"`
+}
+
+type ChunkCorpusDocumentResponse struct {
+	FileName   string `json:"file_name"`
+	ChunkCount int    `json:"chunk_count"`
+	TokenCount int    `json:"token_count"`
+}
+
+type ChunkCorpusResponse struct {
+	Config    ChunkingConfigResponse        `json:"config"`
+	ConfigKey string                        `json:"config_key"`
+	Documents []ChunkCorpusDocumentResponse `json:"documents"`
+}
+
+func ToChunkingConfigResponse(config entities.CorpusChunkingConfig) ChunkingConfigResponse {
+	return ChunkingConfigResponse{
+		Strategy:        string(config.Strategy),
+		ChunkSizeTokens: config.ChunkSizeTokens,
+		OverlapTokens:   config.OverlapTokens,
+	}
+}
+
+func ToCorpusChunkResponse(chunk *entities.CorpusChunk) CorpusChunkResponse {
+	return CorpusChunkResponse{
+		ID:         chunk.ID,
+		FileName:   chunk.FileName,
+		Index:      chunk.Index,
+		Start:      chunk.Start,
+		End:        chunk.End,
+		TokenCount: chunk.TokenCount,
+		Text:       chunk.Text,
+	}
+}
+
+func ToPreviewCorpusChunksResponse(result *in.PreviewCorpusChunksResult) PreviewCorpusChunksResponse {
+	response := PreviewCorpusChunksResponse{
+		Config:      ToChunkingConfigResponse(result.Config),
+		ConfigKey:   result.ConfigKey,
+		FileName:    result.FileName,
+		TotalChunks: result.TotalChunks,
+		Chunks:      make([]CorpusChunkResponse, 0, len(result.Chunks)),
+	}
+	for i := range result.Chunks {
+		response.Chunks = append(response.Chunks, ToCorpusChunkResponse(&result.Chunks[i]))
+	}
+	return response
+}
+
+func ToChunkCorpusResponse(result *in.ChunkCorpusResult) ChunkCorpusResponse {
+	response := ChunkCorpusResponse{
+		Config:    ToChunkingConfigResponse(result.Config),
+		ConfigKey: result.ConfigKey,
+		Documents: make([]ChunkCorpusDocumentResponse, 0, len(result.Documents)),
+	}
+	for _, document := range result.Documents {
+		response.Documents = append(response.Documents, ChunkCorpusDocumentResponse{
+			FileName:   document.FileName,
+			ChunkCount: document.ChunkCount,
+			TokenCount: document.TokenCount,
+		})
+	}
+	return response
+}
@@ -0,0 +1,104 @@
+package web
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/in"
+)
+
+type CorpusResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	S3Path      string     `json:"s3_path"`
+	FilesSubset *[]string  `json:"files_subset"`
+	OwnerID     *uuid.UUID `json:"owner_id"`
+	ProjectID   *uuid.UUID `json:"project_id"`
+	Shared      bool       `json:"shared"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type CorpusDocumentResponse struct {
+	FileName    string    `json:"file_name"`
+	Format      string    `json:"format"`
+	SizeBytes   int64     `json:"size_bytes"`
+	LanguageISO *string   `json:"language_iso"`
+	PageCount   *int      `json:"page_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type ListCorporaResponse struct {
+	Corpora []CorpusResponse `json:"corpora"`
+}
+
+type GetCorpusResponse struct {
+	CorpusResponse
+	Documents []CorpusDocumentResponse `json:"documents"`
+}
+
+type SyncCorpusResponse struct {
+	Documents []CorpusDocumentResponse `json:"documents"`
+	Added     []string                 `json:"added"`
+	Removed   []string                 `json:"removed"`
+}
+
+func ToCorpusResponse(corpus *entities.Corpus) CorpusResponse {
+	return CorpusResponse{
+		ID:          corpus.ID,
+		Name:        corpus.Name,
+		S3Path:      corpus.S3Path,
+		FilesSubset: corpus.FilesSubset,
+		OwnerID:     corpus.OwnerID,
+		ProjectID:   corpus.ProjectID,
+		Shared:      corpus.OwnerID == nil,
+		CreatedAt:   corpus.CreatedAt,
+		UpdatedAt:   corpus.UpdatedAt,
+	}
+}
+
+func ToCorpusDocumentResponse(document *entities.CorpusDocument) CorpusDocumentResponse {
+	return CorpusDocumentResponse{
+		FileName:    document.FileName,
+		Format:      string(document.Format),
+		SizeBytes:   document.SizeBytes,
+		LanguageISO: document.LanguageISO,
+		PageCount:   document.PageCount,
+		CreatedAt:   document.CreatedAt,
+		UpdatedAt:   document.UpdatedAt,
+	}
+}
+
+func toCorpusDocumentResponses(documents []*entities.CorpusDocument) []CorpusDocumentResponse {
+	responses := make([]CorpusDocumentResponse, 0, len(documents))
+	for _, document := range documents {
+		responses = append(responses, ToCorpusDocumentResponse(document))
+	}
+	return responses
+}
+
+func ToListCorporaResponse(corpora []*entities.Corpus) ListCorporaResponse {
+	response := ListCorporaResponse{Corpora: make([]CorpusResponse, 0, len(corpora))}
+	for _, corpus := range corpora {
+		response.Corpora = append(response.Corpora, ToCorpusResponse(corpus))
+	}
+	return response
+}
+
+func ToGetCorpusResponse(result *in.GetCorpusResult) GetCorpusResponse {
+	return GetCorpusResponse{
+		CorpusResponse: ToCorpusResponse(result.Corpus),
+		Documents:      toCorpusDocumentResponses(result.Documents),
+	}
+}
+
+func ToSyncCorpusResponse(result *in.SyncCorpusResult) SyncCorpusResponse {
+	return SyncCorpusResponse{
+		Documents: toCorpusDocumentResponses(result.Documents),
+		Added:     result.Added,
+		Removed:   result.Removed,
+	}
+}
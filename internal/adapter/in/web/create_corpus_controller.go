@@ -0,0 +1,80 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type CreateCorpusController struct {
+	CreateCorpusUseCase in.CreateCorpusUseCase
+}
+
+func (c *CreateCorpusController) CreateCorpus(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	var request CreateCorpusRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	var projectID *uuid.UUID
+	if request.ProjectID != nil {
+		parsed, err := uuid.Parse(*request.ProjectID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid project ID format",
+			})
+			return
+		}
+		projectID = &parsed
+	}
+
+	command := in.CreateCorpusCommand{
+		OwnerID:   userID,
+		ProjectID: projectID,
+		Name:      request.Name,
+	}
+
+	corpus, err := c.CreateCorpusUseCase.CreateCorpus(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "project not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		case "corpus name already exists":
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		case "corpus name cannot be empty", "corpus name cannot exceed 255 characters":
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to create corpus",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, ToCorpusResponse(corpus))
+}
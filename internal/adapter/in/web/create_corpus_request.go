@@ -0,0 +1,6 @@
+package web
+
+type CreateCorpusRequest struct {
+	Name      string  `json:"name" binding:"required"`
+	ProjectID *string `json:"project_id"`
+}
@@ -45,6 +45,7 @@ func (c *CreateDeploymentController) CreateDeployment(ctx *gin.Context) {
 		ProjectID:  projectID,
 		FinetuneID: request.FinetuneID,
 		OwnerID:    userID,
+		RedactPII:  request.RedactPII,
 	}
 
 	result, err := c.CreateDeploymentUseCase.CreateDeployment(command)
@@ -55,6 +55,14 @@ func (c *CreateFinetuneController) CreateFinetune(ctx *gin.Context) {
 		TrainingDatasetID:                trainingDatasetID,
 		TrainingDatasetNumberExamples:    request.TrainingDatasetNumberExamples,
 		TrainingDatasetSelectRandom:      request.TrainingDatasetSelectRandom,
+		TrainingDatasetMinQualityScore:   request.TrainingDatasetMinQualityScore,
+		RedactPII:                        request.RedactPII,
+		Backend:                          request.GetBackend(),
+		Priority:                         request.GetPriority(),
+	}
+	if request.Hyperparameters != nil {
+		hyperparameters := request.Hyperparameters.ToEntity()
+		command.Hyperparameters = &hyperparameters
 	}
 
 	result, err := c.CreateFinetuneUseCase.Execute(ctx.Request.Context(), command)
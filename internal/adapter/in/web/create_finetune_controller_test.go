@@ -18,11 +18,13 @@ import (
 )
 
 type mockCreateFinetuneUseCase struct {
-	result *entities.Finetune
-	err    error
+	result  *entities.Finetune
+	err     error
+	command in.CreateFinetuneCommand
 }
 
 func (m *mockCreateFinetuneUseCase) Execute(ctx context.Context, command in.CreateFinetuneCommand) (*entities.Finetune, error) {
+	m.command = command
 	if command.BaseModelName == "invalid" {
 		return nil, errors.New("invalid base model name")
 	}
@@ -102,6 +104,39 @@ func TestCreateFinetuneController_CreateFinetune_Success(t *testing.T) {
 	}
 }
 
+func TestCreateFinetuneController_CreateFinetune_PriorityAndBackend(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	projectID := uuid.New()
+	mockUseCase := &mockCreateFinetuneUseCase{
+		result: &entities.Finetune{ID: uuid.New(), ProjectID: projectID, Status: entities.FinetuneStatusQueued},
+	}
+	controller := &CreateFinetuneController{
+		CreateFinetuneUseCase: mockUseCase,
+	}
+
+	body := `{"base_model_name": "qwen3b:4b", "training_dataset_id": "` + uuid.New().String() + `", "priority": "high", "backend": " local "}`
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Set("user_id", uuid.New())
+	c.Params = []gin.Param{{Key: "project_id", Value: projectID.String()}}
+	c.Request = httptest.NewRequest("POST", "/api/projects/"+projectID.String()+"/finetunes", bytes.NewBufferString(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	controller.CreateFinetune(c)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Expected status code %d, got %d. Body: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	if mockUseCase.command.Priority == nil || *mockUseCase.command.Priority != entities.FinetunePriorityHigh {
+		t.Errorf("Expected priority HIGH, got %v", mockUseCase.command.Priority)
+	}
+	if mockUseCase.command.Backend == nil || *mockUseCase.command.Backend != entities.FinetuneBackendLocal {
+		t.Errorf("Expected backend LOCAL, got %v", mockUseCase.command.Backend)
+	}
+}
+
 func TestCreateFinetuneController_CreateFinetune_InvalidProjectID(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -1,12 +1,72 @@
 package web
 
-import "github.com/google/uuid"
+import (
+	"strings"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
 
 type CreateFinetuneRequest struct {
 	BaseModelName                    string    `json:"base_model_name" binding:"required"`
 	TrainingDatasetID                string    `json:"training_dataset_id" binding:"required"`
 	TrainingDatasetNumberExamples    *int      `json:"training_dataset_number_examples,omitempty"`
 	TrainingDatasetSelectRandom      bool      `json:"training_dataset_select_random"`
+	TrainingDatasetMinQualityScore   *float64  `json:"training_dataset_min_quality_score,omitempty"`
+	RedactPII                        bool      `json:"redact_pii"`
+	Hyperparameters                  *FinetuneHyperparametersRequest `json:"hyperparameters,omitempty"`
+	Backend                          *string   `json:"backend,omitempty"`
+	Priority                         *string   `json:"priority,omitempty"`
+}
+
+// FinetuneHyperparametersRequest holds the hyperparameters to change, missing fields keep the default
+type FinetuneHyperparametersRequest struct {
+	Epochs                    *int     `json:"epochs"`
+	LearningRate              *float64 `json:"learning_rate"`
+	BatchSize                 *int     `json:"batch_size"`
+	GradientAccumulationSteps *int     `json:"gradient_accumulation_steps"`
+	LoraRank                  *int     `json:"lora_rank"`
+	LoraAlpha                 *int     `json:"lora_alpha"`
+	LoraDropout               *float64 `json:"lora_dropout"`
+	TargetModules             []string `json:"target_modules"`
+	MaxSeqLength              *int     `json:"max_seq_length"`
+	WarmupSteps               *int     `json:"warmup_steps"`
+	Seed                      *int     `json:"seed"`
+}
+
+func (r *FinetuneHyperparametersRequest) ToEntity() entities.FinetuneHyperparameterOverrides {
+	return entities.FinetuneHyperparameterOverrides{
+		Epochs:                    r.Epochs,
+		LearningRate:              r.LearningRate,
+		BatchSize:                 r.BatchSize,
+		GradientAccumulationSteps: r.GradientAccumulationSteps,
+		LoraRank:                  r.LoraRank,
+		LoraAlpha:                 r.LoraAlpha,
+		LoraDropout:               r.LoraDropout,
+		TargetModules:             r.TargetModules,
+		MaxSeqLength:              r.MaxSeqLength,
+		WarmupSteps:               r.WarmupSteps,
+		Seed:                      r.Seed,
+	}
+}
+
+// GetBackend accepts the backend in any case, nil and empty use the default backend
+func (r *CreateFinetuneRequest) GetBackend() *entities.FinetuneBackendType {
+	if r.Backend == nil || strings.TrimSpace(*r.Backend) == "" {
+		return nil
+	}
+	backend := entities.FinetuneBackendType(strings.ToUpper(strings.TrimSpace(*r.Backend)))
+	return &backend
+}
+
+// GetPriority accepts the priority in any case, nil and empty queue the finetune with the default priority
+func (r *CreateFinetuneRequest) GetPriority() *entities.FinetunePriority {
+	if r.Priority == nil || strings.TrimSpace(*r.Priority) == "" {
+		return nil
+	}
+	priority := entities.FinetunePriority(strings.ToUpper(strings.TrimSpace(*r.Priority)))
+	return &priority
 }
 
 func (r *CreateFinetuneRequest) GetTrainingDatasetID() (uuid.UUID, error) {
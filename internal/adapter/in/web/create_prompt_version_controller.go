@@ -0,0 +1,86 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type CreatePromptVersionController struct {
+	CreatePromptVersionUseCase in.CreatePromptVersionUseCase
+}
+
+func (c *CreatePromptVersionController) CreatePromptVersion(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	var request CreatePromptVersionRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	command := in.CreatePromptVersionCommand{
+		ProjectID: projectID,
+		OwnerID:   userID,
+		Text:      request.Text,
+	}
+
+	result, err := c.CreatePromptVersionUseCase.CreatePromptVersion(ctx.Request.Context(), command)
+	if err != nil {
+		// Another request saved a different prompt as the same version meanwhile
+		if strings.Contains(err.Error(), "prompt conflict") {
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		switch err.Error() {
+		case "project not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		case "generate_prompt is required":
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to create prompt version",
+			})
+		}
+		return
+	}
+
+	// An unchanged prompt returns the latest version instead of creating a new one
+	status := http.StatusOK
+	if result.Created {
+		status = http.StatusCreated
+	}
+	ctx.JSON(status, ToCreatePromptVersionResponse(result))
+}
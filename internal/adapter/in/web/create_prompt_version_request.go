@@ -0,0 +1,5 @@
+package web
+
+type CreatePromptVersionRequest struct {
+	Text string `json:"text" binding:"required"`
+}
@@ -0,0 +1,17 @@
+package web
+
+import (
+	"ai-platform/internal/application/port/in"
+)
+
+type CreatePromptVersionResponse struct {
+	PromptResponse
+	Created bool `json:"created"`
+}
+
+func ToCreatePromptVersionResponse(result *in.CreatePromptVersionResult) *CreatePromptVersionResponse {
+	return &CreatePromptVersionResponse{
+		PromptResponse: ToPromptResponse(result.Prompt),
+		Created:        result.Created,
+	}
+}
@@ -2,13 +2,20 @@ package web
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"ai-platform/internal/application/domain/entities"
 	"ai-platform/internal/application/port/in"
 )
 
+const (
+	defaultGenerateModel       = "qwen3:30b-a3b-instruct-2507-q4_K_M"
+	defaultGenerateModelRunner = "runpod_ollama"
+)
+
 type CreateTrainingDatasetController struct {
 	CreateTrainingDatasetUseCase in.CreateTrainingDatasetUseCase
 }
@@ -44,17 +51,31 @@ func (c *CreateTrainingDatasetController) CreateTrainingDataset(ctx *gin.Context
 	// Set default values for generate model and runner if not provided
 	generateModel := request.GenerateModel
 	if generateModel == "" {
-		generateModel = "qwen3:30b-a3b-instruct-2507-q4_K_M"
+		generateModel = defaultGenerateModel
 	}
 	generateModelRunner := request.GenerateModelRunner
 	if generateModelRunner == "" {
-		generateModelRunner = "runpod_ollama"
+		generateModelRunner = defaultGenerateModelRunner
+	}
+
+	var corpusID *uuid.UUID
+	if request.CorpusID != nil {
+		parsed, err := uuid.Parse(*request.CorpusID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid corpus ID format",
+			})
+			return
+		}
+		corpusID = &parsed
 	}
 
 	command := in.CreateTrainingDatasetCommand{
 		UserID:                  userID,
 		ProjectID:               projectID,
+		CorpusID:                corpusID,
 		CorpusName:              request.CorpusName,
+		Type:                    entities.TrainingDatasetType(request.Type),
 		InputField:              request.InputField,
 		OutputField:             request.OutputField,
 		JSONObjectFields:        request.JSONObjectFields,
@@ -66,9 +87,24 @@ func (c *CreateTrainingDatasetController) CreateTrainingDataset(ctx *gin.Context
 		GenerateModel:           generateModel,
 		GenerateModelRunner:     generateModelRunner,
 	}
+	if request.Chunking != nil {
+		chunking := request.Chunking.ToEntity()
+		command.Chunking = &chunking
+	}
+	if request.Conversation != nil {
+		conversation := request.Conversation.ToEntity()
+		command.Conversation = &conversation
+	}
 
 	result, err := c.CreateTrainingDatasetUseCase.Execute(ctx.Request.Context(), command)
 	if err != nil {
+		// Another request saved a different prompt as the same version meanwhile
+		if strings.Contains(err.Error(), "prompt conflict") {
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		ctx.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
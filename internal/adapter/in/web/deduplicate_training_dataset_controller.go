@@ -0,0 +1,85 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type DeduplicateTrainingDatasetController struct {
+	DeduplicateTrainingDatasetUseCase in.DeduplicateTrainingDatasetUseCase
+}
+
+func (c *DeduplicateTrainingDatasetController) DeduplicateTrainingDataset(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	trainingDatasetIDStr := ctx.Param("training_dataset_id")
+	trainingDatasetID, err := uuid.Parse(trainingDatasetIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training dataset ID format",
+		})
+		return
+	}
+
+	// The request body is optional, without it the default threshold is used
+	var request DeduplicateTrainingDatasetRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil && ctx.Request.ContentLength > 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	command := in.DeduplicateTrainingDatasetCommand{
+		ProjectID:           projectID,
+		TrainingDatasetID:   trainingDatasetID,
+		OwnerID:             userID,
+		SimilarityThreshold: request.SimilarityThreshold,
+		DryRun:              request.DryRun,
+	}
+
+	result, err := c.DeduplicateTrainingDatasetUseCase.DeduplicateTrainingDataset(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "project not found", "training dataset not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		case "similarity threshold must be greater than 0 and at most 1":
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to deduplicate training dataset",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToDeduplicateTrainingDatasetResponse(result))
+}
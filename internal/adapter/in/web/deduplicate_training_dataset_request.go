@@ -0,0 +1,6 @@
+package web
+
+type DeduplicateTrainingDatasetRequest struct {
+	SimilarityThreshold float64 `json:"similarity_threshold"`
+	DryRun              bool    `json:"dry_run"`
+}
@@ -0,0 +1,48 @@
+package web
+
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type DuplicateMatchResponse struct {
+	ItemID     uuid.UUID `json:"item_id"`
+	Method     string    `json:"method"`
+	Similarity float64   `json:"similarity"`
+}
+
+type DuplicateClusterResponse struct {
+	KeepID     uuid.UUID                `json:"keep_id"`
+	Duplicates []DuplicateMatchResponse `json:"duplicates"`
+}
+
+type DeduplicateTrainingDatasetResponse struct {
+	Clusters         []DuplicateClusterResponse `json:"clusters"`
+	DuplicatesMarked int                        `json:"duplicates_marked"`
+	ItemsChecked     int                        `json:"items_checked"`
+}
+
+func ToDeduplicateTrainingDatasetResponse(result *in.DeduplicateTrainingDatasetResult) DeduplicateTrainingDatasetResponse {
+	clusters := make([]DuplicateClusterResponse, 0, len(result.Clusters))
+	for _, cluster := range result.Clusters {
+		duplicates := make([]DuplicateMatchResponse, 0, len(cluster.Duplicates))
+		for _, duplicate := range cluster.Duplicates {
+			duplicates = append(duplicates, DuplicateMatchResponse{
+				ItemID:     duplicate.ItemID,
+				Method:     string(duplicate.Method),
+				Similarity: duplicate.Similarity,
+			})
+		}
+		clusters = append(clusters, DuplicateClusterResponse{
+			KeepID:     cluster.KeepID,
+			Duplicates: duplicates,
+		})
+	}
+
+	return DeduplicateTrainingDatasetResponse{
+		Clusters:         clusters,
+		DuplicatesMarked: result.DuplicatesMarked,
+		ItemsChecked:     result.ItemsChecked,
+	}
+}
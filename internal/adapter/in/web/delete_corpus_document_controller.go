@@ -0,0 +1,60 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type DeleteCorpusDocumentController struct {
+	DeleteCorpusDocumentUseCase in.DeleteCorpusDocumentUseCase
+}
+
+func (c *DeleteCorpusDocumentController) DeleteCorpusDocument(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	corpusIDStr := ctx.Param("corpus_id")
+	corpusID, err := uuid.Parse(corpusIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid corpus ID format",
+		})
+		return
+	}
+
+	command := in.DeleteCorpusDocumentCommand{
+		CorpusID: corpusID,
+		OwnerID:  userID,
+		FileName: ctx.Param("file_name"),
+	}
+
+	err = c.DeleteCorpusDocumentUseCase.DeleteCorpusDocument(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "corpus not found", "document not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to delete document",
+			})
+		}
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -2,6 +2,7 @@ package web
 
 import (
 	"io"
+	"log"
 	"net/http"
 	"strconv"
 
@@ -61,7 +62,8 @@ func (controller *DownloadModelController) DownloadModel(c *gin.Context) {
 	_, err = io.Copy(c.Writer, reader)
 	if err != nil {
 		// Cannot send JSON error after we've started streaming
-		// Log the error but don't return anything to client
+		// Log the error but don't return anything to client, a failed checksum ends the download short of its length
+		log.Printf("failed to stream model %s: %v", filename, err)
 		return
 	}
 }
\ No newline at end of file
@@ -5,10 +5,12 @@ import (
 	"encoding/csv"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"ai-platform/internal/application/domain/entities"
 	"ai-platform/internal/application/port/in"
 )
 
@@ -47,10 +49,19 @@ func (c *DownloadTrainingDatasetController) DownloadTrainingDataset(ctx *gin.Con
 		ProjectID:         projectID,
 		TrainingDatasetID: trainingDatasetID,
 		OwnerID:           userID,
+		Format:            entities.TrainingDatasetExportFormat(ctx.Query("format")),
+		SystemPrompt:      ctx.Query("system_prompt"),
+		IncludeMetadata:   ctx.Query("include_metadata") == "true",
 	}
 
 	result, err := c.DownloadTrainingDatasetUseCase.DownloadTrainingDataset(command)
 	if err != nil {
+		if strings.HasPrefix(err.Error(), "unsupported export format") || strings.HasSuffix(err.Error(), "not found in field names") {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to download training dataset",
 		})
@@ -64,6 +75,13 @@ func (c *DownloadTrainingDatasetController) DownloadTrainingDataset(ctx *gin.Con
 		return
 	}
 
+	if result.ContentType != "" {
+		ctx.Header("Content-Description", "File Transfer")
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", result.Filename))
+		ctx.Data(http.StatusOK, result.ContentType, result.Content)
+		return
+	}
+
 	// Generate CSV
 	var buf bytes.Buffer
 	writer := csv.NewWriter(&buf)
@@ -2,6 +2,7 @@ package web
 
 import (
 	"encoding/csv"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"ai-platform/internal/application/domain/entities"
 	"ai-platform/internal/application/port/in"
 )
 
@@ -209,3 +211,72 @@ func TestDownloadTrainingDatasetController_DownloadTrainingDataset_EmptyData(t *
 	assert.Equal(t, 1, len(records))
 	assert.Equal(t, fieldNames, records[0])
 }
+
+func TestDownloadTrainingDatasetController_DownloadTrainingDataset_JSONLFormat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	content := []byte("{\"input\":\"What is AI?\",\"output\":\"Artificial Intelligence\"}\n")
+
+	mockUseCase := &MockDownloadTrainingDatasetUseCase{
+		DownloadTrainingDatasetFunc: func(command in.DownloadTrainingDatasetCommand) (*in.DownloadTrainingDatasetResult, error) {
+			assert.Equal(t, entities.TrainingDatasetExportFormatJSONL, command.Format)
+			assert.True(t, command.IncludeMetadata)
+
+			return &in.DownloadTrainingDatasetResult{
+				FieldNames:  []string{"input", "output"},
+				Filename:    "dataset_test_project_v1.jsonl",
+				Content:     content,
+				ContentType: "application/x-ndjson",
+			}, nil
+		},
+	}
+
+	controller := &DownloadTrainingDatasetController{
+		DownloadTrainingDatasetUseCase: mockUseCase,
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/download?format=jsonl&include_metadata=true", nil)
+
+	c.Params = gin.Params{
+		{Key: "project_id", Value: uuid.New().String()},
+		{Key: "training_dataset_id", Value: uuid.New().String()},
+	}
+	c.Set("user_id", uuid.New())
+
+	controller.DownloadTrainingDataset(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "dataset_test_project_v1.jsonl")
+	assert.Equal(t, string(content), w.Body.String())
+}
+
+func TestDownloadTrainingDatasetController_DownloadTrainingDataset_UnsupportedFormat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUseCase := &MockDownloadTrainingDatasetUseCase{
+		DownloadTrainingDatasetFunc: func(command in.DownloadTrainingDatasetCommand) (*in.DownloadTrainingDatasetResult, error) {
+			return nil, errors.New("unsupported export format: parquet")
+		},
+	}
+
+	controller := &DownloadTrainingDatasetController{
+		DownloadTrainingDatasetUseCase: mockUseCase,
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/download?format=parquet", nil)
+
+	c.Params = gin.Params{
+		{Key: "project_id", Value: uuid.New().String()},
+		{Key: "training_dataset_id", Value: uuid.New().String()},
+	}
+	c.Set("user_id", uuid.New())
+
+	controller.DownloadTrainingDataset(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,101 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type EditTrainingDataItemController struct {
+	EditTrainingDataItemUseCase in.EditTrainingDataItemUseCase
+}
+
+func (c *EditTrainingDataItemController) EditTrainingDataItem(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	trainingDatasetIDStr := ctx.Param("training_dataset_id")
+	trainingDatasetID, err := uuid.Parse(trainingDatasetIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training dataset ID format",
+		})
+		return
+	}
+
+	trainingDataItemIDStr := ctx.Param("item_id")
+	trainingDataItemID, err := uuid.Parse(trainingDataItemIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training data item ID format",
+		})
+		return
+	}
+
+	var request EditTrainingDataItemRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	command := in.EditTrainingDataItemCommand{
+		ProjectID:          projectID,
+		TrainingDatasetID:  trainingDatasetID,
+		TrainingDataItemID: trainingDataItemID,
+		OwnerID:            userID,
+		Values:             request.Values,
+		Messages:           ToConversationMessages(request.Messages),
+	}
+
+	result, err := c.EditTrainingDataItemUseCase.EditTrainingDataItem(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "project not found", "training dataset not found", "training data item not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		case "training data item is deleted", "training data item is already corrected":
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			if strings.HasPrefix(err.Error(), "values") || strings.HasPrefix(err.Error(), "messages") {
+				ctx.JSON(http.StatusBadRequest, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to edit training data item",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, ToTrainingDataItemResponse(result))
+}
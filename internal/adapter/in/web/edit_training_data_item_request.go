@@ -0,0 +1,7 @@
+package web
+
+// EditTrainingDataItemRequest holds the values of a record or the messages of a conversation
+type EditTrainingDataItemRequest struct {
+	Values   []string                     `json:"values"`
+	Messages []ConversationMessageRequest `json:"messages" binding:"omitempty,dive"`
+}
@@ -0,0 +1,99 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type EstimateTrainingDatasetController struct {
+	EstimateTrainingDatasetUseCase in.EstimateTrainingDatasetUseCase
+}
+
+func (c *EstimateTrainingDatasetController) EstimateTrainingDataset(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	var request EstimateTrainingDatasetRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	var corpusID *uuid.UUID
+	if request.CorpusID != nil {
+		parsed, err := uuid.Parse(*request.CorpusID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid corpus ID format",
+			})
+			return
+		}
+		corpusID = &parsed
+	}
+
+	command := in.EstimateTrainingDatasetCommand{
+		ProjectID:               projectID,
+		OwnerID:                 userID,
+		CorpusID:                corpusID,
+		CorpusName:              request.CorpusName,
+		GeneratePrompt:          request.GeneratePrompt,
+		JSONObjectFields:        request.JSONObjectFields,
+		ExpectedOutputSizeChars: request.ExpectedOutputSizeChars,
+		GenerateExamplesNumber:  request.GenerateExamplesNumber,
+		GPUPricePerHour:         request.GPUPricePerHour,
+	}
+	if request.Chunking != nil {
+		chunking := request.Chunking.ToEntity()
+		command.Chunking = &chunking
+	}
+
+	result, err := c.EstimateTrainingDatasetUseCase.EstimateTrainingDataset(ctx.Request.Context(), command)
+	if err != nil {
+		switch {
+		case err.Error() == "project not found" || err.Error() == "corpus not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case err.Error() == "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		case strings.HasPrefix(err.Error(), "chunk"),
+			err.Error() == "generate examples number must be at least 1",
+			err.Error() == "expected output size must be at least 1",
+			err.Error() == "GPU price per hour cannot be negative":
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to estimate training dataset",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToEstimateTrainingDatasetResponse(result))
+}
@@ -0,0 +1,12 @@
+package web
+
+type EstimateTrainingDatasetRequest struct {
+	CorpusID                *string                `json:"corpus_id"`
+	CorpusName              string                 `json:"corpus_name"`
+	JSONObjectFields        map[string]string      `json:"json_object_fields" binding:"required"`
+	ExpectedOutputSizeChars int                    `json:"expected_output_size_chars" binding:"required"`
+	GeneratePrompt          string                 `json:"generate_prompt" binding:"required"`
+	GenerateExamplesNumber  int                    `json:"generate_examples_number" binding:"required"`
+	Chunking                *ChunkingConfigRequest `json:"chunking"`
+	GPUPricePerHour         *float64               `json:"gpu_price_per_hour"`
+}
@@ -0,0 +1,25 @@
+package web
+
+import "ai-platform/internal/application/port/in"
+
+type EstimateTrainingDatasetResponse struct {
+	GenerationRequests    int     `json:"generation_requests"`
+	TokensIn              int     `json:"tokens_in"`
+	TokensOut             int     `json:"tokens_out"`
+	GenerationTimeSeconds float64 `json:"generation_time_seconds"`
+	GPUPricePerHour       float64 `json:"gpu_price_per_hour"`
+	CostUSD               float64 `json:"cost_usd"`
+	HistoricalDatasets    int     `json:"historical_datasets"`
+}
+
+func ToEstimateTrainingDatasetResponse(result *in.EstimateTrainingDatasetResult) EstimateTrainingDatasetResponse {
+	return EstimateTrainingDatasetResponse{
+		GenerationRequests:    result.Estimate.GenerationRequests,
+		TokensIn:              result.Estimate.TokensIn,
+		TokensOut:             result.Estimate.TokensOut,
+		GenerationTimeSeconds: result.Estimate.GenerationTimeSeconds,
+		GPUPricePerHour:       result.Estimate.GPUPricePerHour,
+		CostUSD:               result.Estimate.CostUSD,
+		HistoricalDatasets:    result.Estimate.HistoricalDatasets,
+	}
+}
@@ -0,0 +1,99 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type GenerateMoreTrainingDatasetController struct {
+	GenerateMoreTrainingDatasetUseCase in.GenerateMoreTrainingDatasetUseCase
+}
+
+func (c *GenerateMoreTrainingDatasetController) GenerateMoreTrainingDataset(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	trainingDatasetIDStr := ctx.Param("training_dataset_id")
+	trainingDatasetID, err := uuid.Parse(trainingDatasetIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training dataset ID format",
+		})
+		return
+	}
+
+	var request GenerateMoreTrainingDatasetRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	command := in.GenerateMoreTrainingDatasetCommand{
+		ProjectID:              projectID,
+		TrainingDatasetID:      trainingDatasetID,
+		OwnerID:                userID,
+		GenerateExamplesNumber: request.GenerateExamplesNumber,
+		GeneratePrompt:         request.GeneratePrompt,
+		// Training datasets created before the model was stored are extended with the default model
+		GenerateModel:       defaultGenerateModel,
+		GenerateModelRunner: defaultGenerateModelRunner,
+	}
+
+	result, err := c.GenerateMoreTrainingDatasetUseCase.GenerateMoreTrainingDataset(ctx.Request.Context(), command)
+	if err != nil {
+		// Another request saved a different prompt as the same version meanwhile
+		if strings.Contains(err.Error(), "prompt conflict") {
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		switch err.Error() {
+		case "project not found", "training dataset not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		case "only DONE training datasets can be extended":
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		case "generate examples number must be at least 1":
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to generate more training data",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, ToGenerateMoreTrainingDatasetResponse(result))
+}
@@ -0,0 +1,6 @@
+package web
+
+type GenerateMoreTrainingDatasetRequest struct {
+	GenerateExamplesNumber int    `json:"generate_examples_number" binding:"required"`
+	GeneratePrompt         string `json:"generate_prompt"`
+}
@@ -0,0 +1,25 @@
+package web
+
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type GenerateMoreTrainingDatasetResponse struct {
+	TrainingDatasetID uuid.UUID `json:"training_dataset_id"`
+	Version           int       `json:"version"`
+	CopiedItems       int       `json:"copied_items"`
+	SkippedChunks     int       `json:"skipped_chunks"`
+	PromptVersion     int       `json:"prompt_version"`
+}
+
+func ToGenerateMoreTrainingDatasetResponse(result *in.GenerateMoreTrainingDatasetResult) *GenerateMoreTrainingDatasetResponse {
+	return &GenerateMoreTrainingDatasetResponse{
+		TrainingDatasetID: result.TrainingDatasetID,
+		Version:           result.Version,
+		CopiedItems:       result.CopiedItems,
+		SkippedChunks:     result.SkippedChunks,
+		PromptVersion:     result.PromptVersion,
+	}
+}
@@ -0,0 +1,87 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type GenerateTrainingDatasetSplitsController struct {
+	GenerateTrainingDatasetSplitsUseCase in.GenerateTrainingDatasetSplitsUseCase
+}
+
+func (c *GenerateTrainingDatasetSplitsController) GenerateTrainingDatasetSplits(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	trainingDatasetIDStr := ctx.Param("training_dataset_id")
+	trainingDatasetID, err := uuid.Parse(trainingDatasetIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training dataset ID format",
+		})
+		return
+	}
+
+	var request GenerateTrainingDatasetSplitsRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	command := in.GenerateTrainingDatasetSplitsCommand{
+		ProjectID:                projectID,
+		TrainingDatasetID:        trainingDatasetID,
+		OwnerID:                  userID,
+		TrainRatio:               request.TrainRatio,
+		ValidationRatio:          request.ValidationRatio,
+		TestRatio:                request.TestRatio,
+		Seed:                     request.Seed,
+		StratifyBySourceDocument: request.StratifyBySourceDocument,
+	}
+
+	result, err := c.GenerateTrainingDatasetSplitsUseCase.GenerateTrainingDatasetSplits(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "project not found", "training dataset not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		case "train ratio must be greater than 0", "validation and test ratios cannot be negative", "split ratios must add up to 1":
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to generate training dataset splits",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToGenerateTrainingDatasetSplitsResponse(result))
+}
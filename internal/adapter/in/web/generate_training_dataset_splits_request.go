@@ -0,0 +1,9 @@
+package web
+
+type GenerateTrainingDatasetSplitsRequest struct {
+	TrainRatio               float64 `json:"train_ratio" binding:"required"`
+	ValidationRatio          float64 `json:"validation_ratio"`
+	TestRatio                float64 `json:"test_ratio"`
+	Seed                     int64   `json:"seed"`
+	StratifyBySourceDocument bool    `json:"stratify_by_source_document"`
+}
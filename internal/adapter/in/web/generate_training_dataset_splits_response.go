@@ -0,0 +1,17 @@
+package web
+
+import "ai-platform/internal/application/port/in"
+
+type GenerateTrainingDatasetSplitsResponse struct {
+	TrainItems      int `json:"train_items"`
+	ValidationItems int `json:"validation_items"`
+	TestItems       int `json:"test_items"`
+}
+
+func ToGenerateTrainingDatasetSplitsResponse(result *in.GenerateTrainingDatasetSplitsResult) GenerateTrainingDatasetSplitsResponse {
+	return GenerateTrainingDatasetSplitsResponse{
+		TrainItems:      result.TrainItems,
+		ValidationItems: result.ValidationItems,
+		TestItems:       result.TestItems,
+	}
+}
@@ -0,0 +1,55 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type GetCorpusController struct {
+	GetCorpusUseCase in.GetCorpusUseCase
+}
+
+func (c *GetCorpusController) GetCorpus(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	corpusIDStr := ctx.Param("corpus_id")
+	corpusID, err := uuid.Parse(corpusIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid corpus ID format",
+		})
+		return
+	}
+
+	command := in.GetCorpusCommand{
+		CorpusID: corpusID,
+		OwnerID:  userID,
+	}
+
+	result, err := c.GetCorpusUseCase.GetCorpus(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "corpus not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to fetch corpus",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToGetCorpusResponse(result))
+}
@@ -19,6 +19,7 @@ type GetDeploymentResponse struct {
 	APIKey     string                `json:"api_key"`
 	ProjectID  uuid.UUID             `json:"project_id"`
 	FinetuneID *uuid.UUID            `json:"finetune_id"`
+	RedactPII  bool                  `json:"redact_pii"`
 	CreatedAt  time.Time             `json:"created_at"`
 	UpdatedAt  time.Time             `json:"updated_at"`
 	LogsSample []DeploymentLogSample `json:"logs_sample"`
@@ -40,6 +41,7 @@ func NewGetDeploymentResponse(deployment *entities.Deployment, logs []*entities.
 		APIKey:     deployment.APIKey,
 		ProjectID:  deployment.ProjectID,
 		FinetuneID: deployment.FinetuneID,
+		RedactPII:  deployment.RedactPII,
 		CreatedAt:  deployment.CreatedAt,
 		UpdatedAt:  deployment.UpdatedAt,
 		LogsSample: logsSample,
@@ -67,6 +67,6 @@ func (c *GetFinetuneController) GetFinetune(ctx *gin.Context) {
 		return
 	}
 
-	response := ToGetFinetuneResponse(result.Finetune, result.DeploymentID)
+	response := ToGetFinetuneResponse(result)
 	ctx.JSON(http.StatusOK, response)
 }
\ No newline at end of file
@@ -0,0 +1,69 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type GetFinetuneMetricsController struct {
+	GetFinetuneMetricsUseCase in.GetFinetuneMetricsUseCase
+}
+
+func (c *GetFinetuneMetricsController) GetFinetuneMetrics(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	finetuneIDStr := ctx.Param("finetune_id")
+	finetuneID, err := uuid.Parse(finetuneIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid finetune ID format",
+		})
+		return
+	}
+
+	command := in.GetFinetuneMetricsCommand{
+		ProjectID:  projectID,
+		FinetuneID: finetuneID,
+		OwnerID:    userID,
+	}
+
+	result, err := c.GetFinetuneMetricsUseCase.GetFinetuneMetrics(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "project not found", "finetune not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to fetch finetune metrics",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToGetFinetuneMetricsResponse(result))
+}
@@ -0,0 +1,50 @@
+package web
+
+import (
+	"time"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/in"
+)
+
+type FinetuneMetricResponse struct {
+	Step         int       `json:"step"`
+	Epoch        float64   `json:"epoch"`
+	TrainLoss    *float64  `json:"train_loss,omitempty"`
+	EvalLoss     *float64  `json:"eval_loss,omitempty"`
+	LearningRate *float64  `json:"learning_rate,omitempty"`
+	GPUMemoryGB  *float64  `json:"gpu_memory_gb,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type GetFinetuneMetricsResponse struct {
+	Status           entities.FinetuneStatus    `json:"status"`
+	Metrics          []FinetuneMetricResponse   `json:"metrics"`
+	InferenceSamples []entities.InferenceSample `json:"inference_samples"`
+}
+
+func ToGetFinetuneMetricsResponse(result *in.GetFinetuneMetricsResult) *GetFinetuneMetricsResponse {
+	metrics := make([]FinetuneMetricResponse, len(result.Metrics))
+	for i, metric := range result.Metrics {
+		metrics[i] = FinetuneMetricResponse{
+			Step:         metric.Step,
+			Epoch:        metric.Epoch,
+			TrainLoss:    metric.TrainLoss,
+			EvalLoss:     metric.EvalLoss,
+			LearningRate: metric.LearningRate,
+			GPUMemoryGB:  metric.GPUMemoryGB,
+			CreatedAt:    metric.CreatedAt,
+		}
+	}
+
+	inferenceSamples := result.InferenceSamples
+	if inferenceSamples == nil {
+		inferenceSamples = []entities.InferenceSample{}
+	}
+
+	return &GetFinetuneMetricsResponse{
+		Status:           result.Status,
+		Metrics:          metrics,
+		InferenceSamples: inferenceSamples,
+	}
+}
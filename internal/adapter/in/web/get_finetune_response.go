@@ -2,6 +2,7 @@ package web
 
 import (
 	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/in"
 	"github.com/google/uuid"
 )
 
@@ -9,36 +10,62 @@ type GetFinetuneResponse struct {
 	ID                               uuid.UUID                    `json:"id"`
 	Version                          int                          `json:"version"`
 	Status                           entities.FinetuneStatus     `json:"status"`
+	FailureReason                    *string                      `json:"failure_reason,omitempty"`
+	Backend                          entities.FinetuneBackendType `json:"backend"`
+	Priority                         entities.FinetunePriority    `json:"priority"`
+	QueuePosition                    *int                         `json:"queue_position,omitempty"`
+	DispatchEvents                   []*entities.FinetuneDispatchEvent `json:"dispatch_events"`
 	BaseModelName                    string                       `json:"base_model_name"`
 	ModelName                        string                       `json:"model_name"`
 	TrainingDatasetID                uuid.UUID                   `json:"training_dataset_id"`
 	TrainingDatasetNumberExamples    *int                         `json:"training_dataset_number_examples"`
 	TrainingDatasetSelectRandom      bool                         `json:"training_dataset_select_random"`
+	TrainingDatasetMinQualityScore   *float64                     `json:"training_dataset_min_quality_score,omitempty"`
+	Hyperparameters                  *entities.FinetuneHyperparameters `json:"hyperparameters,omitempty"`
 	ModelSizeGB                      *int                         `json:"model_size_gb"`
 	ModelSizeParameter               *int                         `json:"model_size_parameter"`
 	ModelDtype                       *string                      `json:"model_dtype"`
 	ModelQuantization                *string                      `json:"model_quantization"`
+	ModelFileSizeBytes               *int64                       `json:"model_file_size_bytes,omitempty"`
+	ModelSHA256                      *string                      `json:"model_sha256,omitempty"`
 	InferenceSamples                 []entities.InferenceSample   `json:"inference_samples"`
 	TrainingTimeSeconds              *float64                     `json:"training_time_seconds"`
 	DeploymentID                     *uuid.UUID                   `json:"deployment_id,omitempty"`
 }
 
-func ToGetFinetuneResponse(finetune *entities.Finetune, deploymentID *uuid.UUID) *GetFinetuneResponse {
+func ToGetFinetuneResponse(result *in.GetFinetuneResult) *GetFinetuneResponse {
+	finetune := result.Finetune
+
+	// The position is only sent for queued finetunes
+	var queuePosition *int
+	if result.QueuePosition > 0 {
+		queuePosition = &result.QueuePosition
+	}
+
 	return &GetFinetuneResponse{
 		ID:                               finetune.ID,
 		Version:                          finetune.Version,
 		Status:                           finetune.Status,
+		FailureReason:                    finetune.FailureReason,
+		Backend:                          finetune.Backend,
+		Priority:                         finetune.Priority,
+		QueuePosition:                    queuePosition,
+		DispatchEvents:                   result.DispatchEvents,
 		BaseModelName:                    finetune.BaseModelName,
 		ModelName:                        finetune.ModelName,
 		TrainingDatasetID:                finetune.TrainingDatasetID,
 		TrainingDatasetNumberExamples:    finetune.TrainingDatasetNumberExamples,
 		TrainingDatasetSelectRandom:      finetune.TrainingDatasetSelectRandom,
+		TrainingDatasetMinQualityScore:   finetune.TrainingDatasetMinQualityScore,
+		Hyperparameters:                  finetune.Hyperparameters,
 		ModelSizeGB:                      finetune.ModelSizeGB,
 		ModelSizeParameter:               finetune.ModelSizeParameter,
 		ModelDtype:                       finetune.ModelDtype,
 		ModelQuantization:                finetune.ModelQuantization,
+		ModelFileSizeBytes:               finetune.ModelFileSizeBytes,
+		ModelSHA256:                      finetune.ModelSHA256,
 		InferenceSamples:                 finetune.InferenceSamples,
 		TrainingTimeSeconds:              finetune.TrainingTimeSeconds,
-		DeploymentID:                     deploymentID,
+		DeploymentID:                     result.DeploymentID,
 	}
 }
\ No newline at end of file
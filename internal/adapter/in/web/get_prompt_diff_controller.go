@@ -0,0 +1,79 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type GetPromptDiffController struct {
+	GetPromptDiffUseCase in.GetPromptDiffUseCase
+}
+
+func (c *GetPromptDiffController) GetPromptDiff(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	fromPromptIDStr := ctx.Param("prompt_id")
+	fromPromptID, err := uuid.Parse(fromPromptIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid prompt ID format",
+		})
+		return
+	}
+
+	toPromptIDStr := ctx.Param("other_prompt_id")
+	toPromptID, err := uuid.Parse(toPromptIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid prompt ID format",
+		})
+		return
+	}
+
+	command := in.GetPromptDiffCommand{
+		ProjectID:    projectID,
+		FromPromptID: fromPromptID,
+		ToPromptID:   toPromptID,
+		OwnerID:      userID,
+	}
+
+	result, err := c.GetPromptDiffUseCase.GetPromptDiff(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "project not found", "prompt not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to compare prompts",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToGetPromptDiffResponse(result))
+}
@@ -0,0 +1,34 @@
+package web
+
+import (
+	"ai-platform/internal/application/port/in"
+)
+
+type PromptDiffLineResponse struct {
+	Operation string `json:"operation"`
+	Text      string `json:"text"`
+}
+
+type GetPromptDiffResponse struct {
+	From  PromptResponse           `json:"from"`
+	To    PromptResponse           `json:"to"`
+	Lines []PromptDiffLineResponse `json:"lines"`
+}
+
+func ToGetPromptDiffResponse(result *in.GetPromptDiffResult) *GetPromptDiffResponse {
+	diff := result.Diff
+
+	lines := make([]PromptDiffLineResponse, 0, len(diff.Lines))
+	for _, line := range diff.Lines {
+		lines = append(lines, PromptDiffLineResponse{
+			Operation: string(line.Operation),
+			Text:      line.Text,
+		})
+	}
+
+	return &GetPromptDiffResponse{
+		From:  ToPromptResponse(diff.From),
+		To:    ToPromptResponse(diff.To),
+		Lines: lines,
+	}
+}
@@ -0,0 +1,24 @@
+package web
+
+import (
+	"ai-platform/internal/application/port/in"
+)
+
+type GetTrainingDataItemResponse struct {
+	FieldNames  []string                   `json:"field_names"`
+	Item        TrainingDataItemResponse   `json:"item"`
+	Corrections []TrainingDataItemResponse `json:"corrections"`
+}
+
+func ToGetTrainingDataItemResponse(result *in.GetTrainingDataItemResult) *GetTrainingDataItemResponse {
+	corrections := make([]TrainingDataItemResponse, 0, len(result.Corrections))
+	for i := range result.Corrections {
+		corrections = append(corrections, ToTrainingDataItemResponse(&result.Corrections[i]))
+	}
+
+	return &GetTrainingDataItemResponse{
+		FieldNames:  result.FieldNames,
+		Item:        ToTrainingDataItemResponse(result.Item),
+		Corrections: corrections,
+	}
+}
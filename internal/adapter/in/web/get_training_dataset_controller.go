@@ -61,6 +61,6 @@ func (c *GetTrainingDatasetController) GetTrainingDataset(ctx *gin.Context) {
 		return
 	}
 
-	response := ToGetTrainingDatasetResponse(result.TrainingDataset, result.GeneratePrompt, result.CorpusName)
+	response := ToGetTrainingDatasetResponse(result.TrainingDataset, result.GeneratePrompt, result.GeneratePromptVersion, result.CorpusName, result.PreviousVersionID)
 	ctx.JSON(http.StatusOK, response)
 }
\ No newline at end of file
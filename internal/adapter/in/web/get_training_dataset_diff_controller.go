@@ -0,0 +1,79 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type GetTrainingDatasetDiffController struct {
+	GetTrainingDatasetDiffUseCase in.GetTrainingDatasetDiffUseCase
+}
+
+func (c *GetTrainingDatasetDiffController) GetTrainingDatasetDiff(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	fromTrainingDatasetIDStr := ctx.Param("training_dataset_id")
+	fromTrainingDatasetID, err := uuid.Parse(fromTrainingDatasetIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training dataset ID format",
+		})
+		return
+	}
+
+	toTrainingDatasetIDStr := ctx.Param("other_training_dataset_id")
+	toTrainingDatasetID, err := uuid.Parse(toTrainingDatasetIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training dataset ID format",
+		})
+		return
+	}
+
+	command := in.GetTrainingDatasetDiffCommand{
+		ProjectID:             projectID,
+		FromTrainingDatasetID: fromTrainingDatasetID,
+		ToTrainingDatasetID:   toTrainingDatasetID,
+		OwnerID:               userID,
+	}
+
+	result, err := c.GetTrainingDatasetDiffUseCase.GetTrainingDatasetDiff(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "project not found", "training dataset not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to compare training datasets",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToGetTrainingDatasetDiffResponse(result))
+}
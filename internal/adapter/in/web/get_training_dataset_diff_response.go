@@ -0,0 +1,87 @@
+package web
+
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/in"
+)
+
+type TrainingDatasetDiffVersionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	Version    int       `json:"version"`
+	FieldNames []string  `json:"field_names"`
+}
+
+type TrainingDataItemChangeResponse struct {
+	From          TrainingDataItemResponse `json:"from"`
+	To            TrainingDataItemResponse `json:"to"`
+	ChangedFields []string                 `json:"changed_fields"`
+}
+
+type GetTrainingDatasetDiffResponse struct {
+	From              TrainingDatasetDiffVersionResponse `json:"from"`
+	To                TrainingDatasetDiffVersionResponse `json:"to"`
+	FieldNamesAdded   []string                           `json:"field_names_added"`
+	FieldNamesRemoved []string                           `json:"field_names_removed"`
+	FieldOrderChanged bool                               `json:"field_order_changed"`
+	Added             []TrainingDataItemResponse         `json:"added"`
+	Removed           []TrainingDataItemResponse         `json:"removed"`
+	Modified          []TrainingDataItemChangeResponse   `json:"modified"`
+	Corrected         []TrainingDataItemChangeResponse   `json:"corrected"`
+	Unchanged         int                                `json:"unchanged"`
+}
+
+func ToGetTrainingDatasetDiffResponse(result *in.GetTrainingDatasetDiffResult) *GetTrainingDatasetDiffResponse {
+	diff := result.Diff
+
+	response := &GetTrainingDatasetDiffResponse{
+		From: TrainingDatasetDiffVersionResponse{
+			ID:         diff.From.ID,
+			Version:    diff.From.Version,
+			FieldNames: diff.From.FieldNames,
+		},
+		To: TrainingDatasetDiffVersionResponse{
+			ID:         diff.To.ID,
+			Version:    diff.To.Version,
+			FieldNames: diff.To.FieldNames,
+		},
+		FieldNamesAdded:   nonNilStrings(diff.FieldNamesAdded),
+		FieldNamesRemoved: nonNilStrings(diff.FieldNamesRemoved),
+		FieldOrderChanged: diff.FieldOrderChanged,
+		Added:             toTrainingDataItemResponses(diff.Added),
+		Removed:           toTrainingDataItemResponses(diff.Removed),
+		Modified:          toTrainingDataItemChangeResponses(diff.Modified),
+		Corrected:         toTrainingDataItemChangeResponses(diff.Corrected),
+		Unchanged:         diff.Unchanged,
+	}
+
+	return response
+}
+
+func toTrainingDataItemResponses(items []entities.TrainingDataItem) []TrainingDataItemResponse {
+	responses := make([]TrainingDataItemResponse, 0, len(items))
+	for i := range items {
+		responses = append(responses, ToTrainingDataItemResponse(&items[i]))
+	}
+	return responses
+}
+
+func toTrainingDataItemChangeResponses(changes []entities.TrainingDataItemChange) []TrainingDataItemChangeResponse {
+	responses := make([]TrainingDataItemChangeResponse, 0, len(changes))
+	for i := range changes {
+		responses = append(responses, TrainingDataItemChangeResponse{
+			From:          ToTrainingDataItemResponse(&changes[i].From),
+			To:            ToTrainingDataItemResponse(&changes[i].To),
+			ChangedFields: nonNilStrings(changes[i].ChangedFields),
+		})
+	}
+	return responses
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
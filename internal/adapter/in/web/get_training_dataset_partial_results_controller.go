@@ -0,0 +1,79 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type GetTrainingDatasetPartialResultsController struct {
+	GetTrainingDatasetPartialResultsUseCase in.GetTrainingDatasetPartialResultsUseCase
+}
+
+func (c *GetTrainingDatasetPartialResultsController) GetTrainingDatasetPartialResults(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	trainingDatasetIDStr := ctx.Param("training_dataset_id")
+	trainingDatasetID, err := uuid.Parse(trainingDatasetIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training dataset ID format",
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid limit format",
+		})
+		return
+	}
+
+	command := in.GetTrainingDatasetPartialResultsCommand{
+		ProjectID:         projectID,
+		TrainingDatasetID: trainingDatasetID,
+		OwnerID:           userID,
+		Limit:             limit,
+	}
+
+	result, err := c.GetTrainingDatasetPartialResultsUseCase.GetTrainingDatasetPartialResults(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "project not found", "training dataset not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to fetch partial training dataset results",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToGetTrainingDatasetPartialResultsResponse(result))
+}
@@ -0,0 +1,28 @@
+package web
+
+import (
+	"ai-platform/internal/application/port/in"
+)
+
+type GetTrainingDatasetPartialResultsResponse struct {
+	FieldNames     []string                   `json:"field_names"`
+	ItemsGenerated int                        `json:"items_generated"`
+	TokensIn       int                        `json:"tokens_in"`
+	TokensOut      int                        `json:"tokens_out"`
+	Items          []TrainingDataItemResponse `json:"items"`
+}
+
+func ToGetTrainingDatasetPartialResultsResponse(result *in.GetTrainingDatasetPartialResultsResult) *GetTrainingDatasetPartialResultsResponse {
+	items := make([]TrainingDataItemResponse, 0, len(result.Items))
+	for i := range result.Items {
+		items = append(items, ToTrainingDataItemResponse(&result.Items[i]))
+	}
+
+	return &GetTrainingDatasetPartialResultsResponse{
+		FieldNames:     result.FieldNames,
+		ItemsGenerated: result.ItemsGenerated,
+		TokensIn:       result.TokensIn,
+		TokensOut:      result.TokensOut,
+		Items:          items,
+	}
+}
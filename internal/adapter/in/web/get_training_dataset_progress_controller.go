@@ -0,0 +1,69 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type GetTrainingDatasetProgressController struct {
+	GetTrainingDatasetProgressUseCase in.GetTrainingDatasetProgressUseCase
+}
+
+func (c *GetTrainingDatasetProgressController) GetTrainingDatasetProgress(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	trainingDatasetIDStr := ctx.Param("training_dataset_id")
+	trainingDatasetID, err := uuid.Parse(trainingDatasetIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training dataset ID format",
+		})
+		return
+	}
+
+	command := in.GetTrainingDatasetProgressCommand{
+		ProjectID:         projectID,
+		TrainingDatasetID: trainingDatasetID,
+		OwnerID:           userID,
+	}
+
+	result, err := c.GetTrainingDatasetProgressUseCase.GetTrainingDatasetProgress(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "project not found", "training dataset not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to fetch training dataset progress",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToGetTrainingDatasetProgressResponse(result))
+}
@@ -0,0 +1,47 @@
+package web
+
+import (
+	"time"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/in"
+)
+
+type TrainingDatasetProgressResponse struct {
+	ItemsGenerated  int       `json:"items_generated"`
+	ChunksProcessed int       `json:"chunks_processed"`
+	ChunksTotal     *int      `json:"chunks_total,omitempty"`
+	TokensIn        int       `json:"tokens_in"`
+	TokensOut       int       `json:"tokens_out"`
+	EtaSeconds      *float64  `json:"eta_seconds,omitempty"`
+	StartedAt       time.Time `json:"started_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+type GetTrainingDatasetProgressResponse struct {
+	Status                 entities.TrainingDatasetStatus   `json:"status"`
+	GenerateExamplesNumber int                              `json:"generate_examples_number"`
+	CompletedFraction      float64                          `json:"completed_fraction"`
+	Progress               *TrainingDatasetProgressResponse `json:"progress"`
+}
+
+func ToGetTrainingDatasetProgressResponse(result *in.GetTrainingDatasetProgressResult) *GetTrainingDatasetProgressResponse {
+	response := &GetTrainingDatasetProgressResponse{
+		Status:                 result.Status,
+		GenerateExamplesNumber: result.GenerateExamplesNumber,
+		CompletedFraction:      result.CompletedFraction,
+	}
+	if result.Progress != nil {
+		response.Progress = &TrainingDatasetProgressResponse{
+			ItemsGenerated:  result.Progress.ItemsGenerated,
+			ChunksProcessed: result.Progress.ChunksProcessed,
+			ChunksTotal:     result.Progress.ChunksTotal,
+			TokensIn:        result.Progress.TokensIn,
+			TokensOut:       result.Progress.TokensOut,
+			EtaSeconds:      result.Progress.EtaSeconds,
+			StartedAt:       result.Progress.StartedAt,
+			UpdatedAt:       result.Progress.UpdatedAt,
+		}
+	}
+	return response
+}
@@ -0,0 +1,75 @@
+package web
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/in"
+)
+
+type QualityScoreBucketResponse struct {
+	Score int `json:"score"`
+	Count int `json:"count"`
+}
+
+// QualityScoringJobResponse is the status and progress of a quality scoring job
+type QualityScoringJobResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Status      string     `json:"status"`
+	Model       string     `json:"model"`
+	Rescore     bool       `json:"rescore"`
+	ItemsTotal  int        `json:"items_total"`
+	ItemsScored int        `json:"items_scored"`
+	ItemsFailed int        `json:"items_failed"`
+	LastError   *string    `json:"last_error,omitempty"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type GetTrainingDatasetQualityScoresResponse struct {
+	Buckets       []QualityScoreBucketResponse `json:"buckets"`
+	ScoredItems   int                          `json:"scored_items"`
+	UnscoredItems int                          `json:"unscored_items"`
+	AverageScore  *float64                     `json:"average_score,omitempty"`
+	Job           *QualityScoringJobResponse   `json:"job,omitempty"`
+}
+
+func ToQualityScoringJobResponse(job *entities.QualityScoringJob) *QualityScoringJobResponse {
+	if job == nil {
+		return nil
+	}
+	return &QualityScoringJobResponse{
+		ID:          job.ID,
+		Status:      string(job.Status),
+		Model:       job.Model,
+		Rescore:     job.Rescore,
+		ItemsTotal:  job.ItemsTotal,
+		ItemsScored: job.ItemsScored,
+		ItemsFailed: job.ItemsFailed,
+		LastError:   job.LastError,
+		StartedAt:   job.StartedAt,
+		FinishedAt:  job.FinishedAt,
+		CreatedAt:   job.CreatedAt,
+	}
+}
+
+func ToGetTrainingDatasetQualityScoresResponse(result *in.GetTrainingDatasetQualityScoresResult) GetTrainingDatasetQualityScoresResponse {
+	buckets := make([]QualityScoreBucketResponse, 0, len(result.Histogram.Buckets))
+	for _, bucket := range result.Histogram.Buckets {
+		buckets = append(buckets, QualityScoreBucketResponse{
+			Score: bucket.Score,
+			Count: bucket.Count,
+		})
+	}
+
+	return GetTrainingDatasetQualityScoresResponse{
+		Buckets:       buckets,
+		ScoredItems:   result.Histogram.ScoredItems,
+		UnscoredItems: result.Histogram.UnscoredItems,
+		AverageScore:  result.Histogram.AverageScore,
+		Job:           ToQualityScoringJobResponse(result.Job),
+	}
+}
@@ -1,38 +1,65 @@
 package web
 
 import (
+	"github.com/google/uuid"
+
 	"ai-platform/internal/application/domain/entities"
 )
 
 type GetTrainingDatasetResponse struct {
-	Version                int        `json:"version"`
-	GeneratePrompt         string     `json:"generate_prompt"`
-	InputField             string     `json:"input_field"`
-	OutputField            string     `json:"output_field"`
-	GenerateExamplesNumber int        `json:"generate_examples_number"`
-	CorpusName             string     `json:"corpus_name"`
-	LanguageISO            string     `json:"language_iso"`
-	Status                 string     `json:"status"`
-	FieldNames             []string   `json:"field_names"`
-	TokensIn               *int       `json:"tokens_in,omitempty"`
-	TokensOut              *int       `json:"tokens_out,omitempty"`
-	DataItemsSample        [][]string `json:"data_items_sample"`
+	ID                       uuid.UUID                       `json:"id"`
+	Type                     string                          `json:"type"`
+	Version                  int                             `json:"version"`
+	PreviousVersionID        *uuid.UUID                      `json:"previous_version_id,omitempty"`
+	GeneratePrompt           string                          `json:"generate_prompt"`
+	GeneratePromptID         uuid.UUID                       `json:"generate_prompt_id"`
+	GeneratePromptVersion    int                             `json:"generate_prompt_version"`
+	InputField               string                          `json:"input_field"`
+	OutputField              string                          `json:"output_field"`
+	GenerateExamplesNumber   int                             `json:"generate_examples_number"`
+	CorpusName               string                          `json:"corpus_name"`
+	LanguageISO              string                          `json:"language_iso"`
+	Status                   string                          `json:"status"`
+	FailureReason            *string                         `json:"failure_reason,omitempty"`
+	ExtendsTrainingDatasetID *uuid.UUID                      `json:"extends_training_dataset_id,omitempty"`
+	FieldNames               []string                        `json:"field_names"`
+	TokensIn                 *int                            `json:"tokens_in,omitempty"`
+	TokensOut                *int                            `json:"tokens_out,omitempty"`
+	ChunkingConfig           *ChunkingConfigResponse         `json:"chunking_config,omitempty"`
+	Conversation             *ConversationConfigResponse     `json:"conversation,omitempty"`
+	DataItemsSample          [][]string                      `json:"data_items_sample"`
+	ConversationsSample      [][]ConversationMessageResponse `json:"conversations_sample,omitempty"`
 }
 
-func ToGetTrainingDatasetResponse(td *entities.TrainingDataset, prompt string, corpusName string) *GetTrainingDatasetResponse {
+func ToGetTrainingDatasetResponse(td *entities.TrainingDataset, prompt string, promptVersion int, corpusName string, previousVersionID *uuid.UUID) *GetTrainingDatasetResponse {
 	response := &GetTrainingDatasetResponse{
-		Version:                td.Version,
-		GeneratePrompt:         prompt,
-		InputField:             td.InputField,
-		OutputField:            td.OutputField,
-		GenerateExamplesNumber: td.GenerateExamplesNumber,
-		CorpusName:             corpusName,
-		LanguageISO:            td.LanguageISO,
-		Status:                 string(td.Status),
-		FieldNames:             td.FieldNames,
-		TokensIn:               td.TokensIn,
-		TokensOut:              td.TokensOut,
-		DataItemsSample:        [][]string{},
+		ID:                       td.ID,
+		Type:                     string(td.Type),
+		Version:                  td.Version,
+		PreviousVersionID:        previousVersionID,
+		GeneratePrompt:           prompt,
+		GeneratePromptID:         td.GeneratePromptID,
+		GeneratePromptVersion:    promptVersion,
+		InputField:               td.InputField,
+		OutputField:              td.OutputField,
+		GenerateExamplesNumber:   td.GenerateExamplesNumber,
+		CorpusName:               corpusName,
+		LanguageISO:              td.LanguageISO,
+		Status:                   string(td.Status),
+		FailureReason:            td.FailureReason,
+		ExtendsTrainingDatasetID: td.ExtendsTrainingDatasetID,
+		FieldNames:               td.FieldNames,
+		TokensIn:                 td.TokensIn,
+		TokensOut:                td.TokensOut,
+		DataItemsSample:          [][]string{},
+	}
+	if td.ChunkingConfig != nil {
+		chunkingConfig := ToChunkingConfigResponse(*td.ChunkingConfig)
+		response.ChunkingConfig = &chunkingConfig
+	}
+	if td.Conversation != nil {
+		conversation := ToConversationConfigResponse(*td.Conversation)
+		response.Conversation = &conversation
 	}
 
 	// Only include sample data if status is DONE
@@ -44,11 +71,15 @@ func ToGetTrainingDatasetResponse(td *entities.TrainingDataset, prompt string, c
 				break
 			}
 			if !item.Deleted {
-				response.DataItemsSample = append(response.DataItemsSample, item.Values)
+				if len(item.Messages) > 0 {
+					response.ConversationsSample = append(response.ConversationsSample, ToConversationMessageResponses(item.Messages))
+				} else {
+					response.DataItemsSample = append(response.DataItemsSample, item.Values)
+				}
 				sampleCount++
 			}
 		}
 	}
 
 	return response
-}
\ No newline at end of file
+}
@@ -0,0 +1,83 @@
+package web
+
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/in"
+)
+
+type DistributionResponse struct {
+	Count int     `json:"count"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Mean  float64 `json:"mean"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P99   float64 `json:"p99"`
+}
+
+type TrainingDatasetFieldStatsResponse struct {
+	FieldName   string               `json:"field_name"`
+	EmptyValues int                  `json:"empty_values"`
+	Characters  DistributionResponse `json:"characters"`
+	Tokens      DistributionResponse `json:"tokens"`
+}
+
+type GetTrainingDatasetStatsResponse struct {
+	TotalItems                 int                                 `json:"total_items"`
+	ActiveItems                int                                 `json:"active_items"`
+	DeletedItems               int                                 `json:"deleted_items"`
+	CorrectedItems             int                                 `json:"corrected_items"`
+	Fields                     []TrainingDatasetFieldStatsResponse `json:"fields"`
+	SourceDocuments            int                                 `json:"source_documents"`
+	ItemsWithoutSourceDocument int                                 `json:"items_without_source_document"`
+	ItemsPerSourceDocument     DistributionResponse                `json:"items_per_source_document"`
+	LanguageISO                string                              `json:"language_iso"`
+	LanguageChecked            bool                                `json:"language_checked"`
+	LanguageUndetectedItems    int                                 `json:"language_undetected_items"`
+	LanguageMismatchItemIDs    []uuid.UUID                         `json:"language_mismatch_item_ids"`
+	GenerationTimeSeconds      DistributionResponse                `json:"generation_time_seconds"`
+}
+
+func toDistributionResponse(distribution entities.Distribution) DistributionResponse {
+	return DistributionResponse{
+		Count: distribution.Count,
+		Min:   distribution.Min,
+		Max:   distribution.Max,
+		Mean:  distribution.Mean,
+		P50:   distribution.P50,
+		P90:   distribution.P90,
+		P99:   distribution.P99,
+	}
+}
+
+func ToGetTrainingDatasetStatsResponse(result *in.GetTrainingDatasetStatsResult) GetTrainingDatasetStatsResponse {
+	stats := result.Stats
+
+	fields := make([]TrainingDatasetFieldStatsResponse, 0, len(stats.Fields))
+	for _, field := range stats.Fields {
+		fields = append(fields, TrainingDatasetFieldStatsResponse{
+			FieldName:   field.FieldName,
+			EmptyValues: field.EmptyValues,
+			Characters:  toDistributionResponse(field.Characters),
+			Tokens:      toDistributionResponse(field.Tokens),
+		})
+	}
+
+	return GetTrainingDatasetStatsResponse{
+		TotalItems:                 stats.TotalItems,
+		ActiveItems:                stats.ActiveItems,
+		DeletedItems:               stats.DeletedItems,
+		CorrectedItems:             stats.CorrectedItems,
+		Fields:                     fields,
+		SourceDocuments:            stats.SourceDocuments,
+		ItemsWithoutSourceDocument: stats.ItemsWithoutSourceDocument,
+		ItemsPerSourceDocument:     toDistributionResponse(stats.ItemsPerSourceDocument),
+		LanguageISO:                stats.LanguageISO,
+		LanguageChecked:            stats.LanguageChecked,
+		LanguageUndetectedItems:    stats.LanguageUndetectedItems,
+		LanguageMismatchItemIDs:    stats.LanguageMismatchItemIDs,
+		GenerationTimeSeconds:      toDistributionResponse(stats.GenerationTimeSeconds),
+	}
+}
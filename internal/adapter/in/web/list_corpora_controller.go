@@ -0,0 +1,62 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type ListCorporaController struct {
+	ListCorporaUseCase in.ListCorporaUseCase
+}
+
+func (c *ListCorporaController) ListCorpora(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	var projectID *uuid.UUID
+	if projectIDStr := ctx.Query("project_id"); projectIDStr != "" {
+		parsed, err := uuid.Parse(projectIDStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid project ID format",
+			})
+			return
+		}
+		projectID = &parsed
+	}
+
+	command := in.ListCorporaCommand{
+		OwnerID:   userID,
+		ProjectID: projectID,
+	}
+
+	corpora, err := c.ListCorporaUseCase.ListCorpora(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "project not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to list corpora",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToListCorporaResponse(corpora))
+}
@@ -0,0 +1,59 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type ListPromptsController struct {
+	ListPromptsUseCase in.ListPromptsUseCase
+}
+
+func (c *ListPromptsController) ListPrompts(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	command := in.ListPromptsCommand{
+		ProjectID: projectID,
+		OwnerID:   userID,
+	}
+
+	result, err := c.ListPromptsUseCase.ListPrompts(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "project not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to list prompts",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToListPromptsResponse(result))
+}
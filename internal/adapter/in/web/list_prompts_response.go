@@ -0,0 +1,47 @@
+package web
+
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type PromptTrainingDatasetResponse struct {
+	TrainingDatasetID      uuid.UUID `json:"training_dataset_id"`
+	TrainingDatasetVersion int       `json:"training_dataset_version"`
+	Status                 string    `json:"status"`
+	Generated              bool      `json:"generated"`
+	Items                  int       `json:"items"`
+}
+
+type PromptHistoryEntryResponse struct {
+	PromptResponse
+	TrainingDatasets []PromptTrainingDatasetResponse `json:"training_datasets"`
+}
+
+type ListPromptsResponse struct {
+	Prompts []PromptHistoryEntryResponse `json:"prompts"`
+}
+
+func ToListPromptsResponse(result *in.ListPromptsResult) *ListPromptsResponse {
+	response := &ListPromptsResponse{
+		Prompts: make([]PromptHistoryEntryResponse, 0, len(result.History)),
+	}
+	for _, entry := range result.History {
+		trainingDatasets := make([]PromptTrainingDatasetResponse, 0, len(entry.TrainingDatasets))
+		for _, usage := range entry.TrainingDatasets {
+			trainingDatasets = append(trainingDatasets, PromptTrainingDatasetResponse{
+				TrainingDatasetID:      usage.TrainingDatasetID,
+				TrainingDatasetVersion: usage.TrainingDatasetVersion,
+				Status:                 string(usage.Status),
+				Generated:              usage.Generated,
+				Items:                  usage.Items,
+			})
+		}
+		response.Prompts = append(response.Prompts, PromptHistoryEntryResponse{
+			PromptResponse:   ToPromptResponse(entry.Prompt),
+			TrainingDatasets: trainingDatasets,
+		})
+	}
+	return response
+}
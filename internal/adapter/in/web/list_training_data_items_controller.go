@@ -0,0 +1,142 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type ListTrainingDataItemsController struct {
+	ListTrainingDataItemsUseCase in.ListTrainingDataItemsUseCase
+}
+
+func (c *ListTrainingDataItemsController) ListTrainingDataItems(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	trainingDatasetIDStr := ctx.Param("training_dataset_id")
+	trainingDatasetID, err := uuid.Parse(trainingDatasetIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training dataset ID format",
+		})
+		return
+	}
+
+	pageSize, err := strconv.Atoi(ctx.DefaultQuery("page_size", "50"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid page_size format",
+		})
+		return
+	}
+
+	// Deleted items are hidden unless they are asked for
+	deletedFalse := false
+	deleted := &deletedFalse
+	if ctx.Query("include_deleted") == "true" {
+		deleted = nil
+	}
+	if deletedStr := ctx.Query("deleted"); deletedStr != "" {
+		deleted, err = parseOptionalBoolQuery(deletedStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid deleted format, expected true, false or all",
+			})
+			return
+		}
+	}
+
+	corrected, err := parseOptionalBoolQuery(ctx.DefaultQuery("corrected", "all"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid corrected format, expected true, false or all",
+		})
+		return
+	}
+
+	var sourceDocument *string
+	if value, exists := ctx.GetQuery("source_document"); exists {
+		sourceDocument = &value
+	}
+
+	var generatePromptID *uuid.UUID
+	if value := ctx.Query("generate_prompt_id"); value != "" {
+		parsed, err := uuid.Parse(value)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid generate prompt ID format",
+			})
+			return
+		}
+		generatePromptID = &parsed
+	}
+
+	command := in.ListTrainingDataItemsCommand{
+		ProjectID:         projectID,
+		TrainingDatasetID: trainingDatasetID,
+		OwnerID:           userID,
+		Query:             ctx.Query("q"),
+		Deleted:           deleted,
+		Corrected:         corrected,
+		SourceDocument:    sourceDocument,
+		GeneratePromptID:  generatePromptID,
+		Cursor:            ctx.Query("cursor"),
+		PageSize:          pageSize,
+	}
+
+	result, err := c.ListTrainingDataItemsUseCase.ListTrainingDataItems(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "project not found", "training dataset not found", "training data item not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "invalid cursor":
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to fetch training data items",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToListTrainingDataItemsResponse(result))
+}
+
+// parseOptionalBoolQuery parses true, false or all, where all results in no filter
+func parseOptionalBoolQuery(value string) (*bool, error) {
+	if value == "all" {
+		return nil, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
@@ -0,0 +1,73 @@
+package web
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/in"
+)
+
+type TrainingDataItemResponse struct {
+	ID                    uuid.UUID                     `json:"id"`
+	Values                []string                      `json:"values"`
+	Messages              []ConversationMessageResponse `json:"messages,omitempty"`
+	CorrectsID            *uuid.UUID                    `json:"corrects_id,omitempty"`
+	SourceDocument        *string                       `json:"source_document,omitempty"`
+	SourceDocumentStart   *string                       `json:"source_document_start,omitempty"`
+	SourceDocumentEnd     *string                       `json:"source_document_end,omitempty"`
+	GenerationTimeSeconds float64                       `json:"generation_time_seconds"`
+	Deleted               bool                          `json:"deleted"`
+	DeletedReason         *string                       `json:"deleted_reason,omitempty"`
+	Split                 string                        `json:"split"`
+	QualityScore          *float64                      `json:"quality_score,omitempty"`
+	QualityRationale      *string                       `json:"quality_rationale,omitempty"`
+	GeneratePromptID      *uuid.UUID                    `json:"generate_prompt_id,omitempty"`
+	CreatedAt             time.Time                     `json:"created_at"`
+	UpdatedAt             time.Time                     `json:"updated_at"`
+}
+
+type ListTrainingDataItemsResponse struct {
+	FieldNames []string                   `json:"field_names"`
+	Items      []TrainingDataItemResponse `json:"items"`
+	TotalItems int                        `json:"total_items"`
+	PageSize   int                        `json:"page_size"`
+	NextCursor *string                    `json:"next_cursor"`
+}
+
+func ToTrainingDataItemResponse(item *entities.TrainingDataItem) TrainingDataItemResponse {
+	return TrainingDataItemResponse{
+		ID:                    item.ID,
+		Values:                item.Values,
+		Messages:              ToConversationMessageResponses(item.Messages),
+		CorrectsID:            item.CorrectsID,
+		SourceDocument:        item.SourceDocument,
+		SourceDocumentStart:   item.SourceDocumentStart,
+		SourceDocumentEnd:     item.SourceDocumentEnd,
+		GenerationTimeSeconds: item.GenerationTimeSeconds,
+		Deleted:               item.Deleted,
+		DeletedReason:         item.DeletedReason,
+		Split:                 string(item.Split),
+		QualityScore:          item.QualityScore,
+		QualityRationale:      item.QualityRationale,
+		GeneratePromptID:      item.GeneratePromptID,
+		CreatedAt:             item.CreatedAt,
+		UpdatedAt:             item.UpdatedAt,
+	}
+}
+
+func ToListTrainingDataItemsResponse(result *in.ListTrainingDataItemsResult) *ListTrainingDataItemsResponse {
+	items := make([]TrainingDataItemResponse, 0, len(result.Items))
+	for i := range result.Items {
+		items = append(items, ToTrainingDataItemResponse(&result.Items[i]))
+	}
+
+	return &ListTrainingDataItemsResponse{
+		FieldNames: result.FieldNames,
+		Items:      items,
+		TotalItems: result.TotalItems,
+		PageSize:   result.PageSize,
+		NextCursor: result.NextCursor,
+	}
+}
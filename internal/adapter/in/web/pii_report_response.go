@@ -0,0 +1,73 @@
+package web
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/in"
+)
+
+type PIIFindingResponse struct {
+	ItemID    uuid.UUID `json:"item_id"`
+	FieldName string    `json:"field_name"`
+	Type      string    `json:"type"`
+	Count     int       `json:"count"`
+}
+
+type PIIReportResponse struct {
+	ID                uuid.UUID            `json:"id"`
+	TrainingDatasetID uuid.UUID            `json:"training_dataset_id"`
+	FinetuneID        *uuid.UUID           `json:"finetune_id,omitempty"`
+	Redacted          bool                 `json:"redacted"`
+	ItemsScanned      int                  `json:"items_scanned"`
+	ItemsWithPII      int                  `json:"items_with_pii"`
+	Counts            map[string]int       `json:"counts"`
+	Findings          []PIIFindingResponse `json:"findings"`
+	CreatedAt         time.Time            `json:"created_at"`
+}
+
+type ListTrainingDatasetPIIReportsResponse struct {
+	Reports []PIIReportResponse `json:"reports"`
+}
+
+func ToPIIReportResponse(report *entities.PIIReport) PIIReportResponse {
+	counts := make(map[string]int, len(report.Counts))
+	for piiType, count := range report.Counts {
+		counts[string(piiType)] = count
+	}
+
+	findings := make([]PIIFindingResponse, 0, len(report.Findings))
+	for _, finding := range report.Findings {
+		findings = append(findings, PIIFindingResponse{
+			ItemID:    finding.ItemID,
+			FieldName: finding.FieldName,
+			Type:      string(finding.Type),
+			Count:     finding.Count,
+		})
+	}
+
+	return PIIReportResponse{
+		ID:                report.ID,
+		TrainingDatasetID: report.TrainingDatasetID,
+		FinetuneID:        report.FinetuneID,
+		Redacted:          report.Redacted,
+		ItemsScanned:      report.ItemsScanned,
+		ItemsWithPII:      report.ItemsWithPII,
+		Counts:            counts,
+		Findings:          findings,
+		CreatedAt:         report.CreatedAt,
+	}
+}
+
+func ToListTrainingDatasetPIIReportsResponse(result *in.ListTrainingDatasetPIIReportsResult) ListTrainingDatasetPIIReportsResponse {
+	reports := make([]PIIReportResponse, 0, len(result.Reports))
+	for _, report := range result.Reports {
+		reports = append(reports, ToPIIReportResponse(report))
+	}
+
+	return ListTrainingDatasetPIIReportsResponse{
+		Reports: reports,
+	}
+}
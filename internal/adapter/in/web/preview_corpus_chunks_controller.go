@@ -0,0 +1,79 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type PreviewCorpusChunksController struct {
+	PreviewCorpusChunksUseCase in.PreviewCorpusChunksUseCase
+}
+
+func (c *PreviewCorpusChunksController) PreviewCorpusChunks(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	corpusIDStr := ctx.Param("corpus_id")
+	corpusID, err := uuid.Parse(corpusIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid corpus ID format",
+		})
+		return
+	}
+
+	var request PreviewCorpusChunksRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	command := in.PreviewCorpusChunksCommand{
+		CorpusID: corpusID,
+		OwnerID:  userID,
+		Config:   request.ToEntity(),
+		FileName: request.FileName,
+		Limit:    request.Limit,
+	}
+
+	result, err := c.PreviewCorpusChunksUseCase.PreviewCorpusChunks(ctx.Request.Context(), command)
+	if err != nil {
+		switch {
+		case err.Error() == "corpus not found", err.Error() == "document not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case isChunkingConfigValidationError(err):
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to preview corpus chunks",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToPreviewCorpusChunksResponse(result))
+}
+
+func isChunkingConfigValidationError(err error) bool {
+	message := err.Error()
+	return strings.HasPrefix(message, "chunking strategy") ||
+		strings.HasPrefix(message, "chunk size") ||
+		strings.HasPrefix(message, "chunk overlap")
+}
@@ -0,0 +1,7 @@
+package web
+
+type PreviewCorpusChunksRequest struct {
+	ChunkingConfigRequest
+	FileName string `json:"file_name"`
+	Limit    int    `json:"limit"`
+}
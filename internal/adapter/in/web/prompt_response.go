@@ -0,0 +1,27 @@
+package web
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type PromptResponse struct {
+	ID               uuid.UUID  `json:"id"`
+	Version          int        `json:"version"`
+	PreviousPromptID *uuid.UUID `json:"previous_prompt_id,omitempty"`
+	Text             string     `json:"text"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+func ToPromptResponse(prompt *entities.Prompt) PromptResponse {
+	return PromptResponse{
+		ID:               prompt.ID,
+		Version:          prompt.Version,
+		PreviousPromptID: prompt.PreviousPromptID,
+		Text:             prompt.Text,
+		CreatedAt:        prompt.CreatedAt,
+	}
+}
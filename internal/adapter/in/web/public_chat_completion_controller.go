@@ -106,6 +106,7 @@ func (c *PublicChatCompletionController) GenerateChatCompletion(ctx *gin.Context
 		Temperature:  temperature,
 		TopP:         topP,
 		Stream:       stream,
+		RedactPII:    ctx.GetBool("redact_pii"),
 	}
 
 	// Handle streaming response
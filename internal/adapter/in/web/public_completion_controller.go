@@ -81,6 +81,7 @@ func (c *PublicCompletionController) GenerateCompletion(ctx *gin.Context) {
 		Temperature:  temperature,
 		TopP:         topP,
 		Stream:       stream,
+		RedactPII:    ctx.GetBool("redact_pii"),
 	}
 
 	// Handle streaming response
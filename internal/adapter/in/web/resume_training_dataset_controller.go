@@ -0,0 +1,77 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type ResumeTrainingDatasetController struct {
+	ResumeTrainingDatasetUseCase in.ResumeTrainingDatasetUseCase
+}
+
+func (c *ResumeTrainingDatasetController) ResumeTrainingDataset(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	trainingDatasetIDStr := ctx.Param("training_dataset_id")
+	trainingDatasetID, err := uuid.Parse(trainingDatasetIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training dataset ID format",
+		})
+		return
+	}
+
+	command := in.ResumeTrainingDatasetCommand{
+		ProjectID:         projectID,
+		TrainingDatasetID: trainingDatasetID,
+		OwnerID:           userID,
+		// Training datasets created before the model was stored are resumed with the default model
+		GenerateModel:       defaultGenerateModel,
+		GenerateModelRunner: defaultGenerateModelRunner,
+	}
+
+	result, err := c.ResumeTrainingDatasetUseCase.ResumeTrainingDataset(ctx.Request.Context(), command)
+	if err != nil {
+		switch {
+		case err.Error() == "project not found" || err.Error() == "training dataset not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case err.Error() == "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		case strings.HasPrefix(err.Error(), "invalid status transition"):
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to resume training dataset",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToResumeTrainingDatasetResponse(result))
+}
@@ -0,0 +1,19 @@
+package web
+
+import (
+	"ai-platform/internal/application/port/in"
+)
+
+type ResumeTrainingDatasetResponse struct {
+	ResumedExamplesNumber   int `json:"resumed_examples_number"`
+	RemainingExamplesNumber int `json:"remaining_examples_number"`
+	SkippedChunks           int `json:"skipped_chunks"`
+}
+
+func ToResumeTrainingDatasetResponse(result *in.ResumeTrainingDatasetResult) *ResumeTrainingDatasetResponse {
+	return &ResumeTrainingDatasetResponse{
+		ResumedExamplesNumber:   result.ResumedExamplesNumber,
+		RemainingExamplesNumber: result.RemainingExamplesNumber,
+		SkippedChunks:           result.SkippedChunks,
+	}
+}
@@ -0,0 +1,91 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type ScoreTrainingDatasetController struct {
+	ScoreTrainingDatasetUseCase in.ScoreTrainingDatasetUseCase
+}
+
+func (c *ScoreTrainingDatasetController) ScoreTrainingDataset(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	projectIDStr := ctx.Param("project_id")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid project ID format",
+		})
+		return
+	}
+
+	trainingDatasetIDStr := ctx.Param("training_dataset_id")
+	trainingDatasetID, err := uuid.Parse(trainingDatasetIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training dataset ID format",
+		})
+		return
+	}
+
+	// The request body is optional, without it the default rubric and judge model are used
+	var request ScoreTrainingDatasetRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil && ctx.Request.ContentLength > 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	command := in.ScoreTrainingDatasetCommand{
+		ProjectID:         projectID,
+		TrainingDatasetID: trainingDatasetID,
+		OwnerID:           userID,
+		Rubric:            request.Rubric,
+		Model:             request.Model,
+		Rescore:           request.Rescore,
+	}
+
+	result, err := c.ScoreTrainingDatasetUseCase.ScoreTrainingDataset(ctx.Request.Context(), command)
+	if err != nil {
+		switch {
+		case err.Error() == "project not found" || err.Error() == "training dataset not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case err.Error() == "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		case err.Error() == "training dataset must be in DONE status" || err.Error() == "training dataset is already being scored":
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		case strings.HasSuffix(err.Error(), "not found in field names"):
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to start scoring training dataset",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, ToScoreTrainingDatasetResponse(result))
+}
@@ -0,0 +1,7 @@
+package web
+
+type ScoreTrainingDatasetRequest struct {
+	Rubric  string `json:"rubric"`
+	Model   string `json:"model"`
+	Rescore bool   `json:"rescore"`
+}
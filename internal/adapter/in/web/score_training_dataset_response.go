@@ -0,0 +1,17 @@
+package web
+
+import (
+	"ai-platform/internal/application/port/in"
+)
+
+type ScoreTrainingDatasetResponse struct {
+	ItemsQueued int                        `json:"items_queued"`
+	Job         *QualityScoringJobResponse `json:"job"`
+}
+
+func ToScoreTrainingDatasetResponse(result *in.ScoreTrainingDatasetResult) ScoreTrainingDatasetResponse {
+	return ScoreTrainingDatasetResponse{
+		ItemsQueued: result.ItemsQueued,
+		Job:         ToQualityScoringJobResponse(result.Job),
+	}
+}
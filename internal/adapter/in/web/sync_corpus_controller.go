@@ -0,0 +1,59 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type SyncCorpusController struct {
+	SyncCorpusUseCase in.SyncCorpusUseCase
+}
+
+func (c *SyncCorpusController) SyncCorpus(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	corpusIDStr := ctx.Param("corpus_id")
+	corpusID, err := uuid.Parse(corpusIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid corpus ID format",
+		})
+		return
+	}
+
+	command := in.SyncCorpusCommand{
+		CorpusID: corpusID,
+		OwnerID:  userID,
+	}
+
+	result, err := c.SyncCorpusUseCase.SyncCorpus(ctx.Request.Context(), command)
+	if err != nil {
+		switch err.Error() {
+		case "corpus not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to sync corpus",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToSyncCorpusResponse(result))
+}
@@ -0,0 +1,74 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type UpdateCorpusFilesSubsetController struct {
+	UpdateCorpusFilesSubsetUseCase in.UpdateCorpusFilesSubsetUseCase
+}
+
+func (c *UpdateCorpusFilesSubsetController) UpdateCorpusFilesSubset(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	corpusIDStr := ctx.Param("corpus_id")
+	corpusID, err := uuid.Parse(corpusIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid corpus ID format",
+		})
+		return
+	}
+
+	var request UpdateCorpusFilesSubsetRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	command := in.UpdateCorpusFilesSubsetCommand{
+		CorpusID:    corpusID,
+		OwnerID:     userID,
+		FilesSubset: request.FilesSubset,
+	}
+
+	corpus, err := c.UpdateCorpusFilesSubsetUseCase.UpdateCorpusFilesSubset(ctx.Request.Context(), command)
+	if err != nil {
+		switch {
+		case err.Error() == "corpus not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case err.Error() == "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		case strings.HasPrefix(err.Error(), "file not found in corpus"), strings.HasPrefix(err.Error(), "duplicate file in files subset"):
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to update files subset",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ToCorpusResponse(corpus))
+}
@@ -0,0 +1,6 @@
+package web
+
+type UpdateCorpusFilesSubsetRequest struct {
+	// FilesSubset set to null uses all documents of the corpus
+	FilesSubset *[]string `json:"files_subset"`
+}
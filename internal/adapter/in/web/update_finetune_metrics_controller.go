@@ -0,0 +1,72 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type UpdateFinetuneMetricsController struct {
+	UpdateFinetuneMetricsUseCase in.UpdateFinetuneMetricsUseCase
+}
+
+func (c *UpdateFinetuneMetricsController) UpdateMetrics(ctx *gin.Context) {
+	finetuneIDStr := ctx.Param("finetune_id")
+	finetuneID, err := uuid.Parse(finetuneIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid finetune ID format",
+		})
+		return
+	}
+
+	var request UpdateFinetuneMetricsRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request format: " + err.Error(),
+		})
+		return
+	}
+
+	command := in.UpdateFinetuneMetricsCommand{
+		FinetuneID:       finetuneID,
+		Step:             request.Step,
+		Epoch:            request.Epoch,
+		TrainLoss:        request.TrainLoss,
+		EvalLoss:         request.EvalLoss,
+		LearningRate:     request.LearningRate,
+		GPUMemoryGB:      request.GPUMemoryGB,
+		InferenceSamples: ToInferenceSampleItems(request.InferenceSamples),
+	}
+
+	err = c.UpdateFinetuneMetricsUseCase.Execute(ctx.Request.Context(), command)
+	if err != nil {
+		switch {
+		case err.Error() == "finetune not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": "Finetune not found",
+			})
+		case err.Error() == "metric values must not be negative":
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		case strings.HasPrefix(err.Error(), "finetune is not running"):
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to update finetune metrics",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Finetune metrics updated successfully",
+	})
+}
@@ -0,0 +1,34 @@
+package web
+
+import "ai-platform/internal/application/domain/entities"
+
+type UpdateFinetuneMetricsRequest struct {
+	Step             int                          `json:"step"`
+	Epoch            float64                      `json:"epoch"`
+	TrainLoss        *float64                     `json:"train_loss"`
+	EvalLoss         *float64                     `json:"eval_loss"`
+	LearningRate     *float64                     `json:"learning_rate"`
+	GPUMemoryGB      *float64                     `json:"gpu_memory_gb"`
+	InferenceSamples []InferenceSampleItemRequest `json:"inference_samples"`
+}
+
+type InferenceSampleItemRequest struct {
+	Input  string `json:"input"`
+	Output string `json:"output"`
+}
+
+// ToInferenceSampleItems keeps nil apart from an empty list, nil means the step has no samples
+func ToInferenceSampleItems(requests []InferenceSampleItemRequest) []entities.InferenceSampleItem {
+	if requests == nil {
+		return nil
+	}
+
+	items := make([]entities.InferenceSampleItem, len(requests))
+	for i, request := range requests {
+		items[i] = entities.InferenceSampleItem{
+			Input:  request.Input,
+			Output: request.Output,
+		}
+	}
+	return items
+}
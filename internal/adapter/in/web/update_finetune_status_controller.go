@@ -3,6 +3,7 @@ package web
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -38,6 +39,7 @@ func (c *UpdateFinetuneStatusController) UpdateStatus(ctx *gin.Context) {
 	command := in.UpdateFinetuneStatusCommand{
 		FinetuneID: finetuneID,
 		Status:     request.Status,
+		Result:     request.Result.ToEntity(),
 	}
 
 	// Execute use case
@@ -52,12 +54,21 @@ func (c *UpdateFinetuneStatusController) UpdateStatus(ctx *gin.Context) {
 			})
 			return
 		}
-		if err.Error()[:22] == "invalid status transition" {
+		if strings.HasPrefix(err.Error(), "invalid status transition") ||
+			strings.HasPrefix(err.Error(), "model ") ||
+			strings.HasPrefix(err.Error(), "training time") {
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
+		// The status changed while the update was processed, e.g. because the user aborted
+		if strings.HasPrefix(err.Error(), "status conflict") {
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to update finetune status",
 		})
@@ -4,4 +4,29 @@ import "ai-platform/internal/application/domain/entities"
 
 type UpdateFinetuneStatusRequest struct {
 	Status entities.FinetuneStatus `json:"status" binding:"required"`
+	// Result is optional, the trainer can write a result manifest to S3 instead
+	Result *FinetuneResultRequest `json:"result"`
+}
+
+type FinetuneResultRequest struct {
+	ModelSizeGB         *int     `json:"model_size_gb"`
+	ModelSizeParameter  *int     `json:"model_size_parameter"`
+	ModelDtype          *string  `json:"model_dtype"`
+	ModelQuantization   *string  `json:"model_quantization"`
+	TrainingTimeSeconds *float64 `json:"training_time_seconds"`
+	ModelSHA256         *string  `json:"model_sha256"`
+}
+
+func (r *FinetuneResultRequest) ToEntity() *entities.FinetuneResult {
+	if r == nil {
+		return nil
+	}
+	return &entities.FinetuneResult{
+		ModelSizeGB:         r.ModelSizeGB,
+		ModelSizeParameter:  r.ModelSizeParameter,
+		ModelDtype:          r.ModelDtype,
+		ModelQuantization:   r.ModelQuantization,
+		TrainingTimeSeconds: r.TrainingTimeSeconds,
+		ModelSHA256:         r.ModelSHA256,
+	}
 }
\ No newline at end of file
@@ -0,0 +1,72 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/port/in"
+)
+
+type UpdateTrainingDatasetProgressController struct {
+	UpdateTrainingDatasetProgressUseCase in.UpdateTrainingDatasetProgressUseCase
+}
+
+func (c *UpdateTrainingDatasetProgressController) UpdateProgress(ctx *gin.Context) {
+	trainingDatasetIDStr := ctx.Param("training_dataset_id")
+	trainingDatasetID, err := uuid.Parse(trainingDatasetIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid training dataset ID format",
+		})
+		return
+	}
+
+	var request UpdateTrainingDatasetProgressRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request format: " + err.Error(),
+		})
+		return
+	}
+
+	command := in.UpdateTrainingDatasetProgressCommand{
+		TrainingDatasetID: trainingDatasetID,
+		ItemsGenerated:    request.ItemsGenerated,
+		ChunksProcessed:   request.ChunksProcessed,
+		ChunksTotal:       request.ChunksTotal,
+		TokensIn:          request.TokensIn,
+		TokensOut:         request.TokensOut,
+		EtaSeconds:        request.EtaSeconds,
+	}
+
+	err = c.UpdateTrainingDatasetProgressUseCase.Execute(ctx.Request.Context(), command)
+	if err != nil {
+		switch {
+		case err.Error() == "training dataset not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": "Training dataset not found",
+			})
+		case err.Error() == "progress values must not be negative",
+			err.Error() == "chunks processed must not exceed chunks total":
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		case strings.HasPrefix(err.Error(), "training dataset is not running"):
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to update training dataset progress",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Training dataset progress updated successfully",
+	})
+}
@@ -0,0 +1,10 @@
+package web
+
+type UpdateTrainingDatasetProgressRequest struct {
+	ItemsGenerated  int      `json:"items_generated"`
+	ChunksProcessed int      `json:"chunks_processed"`
+	ChunksTotal     *int     `json:"chunks_total"`
+	TokensIn        int      `json:"tokens_in"`
+	TokensOut       int      `json:"tokens_out"`
+	EtaSeconds      *float64 `json:"eta_seconds"`
+}
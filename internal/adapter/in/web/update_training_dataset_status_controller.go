@@ -3,6 +3,7 @@ package web
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -36,8 +37,10 @@ func (c *UpdateTrainingDatasetStatusController) UpdateStatus(ctx *gin.Context) {
 
 	// Create command
 	command := in.UpdateTrainingDatasetStatusCommand{
-		TrainingDatasetID: trainingDatasetID,
-		Status:            request.Status,
+		TrainingDatasetID:   trainingDatasetID,
+		Status:              request.Status,
+		Deduplicate:         request.Deduplicate,
+		SimilarityThreshold: request.SimilarityThreshold,
 	}
 
 	// Execute use case
@@ -52,12 +55,19 @@ func (c *UpdateTrainingDatasetStatusController) UpdateStatus(ctx *gin.Context) {
 			})
 			return
 		}
-		if err.Error()[:22] == "invalid status transition" {
+		if strings.HasPrefix(err.Error(), "invalid status transition") {
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
+		// The status changed while the update was processed, e.g. because the user aborted
+		if strings.HasPrefix(err.Error(), "status conflict") {
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to update training dataset status",
 		})
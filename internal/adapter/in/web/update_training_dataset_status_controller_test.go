@@ -143,4 +143,63 @@ func TestUpdateTrainingDatasetStatusController_UpdateStatus_InvalidRequest(t *te
 	var response map[string]string
 	json.Unmarshal(w.Body.Bytes(), &response)
 	assert.Contains(t, response["error"], "Invalid request format")
-}
\ No newline at end of file
+}
+func TestUpdateTrainingDatasetStatusController_UpdateStatus_InvalidTransition(t *testing.T) {
+	mockUseCase := &mockUpdateTrainingDatasetStatusUseCase{}
+	controller := &UpdateTrainingDatasetStatusController{
+		UpdateTrainingDatasetStatusUseCase: mockUseCase,
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.PUT("/api/external/training-datasets/:training_dataset_id/update-status", controller.UpdateStatus)
+
+	request := UpdateTrainingDatasetStatusRequest{
+		Status: "INVALID",
+	}
+	requestBody, _ := json.Marshal(request)
+
+	trainingDatasetID := uuid.New()
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/api/external/training-datasets/"+trainingDatasetID.String()+"/update-status", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response map[string]string
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, "invalid status transition from PLANNING to INVALID", response["error"])
+}
+
+func TestUpdateTrainingDatasetStatusController_UpdateStatus_Conflict(t *testing.T) {
+	mockUseCase := &mockUpdateTrainingDatasetStatusUseCase{
+		executeError: fmt.Errorf("status conflict, the training dataset is not RUNNING anymore"),
+	}
+	controller := &UpdateTrainingDatasetStatusController{
+		UpdateTrainingDatasetStatusUseCase: mockUseCase,
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.PUT("/api/external/training-datasets/:training_dataset_id/update-status", controller.UpdateStatus)
+
+	request := UpdateTrainingDatasetStatusRequest{
+		Status: entities.TrainingDatasetStatusFailed,
+	}
+	requestBody, _ := json.Marshal(request)
+
+	trainingDatasetID := uuid.New()
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/api/external/training-datasets/"+trainingDatasetID.String()+"/update-status", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	var response map[string]string
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, "status conflict, the training dataset is not RUNNING anymore", response["error"])
+}
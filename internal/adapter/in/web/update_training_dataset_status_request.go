@@ -3,5 +3,7 @@ package web
 import "ai-platform/internal/application/domain/entities"
 
 type UpdateTrainingDatasetStatusRequest struct {
-	Status entities.TrainingDatasetStatus `json:"status" binding:"required"`
+	Status              entities.TrainingDatasetStatus `json:"status" binding:"required"`
+	Deduplicate         bool                           `json:"deduplicate"`
+	SimilarityThreshold float64                        `json:"similarity_threshold"`
 }
\ No newline at end of file
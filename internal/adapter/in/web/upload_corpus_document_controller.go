@@ -0,0 +1,103 @@
+package web
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+)
+
+type UploadCorpusDocumentController struct {
+	UploadCorpusDocumentUseCase in.UploadCorpusDocumentUseCase
+}
+
+func (c *UploadCorpusDocumentController) UploadCorpusDocument(ctx *gin.Context) {
+	userID, exists := GetUserIDFromContext(ctx)
+	if !exists {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "User ID not found in context",
+		})
+		return
+	}
+
+	corpusIDStr := ctx.Param("corpus_id")
+	corpusID, err := uuid.Parse(corpusIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid corpus ID format",
+		})
+		return
+	}
+
+	// Get the uploaded file
+	file, header, err := ctx.Request.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "No file uploaded or invalid file",
+		})
+		return
+	}
+	defer file.Close()
+
+	if header.Size > services.MaxCorpusDocumentSizeBytes {
+		ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{
+			"error": "Document is too large",
+		})
+		return
+	}
+
+	// Read file content
+	content, err := io.ReadAll(file)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to read file",
+		})
+		return
+	}
+
+	command := in.UploadCorpusDocumentCommand{
+		CorpusID: corpusID,
+		OwnerID:  userID,
+		FileName: filepath.Base(header.Filename),
+		Content:  content,
+	}
+
+	document, err := c.UploadCorpusDocumentUseCase.UploadCorpusDocument(ctx.Request.Context(), command)
+	if err != nil {
+		switch {
+		case err.Error() == "corpus not found":
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case err.Error() == "access denied":
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+			})
+		case isCorpusDocumentValidationError(err):
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to upload document",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, ToCorpusDocumentResponse(document))
+}
+
+func isCorpusDocumentValidationError(err error) bool {
+	message := err.Error()
+	return message == "invalid file name" ||
+		strings.HasPrefix(message, "file name ") ||
+		strings.HasPrefix(message, "document ") ||
+		strings.HasPrefix(message, "unsupported document format")
+}
@@ -42,16 +42,17 @@ func (c *UploadNewTrainingDatasetVersionController) UploadNewTrainingDatasetVers
 	}
 	defer file.Close()
 
-	// Validate file is CSV
-	if header.Header.Get("Content-Type") != "text/csv" && !isCsvFilename(header.Filename) {
+	// Detect the file format from the extension or content type
+	format, ok := detectImportFormat(header.Filename, header.Header.Get("Content-Type"))
+	if !ok {
 		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": "File must be a CSV file",
+			"error": "File must be a CSV, JSONL or JSON file",
 		})
 		return
 	}
 
 	// Read file content
-	csvData, err := io.ReadAll(file)
+	fileData, err := io.ReadAll(file)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to read file",
@@ -62,7 +63,8 @@ func (c *UploadNewTrainingDatasetVersionController) UploadNewTrainingDatasetVers
 	command := in.UploadNewTrainingDatasetVersionCommand{
 		ProjectID: projectID,
 		OwnerID:   userID,
-		CsvData:   csvData,
+		FileData:  fileData,
+		Format:    format,
 	}
 
 	result, err := c.UploadNewTrainingDatasetVersionUseCase.UploadNewTrainingDatasetVersion(command)
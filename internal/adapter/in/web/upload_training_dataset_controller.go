@@ -2,11 +2,15 @@ package web
 
 import (
 	"io"
+	"mime"
 	"net/http"
+	"path/filepath"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"ai-platform/internal/application/domain/entities"
 	"ai-platform/internal/application/port/in"
 )
 
@@ -51,16 +55,17 @@ func (c *UploadTrainingDatasetController) UploadTrainingDataset(ctx *gin.Context
 	}
 	defer file.Close()
 
-	// Validate file is CSV
-	if header.Header.Get("Content-Type") != "text/csv" && !isCsvFilename(header.Filename) {
+	// Detect the file format from the extension or content type
+	format, ok := detectImportFormat(header.Filename, header.Header.Get("Content-Type"))
+	if !ok {
 		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": "File must be a CSV file",
+			"error": "File must be a CSV, JSONL or JSON file",
 		})
 		return
 	}
 
 	// Read file content
-	csvData, err := io.ReadAll(file)
+	fileData, err := io.ReadAll(file)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to read file",
@@ -72,7 +77,8 @@ func (c *UploadTrainingDatasetController) UploadTrainingDataset(ctx *gin.Context
 		ProjectID:         projectID,
 		TrainingDatasetID: trainingDatasetID,
 		OwnerID:           userID,
-		CsvData:           csvData,
+		FileData:          fileData,
+		Format:            format,
 	}
 
 	result, err := c.UploadTrainingDatasetUseCase.UploadTrainingDataset(command)
@@ -90,6 +96,27 @@ func (c *UploadTrainingDatasetController) UploadTrainingDataset(ctx *gin.Context
 	})
 }
 
-func isCsvFilename(filename string) bool {
-	return len(filename) > 4 && filename[len(filename)-4:] == ".csv"
+// detectImportFormat determines the upload format, the file extension takes precedence
+// over the content type because browsers often send JSONL files as application/octet-stream
+func detectImportFormat(filename string, contentType string) (entities.TrainingDatasetImportFormat, bool) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return entities.TrainingDatasetImportFormatCSV, true
+	case ".jsonl", ".ndjson":
+		return entities.TrainingDatasetImportFormatJSONL, true
+	case ".json":
+		return entities.TrainingDatasetImportFormatJSON, true
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "text/csv":
+		return entities.TrainingDatasetImportFormatCSV, true
+	case "application/jsonl", "application/x-jsonlines", "application/x-ndjson":
+		return entities.TrainingDatasetImportFormatJSONL, true
+	case "application/json":
+		return entities.TrainingDatasetImportFormatJSON, true
+	}
+
+	return "", false
 }
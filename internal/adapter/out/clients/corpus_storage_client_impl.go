@@ -0,0 +1,124 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type CorpusStorageClientImpl struct {
+	s3Client *s3.Client
+	bucket   string
+}
+
+func NewCorpusStorageClientImpl() (*CorpusStorageClientImpl, error) {
+	cfg, err := config.LoadDefaultConfig(context.TODO(),
+		config.WithRegion(os.Getenv("AWS_DEFAULT_REGION")),
+		config.WithEndpointResolverWithOptions(aws.EndpointResolverWithOptionsFunc(
+			func(service, region string, options ...interface{}) (aws.Endpoint, error) {
+				if endpointURL := os.Getenv("AWS_ENDPOINT_URL"); endpointURL != "" {
+					return aws.Endpoint{
+						URL:               endpointURL,
+						HostnameImmutable: true,
+					}, nil
+				}
+				return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+			})),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	bucket := os.Getenv("APP_S3_BUCKET")
+	if bucket == "" {
+		return nil, fmt.Errorf("APP_S3_BUCKET environment variable is required")
+	}
+
+	return &CorpusStorageClientImpl{
+		s3Client: s3.NewFromConfig(cfg),
+		bucket:   bucket,
+	}, nil
+}
+
+// corpusPrefix turns the S3 path of a corpus (e.g. "/documents/eurlex") into an object key prefix
+func corpusPrefix(s3Path string) string {
+	return strings.Trim(s3Path, "/") + "/"
+}
+
+func (c *CorpusStorageClientImpl) UploadDocument(ctx context.Context, s3Path string, fileName string, contentType string, content []byte) error {
+	_, err := c.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(c.bucket),
+		Key:         aws.String(corpusPrefix(s3Path) + fileName),
+		Body:        bytes.NewReader(content),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload document to S3: %w", err)
+	}
+
+	return nil
+}
+
+func (c *CorpusStorageClientImpl) DownloadDocument(ctx context.Context, s3Path string, fileName string) ([]byte, error) {
+	result, err := c.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(corpusPrefix(s3Path) + fileName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download document from S3: %w", err)
+	}
+	defer result.Body.Close()
+
+	return io.ReadAll(result.Body)
+}
+
+func (c *CorpusStorageClientImpl) DeleteDocument(ctx context.Context, s3Path string, fileName string) error {
+	_, err := c.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(corpusPrefix(s3Path) + fileName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete document from S3: %w", err)
+	}
+
+	return nil
+}
+
+func (c *CorpusStorageClientImpl) ListDocuments(ctx context.Context, s3Path string) ([]entities.CorpusStorageObject, error) {
+	prefix := corpusPrefix(s3Path)
+
+	paginator := s3.NewListObjectsV2Paginator(c.s3Client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(prefix),
+	})
+
+	objects := []entities.CorpusStorageObject{}
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+		for _, obj := range page.Contents {
+			fileName := strings.TrimPrefix(aws.ToString(obj.Key), prefix)
+			// Only the files directly in the corpus path are documents
+			if fileName == "" || strings.Contains(fileName, "/") {
+				continue
+			}
+			objects = append(objects, entities.CorpusStorageObject{
+				FileName:  fileName,
+				SizeBytes: aws.ToInt64(obj.Size),
+			})
+		}
+	}
+
+	return objects, nil
+}
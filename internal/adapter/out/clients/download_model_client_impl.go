@@ -2,7 +2,10 @@ package clients
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
 	"os"
 
@@ -10,6 +13,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/google/uuid"
+
+	portClients "ai-platform/internal/application/port/out/clients"
 )
 
 type DownloadModelClientImpl struct {
@@ -46,9 +51,13 @@ func NewDownloadModelClientImpl() (*DownloadModelClientImpl, error) {
 	}, nil
 }
 
-func (c *DownloadModelClientImpl) DownloadModel(ctx context.Context, finetuneID uuid.UUID, modelName string) (io.ReadCloser, int64, error) {
-	appEnv := os.Getenv("APP_ENV")
-	key := fmt.Sprintf("%s/finetunes/%s/%s.gguf", appEnv, finetuneID.String(), modelName)
+// finetuneModelKey is the S3 key of the GGUF of a finetune, the result manifest is stored next to it
+func finetuneModelKey(finetuneID uuid.UUID, modelName string, extension string) string {
+	return fmt.Sprintf("%s/finetunes/%s/%s.%s", os.Getenv("APP_ENV"), finetuneID.String(), modelName, extension)
+}
+
+func (c *DownloadModelClientImpl) DownloadModel(ctx context.Context, finetuneID uuid.UUID, modelName string, artifact *portClients.ModelArtifact) (io.ReadCloser, int64, error) {
+	key := finetuneModelKey(finetuneID, modelName, "gguf")
 
 	// First get object metadata to check if file exists and get content length
 	headInput := &s3.HeadObjectInput{
@@ -61,6 +70,11 @@ func (c *DownloadModelClientImpl) DownloadModel(ctx context.Context, finetuneID
 		return nil, 0, fmt.Errorf("failed to get object metadata for %s: %w", key, err)
 	}
 
+	contentLength := *headResult.ContentLength
+	if artifact != nil && artifact.SizeBytes != contentLength {
+		return nil, 0, fmt.Errorf("model size mismatch for %s: expected %d bytes, found %d", key, artifact.SizeBytes, contentLength)
+	}
+
 	// Get the object for streaming
 	getObjectInput := &s3.GetObjectInput{
 		Bucket: aws.String(c.bucket),
@@ -72,7 +86,47 @@ func (c *DownloadModelClientImpl) DownloadModel(ctx context.Context, finetuneID
 		return nil, 0, fmt.Errorf("failed to get object %s: %w", key, err)
 	}
 
-	contentLength := *headResult.ContentLength
+	if artifact == nil {
+		return result.Body, contentLength, nil
+	}
+
+	return newChecksumVerifyingReader(result.Body, contentLength, artifact.SHA256), contentLength, nil
+}
 
-	return result.Body, contentLength, nil
-}
\ No newline at end of file
+// checksumVerifyingReader hashes the model while it is streamed. The read that completes the model returns no data
+// when the checksum does not match, so the download ends short of its content length and the client discards it.
+type checksumVerifyingReader struct {
+	body           io.ReadCloser
+	hash           hash.Hash
+	remaining      int64
+	expectedSHA256 string
+}
+
+func newChecksumVerifyingReader(body io.ReadCloser, size int64, expectedSHA256 string) *checksumVerifyingReader {
+	return &checksumVerifyingReader{
+		body:           body,
+		hash:           sha256.New(),
+		remaining:      size,
+		expectedSHA256: expectedSHA256,
+	}
+}
+
+func (r *checksumVerifyingReader) Read(p []byte) (int, error) {
+	n, err := r.body.Read(p)
+	r.hash.Write(p[:n])
+	r.remaining -= int64(n)
+
+	if (r.remaining == 0 && n > 0) || err == io.EOF {
+		if r.remaining != 0 {
+			return 0, fmt.Errorf("model size mismatch: %d bytes differ from the recorded size", r.remaining)
+		}
+		if actual := hex.EncodeToString(r.hash.Sum(nil)); actual != r.expectedSHA256 {
+			return 0, fmt.Errorf("model checksum mismatch: expected %s, got %s", r.expectedSHA256, actual)
+		}
+	}
+	return n, err
+}
+
+func (r *checksumVerifyingReader) Close() error {
+	return r.body.Close()
+}
@@ -1,7 +1,11 @@
 package clients
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
 	"os"
 	"testing"
 
@@ -47,7 +51,7 @@ func TestDownloadModelClientImpl_DownloadModel_PathConstruction(t *testing.T) {
 
 	// This will fail with AWS credentials error, but we can test the path construction logic
 	ctx := context.Background()
-	_, _, err = client.DownloadModel(ctx, finetuneID, modelName)
+	_, _, err = client.DownloadModel(ctx, finetuneID, modelName, nil)
 	if err == nil {
 		t.Error("Expected AWS error due to invalid credentials")
 	}
@@ -56,4 +60,45 @@ func TestDownloadModelClientImpl_DownloadModel_PathConstruction(t *testing.T) {
 	expectedKey := "finetunes/" + finetuneID.String() + "/" + modelName + ".gguf"
 	t.Logf("Expected S3 key: %s", expectedKey)
 	t.Logf("Actual error: %v", err)
-}
\ No newline at end of file
+}
+
+func TestChecksumVerifyingReader(t *testing.T) {
+	model := bytes.Repeat([]byte("gguf"), 10000)
+	sum := sha256.Sum256(model)
+	checksum := hex.EncodeToString(sum[:])
+
+	t.Run("Matching checksum", func(t *testing.T) {
+		reader := newChecksumVerifyingReader(io.NopCloser(bytes.NewReader(model)), int64(len(model)), checksum)
+
+		var out bytes.Buffer
+		if _, err := io.Copy(&out, reader); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !bytes.Equal(out.Bytes(), model) {
+			t.Error("Expected the whole model to be streamed")
+		}
+	})
+
+	t.Run("Checksum mismatch withholds the end of the model", func(t *testing.T) {
+		corrupted := append([]byte{}, model...)
+		corrupted[len(corrupted)-1] = 'x'
+		reader := newChecksumVerifyingReader(io.NopCloser(bytes.NewReader(corrupted)), int64(len(corrupted)), checksum)
+
+		var out bytes.Buffer
+		_, err := io.Copy(&out, reader)
+		if err == nil {
+			t.Fatal("Expected a checksum mismatch error")
+		}
+		if out.Len() >= len(model) {
+			t.Errorf("Expected a truncated download, got %d of %d bytes", out.Len(), len(model))
+		}
+	})
+
+	t.Run("Truncated model", func(t *testing.T) {
+		reader := newChecksumVerifyingReader(io.NopCloser(bytes.NewReader(model[:100])), int64(len(model)), checksum)
+
+		if _, err := io.Copy(io.Discard, reader); err == nil {
+			t.Error("Expected an error for a truncated model")
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package clients
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type FinetuneArtifactClientImpl struct {
+	s3Client *s3.Client
+	bucket   string
+}
+
+func NewFinetuneArtifactClientImpl() (*FinetuneArtifactClientImpl, error) {
+	cfg, err := config.LoadDefaultConfig(context.TODO(),
+		config.WithRegion(os.Getenv("AWS_DEFAULT_REGION")),
+		config.WithEndpointResolverWithOptions(aws.EndpointResolverWithOptionsFunc(
+			func(service, region string, options ...interface{}) (aws.Endpoint, error) {
+				if endpointURL := os.Getenv("AWS_ENDPOINT_URL"); endpointURL != "" {
+					return aws.Endpoint{
+						URL:               endpointURL,
+						HostnameImmutable: true,
+					}, nil
+				}
+				return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+			})),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	bucket := os.Getenv("APP_S3_BUCKET")
+	if bucket == "" {
+		return nil, fmt.Errorf("APP_S3_BUCKET environment variable is required")
+	}
+
+	return &FinetuneArtifactClientImpl{
+		s3Client: s3.NewFromConfig(cfg),
+		bucket:   bucket,
+	}, nil
+}
+
+func (c *FinetuneArtifactClientImpl) GetResultManifest(ctx context.Context, finetuneID uuid.UUID, modelName string) (*entities.FinetuneResult, error) {
+	key := finetuneModelKey(finetuneID, modelName, "manifest.json")
+
+	result, err := c.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get object %s: %w", key, err)
+	}
+	defer result.Body.Close()
+
+	var manifest FinetuneResultManifestModel
+	if err := json.NewDecoder(result.Body).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse result manifest %s: %w", key, err)
+	}
+
+	return manifest.ToEntity(), nil
+}
+
+func (c *FinetuneArtifactClientImpl) GetModelSize(ctx context.Context, finetuneID uuid.UUID, modelName string) (int64, error) {
+	key := finetuneModelKey(finetuneID, modelName, "gguf")
+
+	headResult, err := c.s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get object metadata for %s: %w", key, err)
+	}
+
+	return *headResult.ContentLength, nil
+}
+
+func (c *FinetuneArtifactClientImpl) ComputeModelChecksum(ctx context.Context, finetuneID uuid.UUID, modelName string) (string, error) {
+	key := finetuneModelKey(finetuneID, modelName, "gguf")
+
+	result, err := c.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get object %s: %w", key, err)
+	}
+	defer result.Body.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, result.Body); err != nil {
+		return "", fmt.Errorf("failed to read object %s: %w", key, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,24 @@
+package clients
+
+import "ai-platform/internal/application/domain/entities"
+
+// FinetuneResultManifestModel is the manifest the trainer writes next to the GGUF
+type FinetuneResultManifestModel struct {
+	ModelSizeGB         *int     `json:"model_size_gb"`
+	ModelSizeParameter  *int     `json:"model_size_parameter"`
+	ModelDtype          *string  `json:"model_dtype"`
+	ModelQuantization   *string  `json:"model_quantization"`
+	TrainingTimeSeconds *float64 `json:"training_time_seconds"`
+	SHA256              *string  `json:"sha256"`
+}
+
+func (m *FinetuneResultManifestModel) ToEntity() *entities.FinetuneResult {
+	return &entities.FinetuneResult{
+		ModelSizeGB:         m.ModelSizeGB,
+		ModelSizeParameter:  m.ModelSizeParameter,
+		ModelDtype:          m.ModelDtype,
+		ModelQuantization:   m.ModelQuantization,
+		TrainingTimeSeconds: m.TrainingTimeSeconds,
+		ModelSHA256:         m.SHA256,
+	}
+}
@@ -0,0 +1,37 @@
+package clients
+
+import (
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	portClients "ai-platform/internal/application/port/out/clients"
+)
+
+type FinetuneBackendsImpl struct {
+	backends       map[entities.FinetuneBackendType]portClients.FinetuneBackend
+	defaultBackend entities.FinetuneBackendType
+}
+
+// NewFinetuneBackendsImpl takes the enabled backends, the default has to be one of them
+func NewFinetuneBackendsImpl(backends map[entities.FinetuneBackendType]portClients.FinetuneBackend, defaultBackend entities.FinetuneBackendType) (*FinetuneBackendsImpl, error) {
+	if _, ok := backends[defaultBackend]; !ok {
+		return nil, fmt.Errorf("default finetune backend '%s' is not enabled", defaultBackend)
+	}
+
+	return &FinetuneBackendsImpl{
+		backends:       backends,
+		defaultBackend: defaultBackend,
+	}, nil
+}
+
+func (b *FinetuneBackendsImpl) Get(backendType entities.FinetuneBackendType) (portClients.FinetuneBackend, error) {
+	backend, ok := b.backends[backendType]
+	if !ok {
+		return nil, fmt.Errorf("finetune backend '%s' is not available", backendType)
+	}
+	return backend, nil
+}
+
+func (b *FinetuneBackendsImpl) Default() entities.FinetuneBackendType {
+	return b.defaultBackend
+}
@@ -0,0 +1,43 @@
+package clients
+
+import (
+	"testing"
+
+	"ai-platform/internal/application/domain/entities"
+	portClients "ai-platform/internal/application/port/out/clients"
+)
+
+func TestNewFinetuneBackendsImpl_RequiresEnabledDefault(t *testing.T) {
+	backends := map[entities.FinetuneBackendType]portClients.FinetuneBackend{
+		entities.FinetuneBackendLocal: &LocalFinetuneBackendImpl{},
+	}
+
+	_, err := NewFinetuneBackendsImpl(backends, entities.FinetuneBackendRunpod)
+	if err == nil || err.Error() != "default finetune backend 'RUNPOD' is not enabled" {
+		t.Fatalf("Expected error for a default that is not enabled, got: %v", err)
+	}
+}
+
+func TestFinetuneBackendsImpl_Get(t *testing.T) {
+	local := &LocalFinetuneBackendImpl{}
+	backends, err := NewFinetuneBackendsImpl(map[entities.FinetuneBackendType]portClients.FinetuneBackend{
+		entities.FinetuneBackendLocal: local,
+	}, entities.FinetuneBackendLocal)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if backends.Default() != entities.FinetuneBackendLocal {
+		t.Fatalf("Expected LOCAL as default, got: %s", backends.Default())
+	}
+
+	backend, err := backends.Get(entities.FinetuneBackendLocal)
+	if err != nil || backend != local {
+		t.Fatalf("Expected the local backend, got: %v, %v", backend, err)
+	}
+
+	_, err = backends.Get(entities.FinetuneBackendRunpod)
+	if err == nil || err.Error() != "finetune backend 'RUNPOD' is not available" {
+		t.Fatalf("Expected error for a backend that is not enabled, got: %v", err)
+	}
+}
@@ -54,10 +54,13 @@ func (c *FinetuneJobClientImpl) SubmitJob(ctx context.Context, job entities.Fine
 	clientModel := FinetuneJobClientModel{
 		FinetuneID:        job.FinetuneID,
 		TrainingDatasetID: job.TrainingDatasetID,
+		DataFormat:        string(job.DataFormat),
 		InputField:        job.InputField,
 		OutputField:       job.OutputField,
 		UserID:            job.UserID,
+		Hyperparameters:   toFinetuneHyperparametersClientModel(job.Hyperparameters),
 		TrainingData:      job.TrainingData,
+		ValidationData:    job.ValidationData,
 	}
 
 	jobJSON, err := json.Marshal(clientModel)
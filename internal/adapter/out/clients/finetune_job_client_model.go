@@ -1,10 +1,44 @@
 package clients
 
+import "ai-platform/internal/application/domain/entities"
+
 type FinetuneJobClientModel struct {
 	FinetuneID        string                   `json:"finetune_id"`
 	TrainingDatasetID string                   `json:"training_dataset_id"`
+	DataFormat        string                   `json:"data_format"`
 	InputField        string                   `json:"input_field"`
 	OutputField       string                   `json:"output_field"`
 	UserID            string                   `json:"user_id"`
+	Hyperparameters   FinetuneHyperparametersClientModel `json:"hyperparameters"`
 	TrainingData      []map[string]interface{} `json:"training_data"`
-}
\ No newline at end of file
+	ValidationData    []map[string]interface{} `json:"validation_data,omitempty"`
+}
+type FinetuneHyperparametersClientModel struct {
+	Epochs                    int      `json:"epochs"`
+	LearningRate              float64  `json:"learning_rate"`
+	BatchSize                 int      `json:"batch_size"`
+	GradientAccumulationSteps int      `json:"gradient_accumulation_steps"`
+	LoraRank                  int      `json:"lora_rank"`
+	LoraAlpha                 int      `json:"lora_alpha"`
+	LoraDropout               float64  `json:"lora_dropout"`
+	TargetModules             []string `json:"target_modules"`
+	MaxSeqLength              int      `json:"max_seq_length"`
+	WarmupSteps               int      `json:"warmup_steps"`
+	Seed                      int      `json:"seed"`
+}
+
+func toFinetuneHyperparametersClientModel(hyperparameters entities.FinetuneHyperparameters) FinetuneHyperparametersClientModel {
+	return FinetuneHyperparametersClientModel{
+		Epochs:                    hyperparameters.Epochs,
+		LearningRate:              hyperparameters.LearningRate,
+		BatchSize:                 hyperparameters.BatchSize,
+		GradientAccumulationSteps: hyperparameters.GradientAccumulationSteps,
+		LoraRank:                  hyperparameters.LoraRank,
+		LoraAlpha:                 hyperparameters.LoraAlpha,
+		LoraDropout:               hyperparameters.LoraDropout,
+		TargetModules:             hyperparameters.TargetModules,
+		MaxSeqLength:              hyperparameters.MaxSeqLength,
+		WarmupSteps:               hyperparameters.WarmupSteps,
+		Seed:                      hyperparameters.Seed,
+	}
+}
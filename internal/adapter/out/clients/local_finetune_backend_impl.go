@@ -0,0 +1,361 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/uuid"
+
+	portClients "ai-platform/internal/application/port/out/clients"
+)
+
+// fakeTrainerStepsPerEpoch is the number of metrics the fake trainer reports per epoch
+const fakeTrainerStepsPerEpoch = 10
+
+// modelUploader stores the GGUF of the fake trainer where the model download expects it
+type modelUploader interface {
+	UploadModel(ctx context.Context, key string, data []byte) error
+}
+
+type s3ModelUploader struct {
+	s3Client *s3.Client
+	bucket   string
+}
+
+func (u *s3ModelUploader) UploadModel(ctx context.Context, key string, data []byte) error {
+	_, err := u.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(u.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/octet-stream"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload model to S3: %w", err)
+	}
+	return nil
+}
+
+// localFinetuneJobRetention is how long the status of an ended job is kept before it is UNKNOWN
+const localFinetuneJobRetention = 24 * time.Hour
+
+type localFinetuneJob struct {
+	status  portClients.FinetuneBackendJobStatus
+	cancel  context.CancelFunc
+	endedAt time.Time
+}
+
+// LocalFinetuneBackendImpl runs finetunes on the machine of the API, either with the command in
+// FINETUNE_LOCAL_COMMAND or with a fake trainer that reports metrics and uploads a dummy GGUF. The jobs only live in
+// memory, they are lost when the API restarts and ended jobs are UNKNOWN after the retention.
+type LocalFinetuneBackendImpl struct {
+	command      string
+	apiURL       string
+	apiKey       string
+	stepInterval time.Duration
+	client       *http.Client
+	uploader     modelUploader
+
+	mu   sync.Mutex
+	jobs map[string]*localFinetuneJob
+}
+
+func NewLocalFinetuneBackendImpl() (*LocalFinetuneBackendImpl, error) {
+	apiURL := os.Getenv("FINETUNE_LOCAL_API_URL")
+	if apiURL == "" {
+		port := os.Getenv("PORT")
+		if port == "" {
+			port = "8080"
+		}
+		apiURL = fmt.Sprintf("http://localhost:%s", port)
+	}
+
+	stepInterval := time.Second
+	if value := os.Getenv("FINETUNE_LOCAL_STEP_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("FINETUNE_LOCAL_STEP_INTERVAL must be a duration like 500ms")
+		}
+		stepInterval = parsed
+	}
+
+	backend := &LocalFinetuneBackendImpl{
+		command:      os.Getenv("FINETUNE_LOCAL_COMMAND"),
+		apiURL:       apiURL,
+		apiKey:       os.Getenv("APP_EXTERNAL_API_KEY"),
+		stepInterval: stepInterval,
+		client:       &http.Client{Timeout: 30 * time.Second},
+		jobs:         make(map[string]*localFinetuneJob),
+	}
+
+	// The command uploads its own model, only the fake trainer needs S3
+	if backend.command != "" {
+		return backend, nil
+	}
+
+	cfg, err := config.LoadDefaultConfig(context.TODO(),
+		config.WithRegion(os.Getenv("AWS_DEFAULT_REGION")),
+		config.WithEndpointResolverWithOptions(aws.EndpointResolverWithOptionsFunc(
+			func(service, region string, options ...interface{}) (aws.Endpoint, error) {
+				if endpointURL := os.Getenv("AWS_ENDPOINT_URL"); endpointURL != "" {
+					return aws.Endpoint{
+						URL:               endpointURL,
+						HostnameImmutable: true,
+					}, nil
+				}
+				return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+			})),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	bucket := os.Getenv("APP_S3_BUCKET")
+	if bucket == "" {
+		return nil, fmt.Errorf("APP_S3_BUCKET environment variable is required")
+	}
+
+	backend.uploader = &s3ModelUploader{
+		s3Client: s3.NewFromConfig(cfg),
+		bucket:   bucket,
+	}
+	return backend, nil
+}
+
+func (b *LocalFinetuneBackendImpl) Submit(ctx context.Context, job portClients.FinetuneBackendJob) (string, error) {
+	// The job outlives the request that submitted it
+	jobCtx, cancel := context.WithCancel(context.Background())
+	jobID := "local-" + uuid.New().String()
+
+	b.mu.Lock()
+	b.pruneEndedJobs()
+	b.jobs[jobID] = &localFinetuneJob{status: portClients.FinetuneBackendJobStatusQueued, cancel: cancel}
+	b.mu.Unlock()
+
+	go b.run(jobCtx, jobID, job)
+
+	return jobID, nil
+}
+
+func (b *LocalFinetuneBackendImpl) Cancel(ctx context.Context, jobID string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	job, ok := b.jobs[jobID]
+	if !ok {
+		return fmt.Errorf("local finetune job %s not found", jobID)
+	}
+	if !job.status.IsFinal() {
+		job.status = portClients.FinetuneBackendJobStatusCancelled
+	}
+	job.cancel()
+	return nil
+}
+
+func (b *LocalFinetuneBackendImpl) Status(ctx context.Context, jobID string) (portClients.FinetuneBackendJobStatus, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	job, ok := b.jobs[jobID]
+	if !ok {
+		return portClients.FinetuneBackendJobStatusUnknown, nil
+	}
+	return job.status, nil
+}
+
+// pruneEndedJobs drops the jobs that ended before the retention, the caller holds the lock
+func (b *LocalFinetuneBackendImpl) pruneEndedJobs() {
+	for jobID, job := range b.jobs {
+		if !job.endedAt.IsZero() && time.Since(job.endedAt) >= localFinetuneJobRetention {
+			delete(b.jobs, jobID)
+		}
+	}
+}
+
+// setStatus does not overwrite the status of a job that has ended, a cancelled job stays cancelled
+func (b *LocalFinetuneBackendImpl) setStatus(jobID string, status portClients.FinetuneBackendJobStatus) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if job, ok := b.jobs[jobID]; ok && !job.status.IsFinal() {
+		job.status = status
+	}
+}
+
+func (b *LocalFinetuneBackendImpl) run(ctx context.Context, jobID string, job portClients.FinetuneBackendJob) {
+	b.setStatus(jobID, portClients.FinetuneBackendJobStatusRunning)
+
+	var err error
+	if b.command != "" {
+		err = b.runCommand(ctx, job)
+	} else {
+		err = b.runFakeTrainer(ctx, job)
+	}
+
+	status := portClients.FinetuneBackendJobStatusCompleted
+	switch {
+	case ctx.Err() != nil:
+		status = portClients.FinetuneBackendJobStatusCancelled
+	case err != nil:
+		log.Printf("local finetune job %s failed: %v", jobID, err)
+		status = portClients.FinetuneBackendJobStatusFailed
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if ended, ok := b.jobs[jobID]; ok {
+		if !ended.status.IsFinal() {
+			ended.status = status
+		}
+		ended.endedAt = time.Now()
+	}
+}
+
+// runCommand runs the trainer command with the job in the environment, the command reports to the external API itself
+func (b *LocalFinetuneBackendImpl) runCommand(ctx context.Context, job portClients.FinetuneBackendJob) error {
+	hyperparametersJSON, err := json.Marshal(toFinetuneHyperparametersClientModel(job.Hyperparameters))
+	if err != nil {
+		return fmt.Errorf("failed to marshal hyperparameters: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", b.command)
+	cmd.Env = append(os.Environ(),
+		"FINETUNE_ID="+job.FinetuneID.String(),
+		"FINETUNE_JOB_S3_KEY="+job.JobS3Key,
+		"FINETUNE_DOCUMENTS_S3_PATH="+job.DocumentsS3Path,
+		"FINETUNE_BASE_MODEL_NAME="+job.BaseModelName,
+		"FINETUNE_MODEL_NAME="+job.ModelName,
+		"FINETUNE_HYPERPARAMETERS="+string(hyperparametersJSON),
+		"FINETUNE_API_URL="+b.apiURL,
+	)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("finetune command failed: %w", err)
+	}
+	return nil
+}
+
+// runFakeTrainer walks through the lifecycle of a real trainer without a GPU: it reports RUNNING, a decaying loss
+// for every step, uploads a dummy GGUF and reports DONE
+func (b *LocalFinetuneBackendImpl) runFakeTrainer(ctx context.Context, job portClients.FinetuneBackendJob) error {
+	startedAt := time.Now()
+
+	if err := b.report(ctx, job.FinetuneID, "update-status", map[string]interface{}{"status": "RUNNING"}); err != nil {
+		b.reportFailure(job.FinetuneID)
+		return err
+	}
+
+	epochs := job.Hyperparameters.Epochs
+	if epochs < 1 {
+		epochs = 1
+	}
+	steps := epochs * fakeTrainerStepsPerEpoch
+	for step := 1; step <= steps; step++ {
+		if err := b.wait(ctx); err != nil {
+			return err
+		}
+		if err := b.report(ctx, job.FinetuneID, "metrics", fakeTrainerMetric(job, step, steps)); err != nil {
+			b.reportFailure(job.FinetuneID)
+			return err
+		}
+	}
+
+	model := []byte(fmt.Sprintf("GGUF fake model %s of %s for finetune %s\n", job.ModelName, job.BaseModelName, job.FinetuneID))
+	if err := b.uploader.UploadModel(ctx, finetuneModelKey(job.FinetuneID, job.ModelName, "gguf"), model); err != nil {
+		b.reportFailure(job.FinetuneID)
+		return err
+	}
+
+	checksum := sha256.Sum256(model)
+	return b.report(ctx, job.FinetuneID, "update-status", map[string]interface{}{
+		"status": "DONE",
+		"result": map[string]interface{}{
+			"model_dtype":           "F16",
+			"model_quantization":    "Q4_K_M",
+			"training_time_seconds": time.Since(startedAt).Seconds(),
+			"model_sha256":          hex.EncodeToString(checksum[:]),
+		},
+	})
+}
+
+// fakeTrainerMetric has a loss that decays over the steps, the eval loss and inference samples are reported every
+// few steps like a real trainer does
+func fakeTrainerMetric(job portClients.FinetuneBackendJob, step int, steps int) map[string]interface{} {
+	progress := float64(step) / float64(steps)
+	metric := map[string]interface{}{
+		"step":          step,
+		"epoch":         float64(step) / fakeTrainerStepsPerEpoch,
+		"train_loss":    0.2 + 2.3*math.Exp(-3*progress),
+		"learning_rate": job.Hyperparameters.LearningRate * (1 - progress),
+	}
+	if step%5 == 0 || step == steps {
+		metric["eval_loss"] = 0.3 + 2.3*math.Exp(-2.5*progress)
+	}
+	if step%fakeTrainerStepsPerEpoch == 0 || step == steps {
+		metric["inference_samples"] = []map[string]string{{
+			"input":  "What is this model?",
+			"output": fmt.Sprintf("A fake finetune of %s after %d steps.", job.BaseModelName, step),
+		}}
+	}
+	return metric
+}
+
+func (b *LocalFinetuneBackendImpl) wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(b.stepInterval):
+		return nil
+	}
+}
+
+// reportFailure is best effort, the finetune stays in its status when the API can not be reached
+func (b *LocalFinetuneBackendImpl) reportFailure(finetuneID uuid.UUID) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	b.report(ctx, finetuneID, "update-status", map[string]interface{}{"status": "FAILED"})
+}
+
+// report sends a request to the external API like the Runpod trainer does
+func (b *LocalFinetuneBackendImpl) report(ctx context.Context, finetuneID uuid.UUID, endpoint string, body interface{}) error {
+	requestJSON, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/api/external/finetunes/%s/%s", b.apiURL, finetuneID, endpoint)
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewReader(requestJSON))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", b.apiKey)
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request to %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s returned status code %d", url, resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package clients
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	portClients "ai-platform/internal/application/port/out/clients"
+)
+
+type memoryModelUploader struct {
+	mu     sync.Mutex
+	models map[string][]byte
+}
+
+func (u *memoryModelUploader) UploadModel(ctx context.Context, key string, data []byte) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.models[key] = data
+	return nil
+}
+
+func newTestLocalFinetuneBackend(command string, apiURL string) *LocalFinetuneBackendImpl {
+	return &LocalFinetuneBackendImpl{
+		command:      command,
+		apiURL:       apiURL,
+		apiKey:       "test-api-key",
+		stepInterval: time.Millisecond,
+		client:       http.DefaultClient,
+		uploader:     &memoryModelUploader{models: make(map[string][]byte)},
+		jobs:         make(map[string]*localFinetuneJob),
+	}
+}
+
+func waitForLocalJobStatus(t *testing.T, backend *LocalFinetuneBackendImpl, jobID string, expected portClients.FinetuneBackendJobStatus) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		status, err := backend.Status(context.Background(), jobID)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if status == expected {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	status, _ := backend.Status(context.Background(), jobID)
+	t.Fatalf("Expected status %s, got: %s", expected, status)
+}
+
+func TestLocalFinetuneBackendImpl_Command(t *testing.T) {
+	backend := newTestLocalFinetuneBackend(`test "$FINETUNE_MODEL_NAME" = "qwen3_4b_test_v1" && echo "$FINETUNE_HYPERPARAMETERS" | grep -q '"epochs":2'`, "")
+
+	jobID, err := backend.Submit(context.Background(), portClients.FinetuneBackendJob{
+		FinetuneID:      uuid.New(),
+		ModelName:       "qwen3_4b_test_v1",
+		Hyperparameters: entities.FinetuneHyperparameters{Epochs: 2},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(jobID, "local-") {
+		t.Fatalf("Expected a local job ID, got: %s", jobID)
+	}
+
+	waitForLocalJobStatus(t, backend, jobID, portClients.FinetuneBackendJobStatusCompleted)
+}
+
+func TestLocalFinetuneBackendImpl_CommandFails(t *testing.T) {
+	backend := newTestLocalFinetuneBackend("exit 1", "")
+
+	jobID, err := backend.Submit(context.Background(), portClients.FinetuneBackendJob{FinetuneID: uuid.New()})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	waitForLocalJobStatus(t, backend, jobID, portClients.FinetuneBackendJobStatusFailed)
+}
+
+func TestLocalFinetuneBackendImpl_Cancel(t *testing.T) {
+	backend := newTestLocalFinetuneBackend("sleep 30", "")
+
+	jobID, err := backend.Submit(context.Background(), portClients.FinetuneBackendJob{FinetuneID: uuid.New()})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	waitForLocalJobStatus(t, backend, jobID, portClients.FinetuneBackendJobStatusRunning)
+
+	if err := backend.Cancel(context.Background(), jobID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	waitForLocalJobStatus(t, backend, jobID, portClients.FinetuneBackendJobStatusCancelled)
+
+	if err := backend.Cancel(context.Background(), "local-unknown"); err == nil {
+		t.Fatal("Expected error for an unknown job")
+	}
+	status, err := backend.Status(context.Background(), "local-unknown")
+	if err != nil || status != portClients.FinetuneBackendJobStatusUnknown {
+		t.Fatalf("Expected UNKNOWN for an unknown job, got: %s, %v", status, err)
+	}
+}
+
+func TestLocalFinetuneBackendImpl_PrunesEndedJobs(t *testing.T) {
+	backend := newTestLocalFinetuneBackend("exit 0", "")
+
+	endedJobID, err := backend.Submit(context.Background(), portClients.FinetuneBackendJob{FinetuneID: uuid.New()})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	waitForLocalJobStatus(t, backend, endedJobID, portClients.FinetuneBackendJobStatusCompleted)
+
+	// The ended job is kept for the retention, the next submit drops it once the retention has passed
+	backend.mu.Lock()
+	backend.jobs[endedJobID].endedAt = time.Now().Add(-localFinetuneJobRetention)
+	backend.mu.Unlock()
+
+	if _, err := backend.Submit(context.Background(), portClients.FinetuneBackendJob{FinetuneID: uuid.New()}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	status, err := backend.Status(context.Background(), endedJobID)
+	if err != nil || status != portClients.FinetuneBackendJobStatusUnknown {
+		t.Fatalf("Expected UNKNOWN for a pruned job, got: %s, %v", status, err)
+	}
+}
+
+func TestLocalFinetuneBackendImpl_FakeTrainer(t *testing.T) {
+	finetuneID := uuid.New()
+
+	var mu sync.Mutex
+	var statuses []string
+	var steps []int
+	var result map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != "test-api-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/api/external/finetunes/" + finetuneID.String() + "/update-status":
+			statuses = append(statuses, body["status"].(string))
+			if body["result"] != nil {
+				result = body["result"].(map[string]interface{})
+			}
+		case "/api/external/finetunes/" + finetuneID.String() + "/metrics":
+			steps = append(steps, int(body["step"].(float64)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	backend := newTestLocalFinetuneBackend("", server.URL)
+	jobID, err := backend.Submit(context.Background(), portClients.FinetuneBackendJob{
+		FinetuneID:      finetuneID,
+		BaseModelName:   "qwen3:4b",
+		ModelName:       "qwen3_4b_test_v1",
+		Hyperparameters: entities.FinetuneHyperparameters{Epochs: 2, LearningRate: 2e-4},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	waitForLocalJobStatus(t, backend, jobID, portClients.FinetuneBackendJobStatusCompleted)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(statuses) != 2 || statuses[0] != "RUNNING" || statuses[1] != "DONE" {
+		t.Fatalf("Expected RUNNING and DONE, got: %v", statuses)
+	}
+	if len(steps) != 2*fakeTrainerStepsPerEpoch || steps[0] != 1 || steps[len(steps)-1] != 2*fakeTrainerStepsPerEpoch {
+		t.Fatalf("Expected one metric per step, got: %v", steps)
+	}
+
+	model := backend.uploader.(*memoryModelUploader).models[finetuneModelKey(finetuneID, "qwen3_4b_test_v1", "gguf")]
+	if len(model) == 0 {
+		t.Fatal("Expected the dummy GGUF to be uploaded")
+	}
+	checksum := sha256.Sum256(model)
+	if result["model_sha256"] != hex.EncodeToString(checksum[:]) {
+		t.Fatalf("Expected the checksum of the uploaded model, got: %v", result["model_sha256"])
+	}
+}
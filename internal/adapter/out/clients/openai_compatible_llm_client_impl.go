@@ -0,0 +1,232 @@
+package clients
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	portClients "ai-platform/internal/application/port/out/clients"
+)
+
+// OpenAICompatibleLLMClientImpl talks directly to a server with an OpenAI-compatible API, e.g. a local Ollama,
+// vLLM or llama.cpp server. It is used instead of the Runpod client when OPENAI_COMPATIBLE_BASE_URL is set.
+type OpenAICompatibleLLMClientImpl struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+func NewOpenAICompatibleLLMClientImpl() (*OpenAICompatibleLLMClientImpl, error) {
+	baseURL := os.Getenv("OPENAI_COMPATIBLE_BASE_URL")
+	if baseURL == "" {
+		return nil, fmt.Errorf("OPENAI_COMPATIBLE_BASE_URL environment variable is required")
+	}
+
+	return &OpenAICompatibleLLMClientImpl{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		// The API key is optional, local servers usually do not check it
+		apiKey: os.Getenv("OPENAI_COMPATIBLE_API_KEY"),
+		client: &http.Client{
+			Timeout: 300 * time.Second,
+		},
+	}, nil
+}
+
+func (c *OpenAICompatibleLLMClientImpl) GenerateCompletion(ctx context.Context, finetuneID *string, prompt string, model string, maxTokens *int, temperature float64, topP float64) (*portClients.OllamaLLMClientResult, error) {
+	openaiInput := c.buildInput(finetuneID, model, maxTokens, temperature, topP, false)
+	openaiInput["prompt"] = prompt
+
+	return c.callAPI(ctx, "/v1/completions", openaiInput)
+}
+
+func (c *OpenAICompatibleLLMClientImpl) GenerateCompletionStream(ctx context.Context, finetuneID *string, prompt string, model string, maxTokens *int, temperature float64, topP float64) (<-chan portClients.StreamChunk, error) {
+	openaiInput := c.buildInput(finetuneID, model, maxTokens, temperature, topP, true)
+	openaiInput["prompt"] = prompt
+
+	return c.callStreamAPI(ctx, "/v1/completions", openaiInput)
+}
+
+func (c *OpenAICompatibleLLMClientImpl) GenerateChatCompletion(ctx context.Context, finetuneID *string, messages []portClients.ChatMessage, model string, maxTokens *int, temperature float64, topP float64) (*portClients.OllamaLLMClientResult, error) {
+	openaiInput := c.buildInput(finetuneID, model, maxTokens, temperature, topP, false)
+	openaiInput["messages"] = messages
+
+	return c.callAPI(ctx, "/v1/chat/completions", openaiInput)
+}
+
+func (c *OpenAICompatibleLLMClientImpl) GenerateChatCompletionStream(ctx context.Context, finetuneID *string, messages []portClients.ChatMessage, model string, maxTokens *int, temperature float64, topP float64) (<-chan portClients.StreamChunk, error) {
+	openaiInput := c.buildInput(finetuneID, model, maxTokens, temperature, topP, true)
+	openaiInput["messages"] = messages
+
+	return c.callStreamAPI(ctx, "/v1/chat/completions", openaiInput)
+}
+
+// buildInput creates the common request fields. There is no adapter loading on a plain OpenAI-compatible server,
+// so a finetune has to be served under its ID and the ID is used as the model name.
+func (c *OpenAICompatibleLLMClientImpl) buildInput(finetuneID *string, model string, maxTokens *int, temperature float64, topP float64, stream bool) map[string]interface{} {
+	if finetuneID != nil {
+		model = *finetuneID
+	}
+
+	openaiInput := map[string]interface{}{
+		"model":       model,
+		"temperature": temperature,
+		"top_p":       topP,
+		"stream":      stream,
+	}
+
+	// Only include max_tokens if provided
+	if maxTokens != nil {
+		openaiInput["max_tokens"] = *maxTokens
+	}
+
+	return openaiInput
+}
+
+func (c *OpenAICompatibleLLMClientImpl) newRequest(ctx context.Context, route string, openaiInput map[string]interface{}) (*http.Request, error) {
+	requestJSON, err := json.Marshal(openaiInput)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request to JSON: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+route, bytes.NewReader(requestJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	}
+
+	return req, nil
+}
+
+func (c *OpenAICompatibleLLMClientImpl) callAPI(ctx context.Context, route string, openaiInput map[string]interface{}) (*portClients.OllamaLLMClientResult, error) {
+	req, err := c.newRequest(ctx, route, openaiInput)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request to LLM API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("LLM API returned status code %d", resp.StatusCode)
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	executionTime := time.Since(startTime)
+
+	var responseData OpenAICompatibleResponseModel
+	if err := json.Unmarshal(bodyBytes, &responseData); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(responseData.Choices) == 0 {
+		return nil, fmt.Errorf("no completion choices in response")
+	}
+
+	// Extract response content - handle both completion (text) and chat completion (message)
+	var responseText string
+	choice := responseData.Choices[0]
+	if choice.Message != nil {
+		responseText = choice.Message.Content
+	} else {
+		responseText = choice.Text
+	}
+
+	return &portClients.OllamaLLMClientResult{
+		Response:      responseText,
+		TokensIn:      responseData.Usage.PromptTokens,
+		TokensOut:     responseData.Usage.CompletionTokens,
+		ExecutionTime: int(executionTime.Milliseconds()),
+	}, nil
+}
+
+func (c *OpenAICompatibleLLMClientImpl) callStreamAPI(ctx context.Context, route string, openaiInput map[string]interface{}) (<-chan portClients.StreamChunk, error) {
+	req, err := c.newRequest(ctx, route, openaiInput)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request to LLM API: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("LLM API returned status code %d", resp.StatusCode)
+	}
+
+	chunkChan := make(chan portClients.StreamChunk)
+
+	go func() {
+		defer close(chunkChan)
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			// Parse the SSE format: "data: {...}"
+			line := scanner.Text()
+			if !strings.HasPrefix(line, "data: ") {
+				continue
+			}
+
+			content := strings.TrimPrefix(line, "data: ")
+			if content == "[DONE]" {
+				return
+			}
+
+			var chunkData OpenAICompatibleStreamChunkModel
+			if err := json.Unmarshal([]byte(content), &chunkData); err != nil {
+				continue
+			}
+			if len(chunkData.Choices) == 0 {
+				continue
+			}
+
+			// Completions send the text, chat completions send a delta
+			choice := chunkData.Choices[0]
+			text := choice.Text
+			if choice.Delta != nil {
+				text = choice.Delta.Content
+			}
+			if text != "" {
+				chunkChan <- portClients.StreamChunk{
+					Content: text,
+				}
+			}
+
+			if choice.FinishReason != nil && *choice.FinishReason != "" {
+				reason := *choice.FinishReason
+				chunkChan <- portClients.StreamChunk{
+					FinishReason: &reason,
+				}
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			chunkChan <- portClients.StreamChunk{
+				Error: fmt.Errorf("error reading stream: %w", err),
+			}
+		}
+	}()
+
+	return chunkChan, nil
+}
@@ -0,0 +1,105 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	portClients "ai-platform/internal/application/port/out/clients"
+)
+
+func TestOpenAICompatibleLLMClientImpl_ValidatesEnvironmentVariables(t *testing.T) {
+	t.Setenv("OPENAI_COMPATIBLE_BASE_URL", "")
+	os.Unsetenv("OPENAI_COMPATIBLE_BASE_URL")
+
+	_, err := NewOpenAICompatibleLLMClientImpl()
+	if err == nil {
+		t.Fatal("Expected error when OPENAI_COMPATIBLE_BASE_URL is not set")
+	}
+	if err.Error() != "OPENAI_COMPATIBLE_BASE_URL environment variable is required" {
+		t.Fatalf("Expected specific error message, got: %s", err.Error())
+	}
+}
+
+func TestOpenAICompatibleLLMClientImpl_GenerateChatCompletion(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			t.Errorf("Unexpected authorization header: %s", r.Header.Get("Authorization"))
+		}
+		json.NewDecoder(r.Body).Decode(&request)
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"Hello"},"finish_reason":"stop"}],"usage":{"prompt_tokens":12,"completion_tokens":3}}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("OPENAI_COMPATIBLE_BASE_URL", server.URL+"/")
+	t.Setenv("OPENAI_COMPATIBLE_API_KEY", "test-key")
+
+	client, err := NewOpenAICompatibleLLMClientImpl()
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	finetuneID := "finetune-1"
+	messages := []portClients.ChatMessage{{Role: "user", Content: "Hi"}}
+	result, err := client.GenerateChatCompletion(context.Background(), &finetuneID, messages, "llama3", nil, 0.7, 0.9)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Response != "Hello" || result.TokensIn != 12 || result.TokensOut != 3 {
+		t.Fatalf("Unexpected result: %+v", result)
+	}
+	if request["model"] != "finetune-1" {
+		t.Fatalf("Expected the finetune ID as model, got: %v", request["model"])
+	}
+	if _, ok := request["max_tokens"]; ok {
+		t.Fatal("Expected max_tokens to be omitted")
+	}
+}
+
+func TestOpenAICompatibleLLMClientImpl_GenerateChatCompletionStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Write([]byte("data: {\"choices\":[{\"delta\":{\"content\":\"Hel\"}}]}\n\n"))
+		w.Write([]byte("data: {\"choices\":[{\"delta\":{\"content\":\"lo\"},\"finish_reason\":\"stop\"}]}\n\n"))
+		w.Write([]byte("data: [DONE]\n\n"))
+	}))
+	defer server.Close()
+
+	t.Setenv("OPENAI_COMPATIBLE_BASE_URL", server.URL)
+
+	client, err := NewOpenAICompatibleLLMClientImpl()
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	chunks, err := client.GenerateChatCompletionStream(context.Background(), nil, []portClients.ChatMessage{{Role: "user", Content: "Hi"}}, "llama3", nil, 0.7, 0.9)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	content := ""
+	var finishReason *string
+	for chunk := range chunks {
+		if chunk.Error != nil {
+			t.Fatalf("Unexpected stream error: %v", chunk.Error)
+		}
+		content += chunk.Content
+		if chunk.FinishReason != nil {
+			finishReason = chunk.FinishReason
+		}
+	}
+
+	if content != "Hello" {
+		t.Fatalf("Expected streamed content Hello, got: %s", content)
+	}
+	if finishReason == nil || *finishReason != "stop" {
+		t.Fatalf("Expected finish reason stop, got: %v", finishReason)
+	}
+}
@@ -8,12 +8,17 @@ import (
 	"net/http"
 	"os"
 	"time"
+
+	portClients "ai-platform/internal/application/port/out/clients"
 )
 
+const runpodAPIBaseURL = "https://api.runpod.ai/v2"
+
 type RunpodClientImpl struct {
-	apiKey string
-	podID  string
-	client *http.Client
+	apiKey  string
+	podID   string
+	baseURL string
+	client  *http.Client
 }
 
 func NewRunpodClientImpl() (*RunpodClientImpl, error) {
@@ -28,23 +33,25 @@ func NewRunpodClientImpl() (*RunpodClientImpl, error) {
 	}
 
 	return &RunpodClientImpl{
-		apiKey: apiKey,
-		podID:  podID,
+		apiKey:  apiKey,
+		podID:   podID,
+		baseURL: runpodAPIBaseURL,
 		client: &http.Client{
 			Timeout: 10 * time.Second,
 		},
 	}, nil
 }
 
-func (c *RunpodClientImpl) StartFinetuneJob(ctx context.Context, s3Key string, documentsS3Path string, baseModelName string, modelName string, finetuneID string) error {
+func (c *RunpodClientImpl) Submit(ctx context.Context, job portClients.FinetuneBackendJob) (string, error) {
 	// Create client model with environment configuration
 	clientModel := RunpodClientModel{
-		S3Bucket:               os.Getenv("APP_S3_BUCKET"),
-		TrainingDatasetS3Path:  s3Key,
-		DocumentsS3Path:        documentsS3Path,
-		BaseModelName:          baseModelName,
-		ModelName:              modelName,
-		FinetuneID:				finetuneID,
+		S3Bucket:              os.Getenv("APP_S3_BUCKET"),
+		TrainingDatasetS3Path: job.JobS3Key,
+		DocumentsS3Path:       job.DocumentsS3Path,
+		BaseModelName:         job.BaseModelName,
+		ModelName:             job.ModelName,
+		FinetuneID:            job.FinetuneID.String(),
+		Hyperparameters:       toFinetuneHyperparametersClientModel(job.Hyperparameters),
 	}
 
 	// Wrap the data in the required "input" field for Runpod API
@@ -54,20 +61,47 @@ func (c *RunpodClientImpl) StartFinetuneJob(ctx context.Context, s3Key string, d
 
 	requestJSON, err := json.Marshal(requestPayload)
 	if err != nil {
-		return fmt.Errorf("failed to marshal request to JSON: %w", err)
+		return "", fmt.Errorf("failed to marshal request to JSON: %w", err)
 	}
 
 	// Create HTTP request to Runpod API
-	url := fmt.Sprintf("https://api.runpod.ai/v2/%s/run", c.podID)
+	url := fmt.Sprintf("%s/%s/run", c.baseURL, c.podID)
 	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(requestJSON))
 	if err != nil {
-		return fmt.Errorf("failed to create HTTP request: %w", err)
+		return "", fmt.Errorf("failed to create HTTP request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
 
 	// Send request
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request to Runpod API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("Runpod API returned status code %d", resp.StatusCode)
+	}
+
+	var runResponse RunpodRunResponseModel
+	if err := json.NewDecoder(resp.Body).Decode(&runResponse); err != nil {
+		return "", fmt.Errorf("failed to decode Runpod API response: %w", err)
+	}
+
+	return runResponse.ID, nil
+}
+
+func (c *RunpodClientImpl) Cancel(ctx context.Context, jobID string) error {
+	url := fmt.Sprintf("%s/%s/cancel/%s", c.baseURL, c.podID, jobID)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to send request to Runpod API: %w", err)
@@ -79,4 +113,53 @@ func (c *RunpodClientImpl) StartFinetuneJob(ctx context.Context, s3Key string, d
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+func (c *RunpodClientImpl) Status(ctx context.Context, jobID string) (portClients.FinetuneBackendJobStatus, error) {
+	url := fmt.Sprintf("%s/%s/status/%s", c.baseURL, c.podID, jobID)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request to Runpod API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Runpod forgets jobs some time after they have ended
+	if resp.StatusCode == http.StatusNotFound {
+		return portClients.FinetuneBackendJobStatusUnknown, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("Runpod API returned status code %d", resp.StatusCode)
+	}
+
+	var statusResponse RunpodRunResponseModel
+	if err := json.NewDecoder(resp.Body).Decode(&statusResponse); err != nil {
+		return "", fmt.Errorf("failed to decode Runpod API response: %w", err)
+	}
+
+	return toFinetuneBackendJobStatus(statusResponse.Status), nil
+}
+
+// toFinetuneBackendJobStatus maps the job states of Runpod
+func toFinetuneBackendJobStatus(status string) portClients.FinetuneBackendJobStatus {
+	switch status {
+	case "IN_QUEUE":
+		return portClients.FinetuneBackendJobStatusQueued
+	case "IN_PROGRESS":
+		return portClients.FinetuneBackendJobStatusRunning
+	case "COMPLETED":
+		return portClients.FinetuneBackendJobStatusCompleted
+	case "FAILED", "TIMED_OUT":
+		return portClients.FinetuneBackendJobStatusFailed
+	case "CANCELLED":
+		return portClients.FinetuneBackendJobStatusCancelled
+	default:
+		return portClients.FinetuneBackendJobStatusUnknown
+	}
+}
@@ -2,11 +2,19 @@ package clients
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	portClients "ai-platform/internal/application/port/out/clients"
 )
 
-func TestRunpodClientImpl_StartFinetuneJob_ValidatesEnvironmentVariables(t *testing.T) {
+func TestRunpodClientImpl_Submit_ValidatesEnvironmentVariables(t *testing.T) {
 	// Clear environment variables to test validation
 	originalAPIKey := os.Getenv("RUNPOD_API_KEY")
 	originalPodID := os.Getenv("RUNPOD_POD_ID_FINETUNE")
@@ -41,7 +49,7 @@ func TestRunpodClientImpl_StartFinetuneJob_ValidatesEnvironmentVariables(t *test
 	}
 }
 
-func TestRunpodClientImpl_StartFinetuneJob_ValidatesRequestMarshaling(t *testing.T) {
+func TestRunpodClientImpl_Submit_ValidatesRequestMarshaling(t *testing.T) {
 	// Set required environment variables
 	os.Setenv("RUNPOD_API_KEY", "test-api-key")
 	os.Setenv("RUNPOD_POD_ID_FINETUNE", "test-pod-id")
@@ -62,15 +70,118 @@ func TestRunpodClientImpl_StartFinetuneJob_ValidatesRequestMarshaling(t *testing
 	// This will fail without a valid Runpod API endpoint, but we're testing the JSON marshaling
 	// and the method signature, not the actual API call
 	ctx := context.Background()
-	err = client.StartFinetuneJob(ctx,
-		"jobs/finetunes/250927101726_cb1b846e-ab09-417e-823c-475107bda72a.json",
-		"documents/eurlex/eng",
-		"qwen3:4b",
-		"qwen3b_4b_test_radio_buttons_v11",
-		"12345678-1234-1234-1234-123456789012")
+	_, err = client.Submit(ctx, portClients.FinetuneBackendJob{
+		FinetuneID:      uuid.MustParse("12345678-1234-1234-1234-123456789012"),
+		JobS3Key:        "jobs/finetunes/250927101726_cb1b846e-ab09-417e-823c-475107bda72a.json",
+		DocumentsS3Path: "documents/eurlex/eng",
+		BaseModelName:   "qwen3:4b",
+		ModelName:       "qwen3b_4b_test_radio_buttons_v11",
+		Hyperparameters: entities.FinetuneHyperparameters{Epochs: 3, LearningRate: 2e-4},
+	})
 	// We expect this to fail due to invalid endpoint/credentials, but not due to JSON marshaling
 	if err != nil {
 		// This is expected in a test environment without valid Runpod credentials
 		t.Logf("Expected Runpod API error: %v", err)
 	}
-}
\ No newline at end of file
+}
+
+func TestRunpodClientImpl_SubmitAndCancel(t *testing.T) {
+	var requests []string
+	var runRequest struct {
+		Input RunpodClientModel `json:"input"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer test-api-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path == "/test-pod-id/run" {
+			json.NewDecoder(r.Body).Decode(&runRequest)
+			w.Write([]byte(`{"id": "job-123", "status": "IN_QUEUE"}`))
+			return
+		}
+		w.Write([]byte(`{"id": "job-123", "status": "CANCELLED"}`))
+	}))
+	defer server.Close()
+
+	client := &RunpodClientImpl{
+		apiKey:  "test-api-key",
+		podID:   "test-pod-id",
+		baseURL: server.URL,
+		client:  server.Client(),
+	}
+
+	hyperparameters := entities.FinetuneHyperparameters{Epochs: 2, LearningRate: 1e-4, LoraRank: 32, TargetModules: []string{"q_proj", "v_proj"}}
+	jobID, err := client.Submit(context.Background(), portClients.FinetuneBackendJob{
+		FinetuneID:      uuid.MustParse("12345678-1234-1234-1234-123456789012"),
+		JobS3Key:        "jobs/finetunes/job.json",
+		BaseModelName:   "qwen3:4b",
+		ModelName:       "qwen3_4b_test_v1",
+		Hyperparameters: hyperparameters,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if jobID != "job-123" {
+		t.Fatalf("Expected job ID job-123, got: %s", jobID)
+	}
+	if runRequest.Input.FinetuneID != "12345678-1234-1234-1234-123456789012" {
+		t.Fatalf("Expected the finetune ID in the request, got: %s", runRequest.Input.FinetuneID)
+	}
+	if runRequest.Input.Hyperparameters.Epochs != 2 || runRequest.Input.Hyperparameters.LoraRank != 32 || len(runRequest.Input.Hyperparameters.TargetModules) != 2 {
+		t.Fatalf("Expected the hyperparameters in the request, got: %+v", runRequest.Input.Hyperparameters)
+	}
+
+	if err := client.Cancel(context.Background(), jobID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{"POST /test-pod-id/run", "POST /test-pod-id/cancel/job-123"}
+	if len(requests) != len(expected) || requests[0] != expected[0] || requests[1] != expected[1] {
+		t.Fatalf("Expected requests %v, got: %v", expected, requests)
+	}
+}
+
+func TestRunpodClientImpl_Status(t *testing.T) {
+	statuses := map[string]string{
+		"job-queued":    `{"id": "job-queued", "status": "IN_QUEUE"}`,
+		"job-running":   `{"id": "job-running", "status": "IN_PROGRESS"}`,
+		"job-completed": `{"id": "job-completed", "status": "COMPLETED"}`,
+		"job-timed-out": `{"id": "job-timed-out", "status": "TIMED_OUT"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for jobID, response := range statuses {
+			if r.Method == "GET" && r.URL.Path == "/test-pod-id/status/"+jobID {
+				w.Write([]byte(response))
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := &RunpodClientImpl{
+		apiKey:  "test-api-key",
+		podID:   "test-pod-id",
+		baseURL: server.URL,
+		client:  server.Client(),
+	}
+
+	expected := map[string]portClients.FinetuneBackendJobStatus{
+		"job-queued":    portClients.FinetuneBackendJobStatusQueued,
+		"job-running":   portClients.FinetuneBackendJobStatusRunning,
+		"job-completed": portClients.FinetuneBackendJobStatusCompleted,
+		"job-timed-out": portClients.FinetuneBackendJobStatusFailed,
+		"job-forgotten": portClients.FinetuneBackendJobStatusUnknown,
+	}
+	for jobID, expectedStatus := range expected {
+		status, err := client.Status(context.Background(), jobID)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %v", jobID, err)
+		}
+		if status != expectedStatus {
+			t.Fatalf("Expected status %s for %s, got: %s", expectedStatus, jobID, status)
+		}
+	}
+}
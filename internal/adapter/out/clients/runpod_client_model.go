@@ -7,4 +7,11 @@ type RunpodClientModel struct {
 	BaseModelName          string `json:"base_model_name"`
 	ModelName              string `json:"model_name"`
 	FinetuneID			   string `json:"finetune_id"`
-}
\ No newline at end of file
+	Hyperparameters        FinetuneHyperparametersClientModel `json:"hyperparameters"`
+}
+
+// RunpodRunResponseModel is the answer of Runpod to a submitted job
+type RunpodRunResponseModel struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
@@ -5,7 +5,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -13,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 
 	"ai-platform/internal/application/domain/entities"
+	portClients "ai-platform/internal/application/port/out/clients"
 )
 
 type TrainingDatasetJobClientImpl struct {
@@ -71,6 +74,27 @@ func (c *TrainingDatasetJobClientImpl) SubmitJob(ctx context.Context, job entiti
 		OutputField:             job.OutputField,
 		JSONObjectFields:        string(jsonObjectFieldsJSON),
 		ExpectedOutputSizeChars: job.ExpectedOutputSizeChars,
+		ResumedExamplesNumber:   job.ResumedExamplesNumber,
+	}
+	for _, chunk := range job.SkipChunks {
+		clientModel.SkipChunks = append(clientModel.SkipChunks, TrainingDatasetJobChunkClientModel{
+			FileName: chunk.FileName,
+			Start:    chunk.Start,
+			End:      chunk.End,
+		})
+	}
+	if job.Conversation != nil {
+		clientModel.Conversation = &TrainingDatasetJobConversationClientModel{
+			SystemPrompt: job.Conversation.SystemPrompt,
+			Turns:        job.Conversation.Turns,
+		}
+	}
+	if job.Chunking != nil {
+		clientModel.Chunking = &TrainingDatasetJobChunkingClientModel{
+			Strategy:        string(job.Chunking.Strategy),
+			ChunkSizeTokens: job.Chunking.ChunkSizeTokens,
+			OverlapTokens:   job.Chunking.OverlapTokens,
+		}
 	}
 
 	jobJSON, err := json.Marshal(clientModel)
@@ -78,8 +102,16 @@ func (c *TrainingDatasetJobClientImpl) SubmitJob(ctx context.Context, job entiti
 		return fmt.Errorf("failed to marshal job to JSON: %w", err)
 	}
 
-	appEnv := os.Getenv("APP_ENV")
-	key := fmt.Sprintf("%s/jobs/datasets/%s_%s.json", appEnv, time.Now().Format("060102150405"), job.TrainingDatasetID)
+	// A resumed job must not be cancelled by the marker of the earlier abort
+	_, err = c.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(cancelKey(job.TrainingDatasetID)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete cancel marker from S3: %w", err)
+	}
+
+	key := fmt.Sprintf("%s%s_%s.json", jobsPrefix(), time.Now().Format("060102150405"), job.TrainingDatasetID)
 
 	_, err = c.s3Client.PutObject(ctx, &s3.PutObjectInput{
 		Bucket:      aws.String(c.bucket),
@@ -92,4 +124,127 @@ func (c *TrainingDatasetJobClientImpl) SubmitJob(ctx context.Context, job entiti
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+func (c *TrainingDatasetJobClientImpl) CancelJob(ctx context.Context, trainingDatasetID string) error {
+	// Jobs that were not picked up yet are removed, a runner that already started sees the cancel marker
+	paginator := s3.NewListObjectsV2Paginator(c.s3Client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(jobsPrefix()),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list jobs: %w", err)
+		}
+
+		for _, object := range page.Contents {
+			key := aws.ToString(object.Key)
+			if !strings.HasSuffix(key, "_"+trainingDatasetID+".json") {
+				continue
+			}
+			if err := c.DeleteJob(ctx, key); err != nil {
+				return err
+			}
+		}
+	}
+
+	marker, err := json.Marshal(map[string]string{
+		"training_dataset_id": trainingDatasetID,
+		"cancelled_at":        time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal cancel marker to JSON: %w", err)
+	}
+
+	_, err = c.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(c.bucket),
+		Key:         aws.String(cancelKey(trainingDatasetID)),
+		Body:        bytes.NewReader(marker),
+		ContentType: aws.String("application/json"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload cancel marker to S3: %w", err)
+	}
+
+	return nil
+}
+
+func (c *TrainingDatasetJobClientImpl) ReceiveJobs(ctx context.Context) ([]portClients.ReceivedTrainingDatasetJob, error) {
+	// The keys start with the submission time, so the listing order is the submission order
+	paginator := s3.NewListObjectsV2Paginator(c.s3Client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(jobsPrefix()),
+	})
+
+	var jobs []portClients.ReceivedTrainingDatasetJob
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list jobs: %w", err)
+		}
+
+		for _, object := range page.Contents {
+			key := aws.ToString(object.Key)
+			if !strings.HasSuffix(key, ".json") {
+				continue
+			}
+
+			job, err := c.downloadJob(ctx, key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read job %s: %w", key, err)
+			}
+
+			jobs = append(jobs, portClients.ReceivedTrainingDatasetJob{
+				Key: key,
+				Job: *job,
+			})
+		}
+	}
+
+	return jobs, nil
+}
+
+func (c *TrainingDatasetJobClientImpl) DeleteJob(ctx context.Context, key string) error {
+	_, err := c.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete job from S3: %w", err)
+	}
+
+	return nil
+}
+
+func (c *TrainingDatasetJobClientImpl) downloadJob(ctx context.Context, key string) (*entities.TrainingDatasetJob, error) {
+	result, err := c.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer result.Body.Close()
+
+	jobJSON, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var clientModel TrainingDatasetJobClientModel
+	if err := json.Unmarshal(jobJSON, &clientModel); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal job: %w", err)
+	}
+
+	return clientModel.ToEntity()
+}
+
+func jobsPrefix() string {
+	return fmt.Sprintf("%s/jobs/datasets/", os.Getenv("APP_ENV"))
+}
+
+// cancelKey is outside of the jobs prefix, so runners listing the jobs never read a marker as a job
+func cancelKey(trainingDatasetID string) string {
+	return fmt.Sprintf("%s/jobs/cancelled/datasets/%s.json", os.Getenv("APP_ENV"), trainingDatasetID)
+}
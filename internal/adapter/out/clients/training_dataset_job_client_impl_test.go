@@ -64,4 +64,43 @@ func TestTrainingDatasetJobClientImpl_SubmitJob_ValidatesJobMarshaling(t *testin
 		// This is expected in a test environment without AWS credentials
 		t.Logf("Expected AWS error: %v", err)
 	}
-}
\ No newline at end of file
+}
+func TestTrainingDatasetJobClientModel_ToEntity(t *testing.T) {
+	clientModel := TrainingDatasetJobClientModel{
+		CorpusS3Path:           "/documents/eurlex",
+		LanguageISO:            "deu",
+		TrainingDatasetID:      uuid.New().String(),
+		GenerateExamplesNumber: 10,
+		JSONObjectFields:       `{"question":"A question about the text","answer":"The answer"}`,
+		Chunking: &TrainingDatasetJobChunkingClientModel{
+			Strategy:        "paragraph",
+			ChunkSizeTokens: 512,
+			OverlapTokens:   64,
+		},
+		SkipChunks:            []TrainingDatasetJobChunkClientModel{{FileName: "doc.md", Start: 0, End: 120}},
+		ResumedExamplesNumber: 4,
+		Conversation:          &TrainingDatasetJobConversationClientModel{SystemPrompt: "You are a support agent.", Turns: 3},
+	}
+
+	job, err := clientModel.ToEntity()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if job.JSONObjectFields["answer"] != "The answer" || len(job.JSONObjectFields) != 2 {
+		t.Fatalf("Unexpected json_object_fields: %v", job.JSONObjectFields)
+	}
+	if job.Chunking == nil || job.Chunking.Strategy != entities.CorpusChunkingStrategyParagraph || job.Chunking.ChunkSizeTokens != 512 {
+		t.Fatalf("Unexpected chunking config: %+v", job.Chunking)
+	}
+	if len(job.SkipChunks) != 1 || job.SkipChunks[0].FileName != "doc.md" || job.SkipChunks[0].End != 120 || job.ResumedExamplesNumber != 4 {
+		t.Fatalf("Unexpected resume fields: %+v %d", job.SkipChunks, job.ResumedExamplesNumber)
+	}
+	if job.Conversation == nil || job.Conversation.SystemPrompt != "You are a support agent." || job.Conversation.Turns != 3 {
+		t.Fatalf("Unexpected conversation config: %+v", job.Conversation)
+	}
+
+	clientModel.JSONObjectFields = "not json"
+	if _, err := clientModel.ToEntity(); err == nil {
+		t.Fatal("Expected error for invalid json_object_fields")
+	}
+}
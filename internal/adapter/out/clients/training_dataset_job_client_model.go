@@ -1,5 +1,12 @@
 package clients
 
+import (
+	"encoding/json"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
 type TrainingDatasetJobClientModel struct {
 	CorpusS3Path            string   `json:"corpus_s3_path"`
 	CorpusFilesSubset       []string `json:"corpus_files_subset"`
@@ -14,4 +21,76 @@ type TrainingDatasetJobClientModel struct {
 	OutputField             string   `json:"output_field"`
 	JSONObjectFields        string   `json:"json_object_fields"`
 	ExpectedOutputSizeChars int      `json:"expected_output_size_chars"`
-}
\ No newline at end of file
+	// Chunking is omitted when the runner should split the documents itself
+	Chunking *TrainingDatasetJobChunkingClientModel `json:"chunking,omitempty"`
+	// SkipChunks and ResumedExamplesNumber are only set when a generation is resumed
+	SkipChunks            []TrainingDatasetJobChunkClientModel `json:"skip_chunks,omitempty"`
+	ResumedExamplesNumber int                                  `json:"resumed_examples_number,omitempty"`
+	// Conversation is set when the runner generates conversations instead of records
+	Conversation *TrainingDatasetJobConversationClientModel `json:"conversation,omitempty"`
+}
+
+type TrainingDatasetJobConversationClientModel struct {
+	SystemPrompt string `json:"system_prompt"`
+	Turns        int    `json:"turns"`
+}
+
+type TrainingDatasetJobChunkClientModel struct {
+	FileName string `json:"file_name"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
+
+type TrainingDatasetJobChunkingClientModel struct {
+	Strategy        string `json:"strategy"`
+	ChunkSizeTokens int    `json:"chunk_size_tokens"`
+	OverlapTokens   int    `json:"overlap_tokens"`
+}
+
+func (m *TrainingDatasetJobClientModel) ToEntity() (*entities.TrainingDatasetJob, error) {
+	jsonObjectFields := map[string]string{}
+	if m.JSONObjectFields != "" {
+		if err := json.Unmarshal([]byte(m.JSONObjectFields), &jsonObjectFields); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal json_object_fields: %w", err)
+		}
+	}
+
+	job := &entities.TrainingDatasetJob{
+		CorpusS3Path:            m.CorpusS3Path,
+		CorpusFilesSubset:       m.CorpusFilesSubset,
+		LanguageISO:             m.LanguageISO,
+		UserID:                  m.UserID,
+		TrainingDatasetID:       m.TrainingDatasetID,
+		GeneratePrompt:          m.GeneratePrompt,
+		GenerateExamplesNumber:  m.GenerateExamplesNumber,
+		GenerateModel:           m.GenerateModel,
+		GenerateModelRunner:     m.GenerateModelRunner,
+		InputField:              m.InputField,
+		OutputField:             m.OutputField,
+		JSONObjectFields:        jsonObjectFields,
+		ExpectedOutputSizeChars: m.ExpectedOutputSizeChars,
+		ResumedExamplesNumber:   m.ResumedExamplesNumber,
+	}
+	for _, chunk := range m.SkipChunks {
+		job.SkipChunks = append(job.SkipChunks, entities.TrainingDatasetJobChunk{
+			FileName: chunk.FileName,
+			Start:    chunk.Start,
+			End:      chunk.End,
+		})
+	}
+	if m.Conversation != nil {
+		job.Conversation = &entities.ConversationConfig{
+			SystemPrompt: m.Conversation.SystemPrompt,
+			Turns:        m.Conversation.Turns,
+		}
+	}
+	if m.Chunking != nil {
+		job.Chunking = &entities.CorpusChunkingConfig{
+			Strategy:        entities.CorpusChunkingStrategy(m.Chunking.Strategy),
+			ChunkSizeTokens: m.Chunking.ChunkSizeTokens,
+			OverlapTokens:   m.Chunking.OverlapTokens,
+		}
+	}
+
+	return job, nil
+}
@@ -1,6 +1,7 @@
 package clients
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -18,6 +19,9 @@ import (
 	portClients "ai-platform/internal/application/port/out/clients"
 )
 
+// conversationMessagesKey is the annotation key of the messages of a generated conversation
+const conversationMessagesKey = "messages"
+
 type TrainingDatasetResultsClientImpl struct {
 	s3Client *s3.Client
 	bucket   string
@@ -53,6 +57,66 @@ func NewTrainingDatasetResultsClientImpl() (*TrainingDatasetResultsClientImpl, e
 }
 
 func (c *TrainingDatasetResultsClientImpl) GetTrainingDatasetResults(ctx context.Context, trainingDatasetID uuid.UUID, fieldNames []string) (*portClients.TrainingDatasetResult, error) {
+	results, fileCount, err := c.readResultsFiles(ctx, trainingDatasetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if fileCount == 0 {
+		return nil, fmt.Errorf("no JSON files found for training dataset %s", trainingDatasetID.String())
+	}
+
+	// Convert annotations to TrainingDataItem entities
+	trainingDataItems, err := c.convertAnnotationsToTrainingDataItems(results.Annotations, fieldNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert annotations: %w", err)
+	}
+
+	return &portClients.TrainingDatasetResult{
+		TotalGenerationTimeSeconds: results.TotalGenerationTime,
+		TokensIn:                   results.TokensIn,
+		TokensOut:                  results.TokensOut,
+		TrainingDataItems:          trainingDataItems,
+	}, nil
+}
+
+func (c *TrainingDatasetResultsClientImpl) GetPartialTrainingDatasetResults(ctx context.Context, trainingDatasetID uuid.UUID, fieldNames []string) (*portClients.TrainingDatasetResult, error) {
+	results, _, err := c.readResultsFiles(ctx, trainingDatasetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The runner may still be writing, annotations that are not complete yet are skipped instead of failing
+	var completeAnnotations []AnnotationModel
+	for _, annotation := range results.Annotations {
+		_, hasMessages := annotation.Fields[conversationMessagesKey]
+		complete := len(fieldNames) > 0 || hasMessages
+		for _, fieldName := range fieldNames {
+			if _, exists := annotation.Fields[fieldName]; !exists {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			completeAnnotations = append(completeAnnotations, annotation)
+		}
+	}
+
+	trainingDataItems, err := c.convertAnnotationsToTrainingDataItems(completeAnnotations, fieldNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert annotations: %w", err)
+	}
+
+	return &portClients.TrainingDatasetResult{
+		TotalGenerationTimeSeconds: results.TotalGenerationTime,
+		TokensIn:                   results.TokensIn,
+		TokensOut:                  results.TokensOut,
+		TrainingDataItems:          trainingDataItems,
+	}, nil
+}
+
+// readResultsFiles sums up all JSON files of the training dataset and returns how many files there are
+func (c *TrainingDatasetResultsClientImpl) readResultsFiles(ctx context.Context, trainingDatasetID uuid.UUID) (*TrainingDatasetResultsFileModel, int, error) {
 	appEnv := os.Getenv("APP_ENV")
 	// List all JSON files in the datasets/{training_dataset_id}/ path
 	prefix := fmt.Sprintf("%s/datasets/%s/", appEnv, trainingDatasetID.String())
@@ -68,7 +132,7 @@ func (c *TrainingDatasetResultsClientImpl) GetTrainingDatasetResults(ctx context
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list objects: %w", err)
+			return nil, 0, fmt.Errorf("failed to list objects: %w", err)
 		}
 		allObjects = append(allObjects, page.Contents...)
 	}
@@ -81,10 +145,6 @@ func (c *TrainingDatasetResultsClientImpl) GetTrainingDatasetResults(ctx context
 		}
 	}
 
-	if len(jsonFiles) == 0 {
-		return nil, fmt.Errorf("no JSON files found for training dataset %s", trainingDatasetID.String())
-	}
-
 	var totalGenerationTime float64
 	var totalTokensIn int
 	var totalTokensOut int
@@ -94,13 +154,13 @@ func (c *TrainingDatasetResultsClientImpl) GetTrainingDatasetResults(ctx context
 	for _, file := range jsonFiles {
 		fileData, err := c.downloadFile(ctx, *file.Key)
 		if err != nil {
-			return nil, fmt.Errorf("failed to download file %s: %w", *file.Key, err)
+			return nil, 0, fmt.Errorf("failed to download file %s: %w", *file.Key, err)
 		}
 
 		// Parse as raw JSON first to handle dynamic fields
 		var rawData map[string]interface{}
 		if err := json.Unmarshal(fileData, &rawData); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal file %s: %w", *file.Key, err)
+			return nil, 0, fmt.Errorf("failed to unmarshal file %s: %w", *file.Key, err)
 		}
 
 		// Extract the structured fields
@@ -153,18 +213,57 @@ func (c *TrainingDatasetResultsClientImpl) GetTrainingDatasetResults(ctx context
 		allAnnotations = append(allAnnotations, fileModel.Annotations...)
 	}
 
-	// Convert annotations to TrainingDataItem entities
-	trainingDataItems, err := c.convertAnnotationsToTrainingDataItems(allAnnotations, fieldNames)
+	return &TrainingDatasetResultsFileModel{
+		TotalGenerationTime: totalGenerationTime,
+		TokensIn:            totalTokensIn,
+		TokensOut:           totalTokensOut,
+		Annotations:         allAnnotations,
+	}, len(jsonFiles), nil
+}
+
+func (c *TrainingDatasetResultsClientImpl) PutTrainingDatasetResultsPart(ctx context.Context, trainingDatasetID uuid.UUID, partName string, part portClients.TrainingDatasetResultsPart) error {
+	fileModel := TrainingDatasetResultsFileModel{
+		TotalGenerationTime: part.TotalGenerationTimeSeconds,
+		TokensIn:            part.TokensIn,
+		TokensOut:           part.TokensOut,
+		Annotations:         make([]AnnotationModel, 0, len(part.Annotations)),
+	}
+	for _, annotation := range part.Annotations {
+		fields := make(map[string]interface{}, len(annotation.Fields))
+		for key, value := range annotation.Fields {
+			fields[key] = value
+		}
+		if len(annotation.Messages) > 0 {
+			fields[conversationMessagesKey] = annotation.Messages
+		}
+		fileModel.Annotations = append(fileModel.Annotations, AnnotationModel{
+			DocumentID:           annotation.DocumentID,
+			WithinStart:          annotation.WithinStart,
+			WithinEnd:            annotation.WithinEnd,
+			InferenceTimeSeconds: annotation.InferenceTimeSeconds,
+			Fields:               fields,
+		})
+	}
+
+	fileJSON, err := json.Marshal(fileModel)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert annotations: %w", err)
+		return fmt.Errorf("failed to marshal results to JSON: %w", err)
 	}
 
-	return &portClients.TrainingDatasetResult{
-		TotalGenerationTimeSeconds: totalGenerationTime,
-		TokensIn:                   totalTokensIn,
-		TokensOut:                  totalTokensOut,
-		TrainingDataItems:          trainingDataItems,
-	}, nil
+	appEnv := os.Getenv("APP_ENV")
+	key := fmt.Sprintf("%s/datasets/%s/%s.json", appEnv, trainingDatasetID.String(), partName)
+
+	_, err = c.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(c.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(fileJSON),
+		ContentType: aws.String("application/json"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload results to S3: %w", err)
+	}
+
+	return nil
 }
 
 func (c *TrainingDatasetResultsClientImpl) downloadFile(ctx context.Context, key string) ([]byte, error) {
@@ -198,10 +297,23 @@ func (c *TrainingDatasetResultsClientImpl) convertAnnotationsToTrainingDataItems
 			}
 		}
 
+		// Conversation datasets have no field names, their annotations hold the messages instead
+		var messages []entities.ConversationMessage
+		if rawMessages, exists := annotation.Fields[conversationMessagesKey]; exists {
+			var err error
+			messages, err = annotationMessages(rawMessages)
+			if err != nil {
+				return nil, err
+			}
+		} else if len(fieldNames) == 0 {
+			return nil, fmt.Errorf("%s not found in annotation", conversationMessagesKey)
+		}
+
 		// Create TrainingDataItem
 		item := entities.TrainingDataItem{
 			ID:                    uuid.New(),
 			Values:                values,
+			Messages:              messages,
 			SourceDocument:        &annotation.DocumentID,
 			SourceDocumentStart:   func() *string { s := fmt.Sprintf("%d", annotation.WithinStart); return &s }(),
 			SourceDocumentEnd:     func() *string { s := fmt.Sprintf("%d", annotation.WithinEnd); return &s }(),
@@ -215,4 +327,18 @@ func (c *TrainingDatasetResultsClientImpl) convertAnnotationsToTrainingDataItems
 	}
 
 	return trainingDataItems, nil
-}
\ No newline at end of file
+}
+// annotationMessages converts the decoded messages of an annotation, which the runner writes as a list of objects
+// with a role and a content
+func annotationMessages(rawMessages interface{}) ([]entities.ConversationMessage, error) {
+	encoded, err := json.Marshal(rawMessages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s of annotation: %w", conversationMessagesKey, err)
+	}
+
+	var messages []entities.ConversationMessage
+	if err := json.Unmarshal(encoded, &messages); err != nil {
+		return nil, fmt.Errorf("failed to read %s of annotation: %w", conversationMessagesKey, err)
+	}
+	return messages, nil
+}
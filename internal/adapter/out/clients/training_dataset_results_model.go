@@ -1,5 +1,7 @@
 package clients
 
+import "encoding/json"
+
 // TrainingDatasetResultsFileModel represents the structure of each JSON file from S3
 type TrainingDatasetResultsFileModel struct {
 	TotalGenerationTime float64           `json:"total_generation_time"`
@@ -17,4 +19,18 @@ type AnnotationModel struct {
 
 	// Dynamic fields based on FieldNames - stored as raw map
 	Fields map[string]interface{} `json:"-"`
-}
\ No newline at end of file
+}
+
+// MarshalJSON writes the dynamic fields next to the fixed fields, the same flat layout the runner writes
+func (a AnnotationModel) MarshalJSON() ([]byte, error) {
+	annotation := make(map[string]interface{}, len(a.Fields)+4)
+	for key, value := range a.Fields {
+		annotation[key] = value
+	}
+	annotation["document_id"] = a.DocumentID
+	annotation["within_start"] = a.WithinStart
+	annotation["within_end"] = a.WithinEnd
+	annotation["inference_time_seconds"] = a.InferenceTimeSeconds
+
+	return json.Marshal(annotation)
+}
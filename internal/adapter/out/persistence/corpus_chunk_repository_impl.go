@@ -0,0 +1,87 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type CorpusChunkRepositoryImpl struct {
+	Db *sql.DB
+}
+
+func (r *CorpusChunkRepositoryImpl) ReplaceForDocument(ctx context.Context, corpusID uuid.UUID, configKey string, fileName string, chunks []entities.CorpusChunk) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM corpus_chunks WHERE corpus_id = $1 AND config_key = $2 AND file_name = $3`, corpusID, configKey, fileName)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO corpus_chunks (id, corpus_id, config_key, file_name, chunk_index, start_offset, end_offset, token_count, text, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	now := time.Now()
+	for i := range chunks {
+		chunks[i].CreatedAt = now
+		model := FromCorpusChunkEntity(&chunks[i])
+		_, err = tx.ExecContext(ctx, query,
+			model.ID,
+			model.CorpusID,
+			model.ConfigKey,
+			model.FileName,
+			model.ChunkIndex,
+			model.StartOffset,
+			model.EndOffset,
+			model.TokenCount,
+			model.Text,
+			model.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *CorpusChunkRepositoryImpl) FindByRange(ctx context.Context, corpusID uuid.UUID, configKey string, fileName string, start int, end int) (*entities.CorpusChunk, error) {
+	// A containing chunk wins, otherwise the chunk with the largest overlap, the smaller chunk breaks ties
+	query := `SELECT id, corpus_id, config_key, file_name, chunk_index, start_offset, end_offset, token_count, text, created_at
+	FROM corpus_chunks
+	WHERE corpus_id = $1 AND config_key = $2 AND file_name = $3 AND start_offset <= $5 AND end_offset >= $4
+	ORDER BY (start_offset <= $4 AND end_offset >= $5) DESC,
+		LEAST(end_offset, $5) - GREATEST(start_offset, $4) DESC,
+		end_offset - start_offset
+	LIMIT 1`
+
+	var model CorpusChunkRepositoryModel
+	err := r.Db.QueryRowContext(ctx, query, corpusID, configKey, fileName, start, end).Scan(
+		&model.ID,
+		&model.CorpusID,
+		&model.ConfigKey,
+		&model.FileName,
+		&model.ChunkIndex,
+		&model.StartOffset,
+		&model.EndOffset,
+		&model.TokenCount,
+		&model.Text,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
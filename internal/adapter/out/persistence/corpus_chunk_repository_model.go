@@ -0,0 +1,52 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type CorpusChunkRepositoryModel struct {
+	ID          uuid.UUID `db:"id"`
+	CorpusID    uuid.UUID `db:"corpus_id"`
+	ConfigKey   string    `db:"config_key"`
+	FileName    string    `db:"file_name"`
+	ChunkIndex  int       `db:"chunk_index"`
+	StartOffset int       `db:"start_offset"`
+	EndOffset   int       `db:"end_offset"`
+	TokenCount  int       `db:"token_count"`
+	Text        string    `db:"text"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+func (m *CorpusChunkRepositoryModel) ToEntity() *entities.CorpusChunk {
+	return &entities.CorpusChunk{
+		ID:         m.ID,
+		CorpusID:   m.CorpusID,
+		ConfigKey:  m.ConfigKey,
+		FileName:   m.FileName,
+		Index:      m.ChunkIndex,
+		Start:      m.StartOffset,
+		End:        m.EndOffset,
+		TokenCount: m.TokenCount,
+		Text:       m.Text,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+func FromCorpusChunkEntity(chunk *entities.CorpusChunk) *CorpusChunkRepositoryModel {
+	return &CorpusChunkRepositoryModel{
+		ID:          chunk.ID,
+		CorpusID:    chunk.CorpusID,
+		ConfigKey:   chunk.ConfigKey,
+		FileName:    chunk.FileName,
+		ChunkIndex:  chunk.Index,
+		StartOffset: chunk.Start,
+		EndOffset:   chunk.End,
+		TokenCount:  chunk.TokenCount,
+		Text:        chunk.Text,
+		CreatedAt:   chunk.CreatedAt,
+	}
+}
@@ -0,0 +1,110 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type CorpusDocumentRepositoryImpl struct {
+	Db *sql.DB
+}
+
+func (r *CorpusDocumentRepositoryImpl) Save(ctx context.Context, document *entities.CorpusDocument) error {
+	query := `INSERT INTO corpus_documents (id, corpus_id, file_name, format, size_bytes, language_iso, page_count, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			  ON CONFLICT (corpus_id, file_name) DO UPDATE SET
+				format = EXCLUDED.format,
+				size_bytes = EXCLUDED.size_bytes,
+				language_iso = EXCLUDED.language_iso,
+				page_count = EXCLUDED.page_count,
+				updated_at = EXCLUDED.updated_at
+			  RETURNING id, created_at`
+
+	now := time.Now()
+	document.CreatedAt = now
+	document.UpdatedAt = now
+
+	model := FromCorpusDocumentEntity(document)
+	// A replaced document keeps its original ID and creation time
+	return r.Db.QueryRowContext(ctx, query,
+		model.ID,
+		model.CorpusID,
+		model.FileName,
+		model.Format,
+		model.SizeBytes,
+		model.LanguageISO,
+		model.PageCount,
+		model.CreatedAt,
+		model.UpdatedAt,
+	).Scan(&document.ID, &document.CreatedAt)
+}
+
+func (r *CorpusDocumentRepositoryImpl) GetByCorpusID(ctx context.Context, corpusID uuid.UUID) ([]*entities.CorpusDocument, error) {
+	query := `SELECT id, corpus_id, file_name, format, size_bytes, language_iso, page_count, created_at, updated_at
+	FROM corpus_documents WHERE corpus_id = $1 ORDER BY file_name`
+
+	rows, err := r.Db.QueryContext(ctx, query, corpusID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	documents := []*entities.CorpusDocument{}
+	for rows.Next() {
+		var model CorpusDocumentRepositoryModel
+		err := rows.Scan(
+			&model.ID,
+			&model.CorpusID,
+			&model.FileName,
+			&model.Format,
+			&model.SizeBytes,
+			&model.LanguageISO,
+			&model.PageCount,
+			&model.CreatedAt,
+			&model.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, model.ToEntity())
+	}
+
+	return documents, rows.Err()
+}
+
+func (r *CorpusDocumentRepositoryImpl) GetByFileName(ctx context.Context, corpusID uuid.UUID, fileName string) (*entities.CorpusDocument, error) {
+	query := `SELECT id, corpus_id, file_name, format, size_bytes, language_iso, page_count, created_at, updated_at
+	FROM corpus_documents WHERE corpus_id = $1 AND file_name = $2`
+
+	var model CorpusDocumentRepositoryModel
+	err := r.Db.QueryRowContext(ctx, query, corpusID, fileName).Scan(
+		&model.ID,
+		&model.CorpusID,
+		&model.FileName,
+		&model.Format,
+		&model.SizeBytes,
+		&model.LanguageISO,
+		&model.PageCount,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *CorpusDocumentRepositoryImpl) DeleteByFileName(ctx context.Context, corpusID uuid.UUID, fileName string) error {
+	query := `DELETE FROM corpus_documents WHERE corpus_id = $1 AND file_name = $2`
+	_, err := r.Db.ExecContext(ctx, query, corpusID, fileName)
+	return err
+}
@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type CorpusDocumentRepositoryModel struct {
+	ID          uuid.UUID `db:"id"`
+	CorpusID    uuid.UUID `db:"corpus_id"`
+	FileName    string    `db:"file_name"`
+	Format      string    `db:"format"`
+	SizeBytes   int64     `db:"size_bytes"`
+	LanguageISO *string   `db:"language_iso"`
+	PageCount   *int      `db:"page_count"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+func (m *CorpusDocumentRepositoryModel) ToEntity() *entities.CorpusDocument {
+	return &entities.CorpusDocument{
+		ID:          m.ID,
+		CorpusID:    m.CorpusID,
+		FileName:    m.FileName,
+		Format:      entities.CorpusDocumentFormat(m.Format),
+		SizeBytes:   m.SizeBytes,
+		LanguageISO: m.LanguageISO,
+		PageCount:   m.PageCount,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func FromCorpusDocumentEntity(document *entities.CorpusDocument) *CorpusDocumentRepositoryModel {
+	return &CorpusDocumentRepositoryModel{
+		ID:          document.ID,
+		CorpusID:    document.CorpusID,
+		FileName:    document.FileName,
+		Format:      string(document.Format),
+		SizeBytes:   document.SizeBytes,
+		LanguageISO: document.LanguageISO,
+		PageCount:   document.PageCount,
+		CreatedAt:   document.CreatedAt,
+		UpdatedAt:   document.UpdatedAt,
+	}
+}
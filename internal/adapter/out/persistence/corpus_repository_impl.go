@@ -15,10 +15,11 @@ type CorpusRepositoryImpl struct {
 	Db *sql.DB
 }
 
+const corpusColumns = `id, name, s3_path, files_subset, owner_id, project_id, created_at, updated_at`
 
 func (r *CorpusRepositoryImpl) Create(ctx context.Context, corpus *entities.Corpus) error {
-	query := `INSERT INTO corpus (id, name, s3_path, files_subset, created_at, updated_at)
-			  VALUES ($1, $2, $3, $4, $5, $6)`
+	query := `INSERT INTO corpus (id, name, s3_path, files_subset, owner_id, project_id, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
 
 	now := time.Now()
 	corpus.CreatedAt = now
@@ -34,6 +35,8 @@ func (r *CorpusRepositoryImpl) Create(ctx context.Context, corpus *entities.Corp
 		corpus.Name,
 		corpus.S3Path,
 		filesSubset,
+		corpus.OwnerID,
+		corpus.ProjectID,
 		corpus.CreatedAt,
 		corpus.UpdatedAt,
 	)
@@ -42,65 +45,25 @@ func (r *CorpusRepositoryImpl) Create(ctx context.Context, corpus *entities.Corp
 }
 
 func (r *CorpusRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entities.Corpus, error) {
-	query := `SELECT id, name, s3_path, files_subset, created_at, updated_at FROM corpus WHERE id = $1`
-
-	var model CorpusRepositoryModel
-	var filesSubset pq.StringArray
-	err := r.Db.QueryRowContext(ctx, query, id).Scan(
-		&model.ID,
-		&model.Name,
-		&model.S3Path,
-		&filesSubset,
-		&model.CreatedAt,
-		&model.UpdatedAt,
-	)
-
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
-		return nil, err
-	}
-
-	if len(filesSubset) > 0 {
-		subset := []string(filesSubset)
-		model.FilesSubset = &subset
-	}
-
-	return model.ToEntity(), nil
+	query := `SELECT ` + corpusColumns + ` FROM corpus WHERE id = $1`
+	return r.queryCorpus(ctx, query, id)
 }
 
 func (r *CorpusRepositoryImpl) GetByName(ctx context.Context, name string) (*entities.Corpus, error) {
-	query := `SELECT id, name, s3_path, files_subset, created_at, updated_at FROM corpus WHERE name = $1`
-
-	var model CorpusRepositoryModel
-	var filesSubset pq.StringArray
-	err := r.Db.QueryRowContext(ctx, query, name).Scan(
-		&model.ID,
-		&model.Name,
-		&model.S3Path,
-		&filesSubset,
-		&model.CreatedAt,
-		&model.UpdatedAt,
-	)
-
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
-		return nil, err
-	}
-
-	if len(filesSubset) > 0 {
-		subset := []string(filesSubset)
-		model.FilesSubset = &subset
-	}
+	query := `SELECT ` + corpusColumns + ` FROM corpus WHERE name = $1 ORDER BY owner_id NULLS FIRST LIMIT 1`
+	return r.queryCorpus(ctx, query, name)
+}
 
-	return model.ToEntity(), nil
+func (r *CorpusRepositoryImpl) GetByNameForOwner(ctx context.Context, name string, ownerID uuid.UUID) (*entities.Corpus, error) {
+	// A corpus of the owner takes precedence over a shared corpus with the same name
+	query := `SELECT ` + corpusColumns + ` FROM corpus
+		WHERE name = $1 AND (owner_id = $2 OR owner_id IS NULL)
+		ORDER BY owner_id NULLS LAST LIMIT 1`
+	return r.queryCorpus(ctx, query, name, ownerID)
 }
 
 func (r *CorpusRepositoryImpl) Update(ctx context.Context, corpus *entities.Corpus) error {
-	query := `UPDATE corpus SET name = $2, s3_path = $3, files_subset = $4, updated_at = $5 WHERE id = $1`
+	query := `UPDATE corpus SET name = $2, s3_path = $3, files_subset = $4, owner_id = $5, project_id = $6, updated_at = $7 WHERE id = $1`
 
 	corpus.UpdatedAt = time.Now()
 
@@ -114,6 +77,8 @@ func (r *CorpusRepositoryImpl) Update(ctx context.Context, corpus *entities.Corp
 		corpus.Name,
 		corpus.S3Path,
 		filesSubset,
+		corpus.OwnerID,
+		corpus.ProjectID,
 		corpus.UpdatedAt,
 	)
 
@@ -127,9 +92,32 @@ func (r *CorpusRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
 }
 
 func (r *CorpusRepositoryImpl) List(ctx context.Context) ([]*entities.Corpus, error) {
-	query := `SELECT id, name, s3_path, files_subset, created_at, updated_at FROM corpus ORDER BY name`
+	query := `SELECT ` + corpusColumns + ` FROM corpus ORDER BY name`
+	return r.queryCorpora(ctx, query)
+}
+
+func (r *CorpusRepositoryImpl) ListForOwner(ctx context.Context, ownerID uuid.UUID, projectID *uuid.UUID) ([]*entities.Corpus, error) {
+	// Shared corpora are always listed, project scoped corpora only for their project
+	query := `SELECT ` + corpusColumns + ` FROM corpus
+		WHERE owner_id IS NULL
+			OR (owner_id = $1 AND (project_id IS NULL OR project_id = $2 OR $2::uuid IS NULL))
+		ORDER BY name`
+	return r.queryCorpora(ctx, query, ownerID, projectID)
+}
 
-	rows, err := r.Db.QueryContext(ctx, query)
+func (r *CorpusRepositoryImpl) queryCorpus(ctx context.Context, query string, args ...interface{}) (*entities.Corpus, error) {
+	corpora, err := r.queryCorpora(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(corpora) == 0 {
+		return nil, nil
+	}
+	return corpora[0], nil
+}
+
+func (r *CorpusRepositoryImpl) queryCorpora(ctx context.Context, query string, args ...interface{}) ([]*entities.Corpus, error) {
+	rows, err := r.Db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -144,6 +132,8 @@ func (r *CorpusRepositoryImpl) List(ctx context.Context) ([]*entities.Corpus, er
 			&model.Name,
 			&model.S3Path,
 			&filesSubset,
+			&model.OwnerID,
+			&model.ProjectID,
 			&model.CreatedAt,
 			&model.UpdatedAt,
 		)
@@ -159,5 +149,5 @@ func (r *CorpusRepositoryImpl) List(ctx context.Context) ([]*entities.Corpus, er
 		corpus = append(corpus, model.ToEntity())
 	}
 
-	return corpus, nil
-}
\ No newline at end of file
+	return corpus, rows.Err()
+}
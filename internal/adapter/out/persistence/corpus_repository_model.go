@@ -9,12 +9,14 @@ import (
 )
 
 type CorpusRepositoryModel struct {
-	ID          uuid.UUID `db:"id"`
-	Name        string    `db:"name"`
-	S3Path      string    `db:"s3_path"`
-	FilesSubset *[]string `db:"files_subset"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	ID          uuid.UUID  `db:"id"`
+	Name        string     `db:"name"`
+	S3Path      string     `db:"s3_path"`
+	FilesSubset *[]string  `db:"files_subset"`
+	OwnerID     *uuid.UUID `db:"owner_id"`
+	ProjectID   *uuid.UUID `db:"project_id"`
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
 }
 
 func (m *CorpusRepositoryModel) ToEntity() *entities.Corpus {
@@ -23,6 +25,8 @@ func (m *CorpusRepositoryModel) ToEntity() *entities.Corpus {
 		Name:        m.Name,
 		S3Path:      m.S3Path,
 		FilesSubset: m.FilesSubset,
+		OwnerID:     m.OwnerID,
+		ProjectID:   m.ProjectID,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
 	}
@@ -34,7 +38,9 @@ func FromCorpusEntity(corpus *entities.Corpus) *CorpusRepositoryModel {
 		Name:        corpus.Name,
 		S3Path:      corpus.S3Path,
 		FilesSubset: corpus.FilesSubset,
+		OwnerID:     corpus.OwnerID,
+		ProjectID:   corpus.ProjectID,
 		CreatedAt:   corpus.CreatedAt,
 		UpdatedAt:   corpus.UpdatedAt,
 	}
-}
\ No newline at end of file
+}
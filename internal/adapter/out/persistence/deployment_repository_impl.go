@@ -12,8 +12,8 @@ type DeploymentRepositoryImpl struct {
 }
 
 func (r *DeploymentRepositoryImpl) Create(deployment *entities.Deployment) error {
-	query := `INSERT INTO deployments (id, model_name, api_key, project_id, finetune_id, created_at, updated_at)
-			  VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	query := `INSERT INTO deployments (id, model_name, api_key, project_id, finetune_id, redact_pii, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
 
 	now := time.Now()
 	deployment.CreatedAt = now
@@ -25,6 +25,7 @@ func (r *DeploymentRepositoryImpl) Create(deployment *entities.Deployment) error
 		deployment.APIKey,
 		deployment.ProjectID,
 		deployment.FinetuneID,
+		deployment.RedactPII,
 		deployment.CreatedAt,
 		deployment.UpdatedAt,
 	)
@@ -33,7 +34,7 @@ func (r *DeploymentRepositoryImpl) Create(deployment *entities.Deployment) error
 }
 
 func (r *DeploymentRepositoryImpl) GetByID(id uuid.UUID) (*entities.Deployment, error) {
-	query := `SELECT id, model_name, api_key, project_id, finetune_id, created_at, updated_at
+	query := `SELECT id, model_name, api_key, project_id, finetune_id, redact_pii, created_at, updated_at
 			  FROM deployments WHERE id = $1`
 
 	var model DeploymentRepositoryModel
@@ -43,6 +44,7 @@ func (r *DeploymentRepositoryImpl) GetByID(id uuid.UUID) (*entities.Deployment,
 		&model.APIKey,
 		&model.ProjectID,
 		&model.FinetuneID,
+		&model.RedactPII,
 		&model.CreatedAt,
 		&model.UpdatedAt,
 	)
@@ -58,7 +60,7 @@ func (r *DeploymentRepositoryImpl) GetByID(id uuid.UUID) (*entities.Deployment,
 }
 
 func (r *DeploymentRepositoryImpl) GetByProjectID(projectID uuid.UUID) ([]entities.Deployment, error) {
-	query := `SELECT id, model_name, api_key, project_id, finetune_id, created_at, updated_at
+	query := `SELECT id, model_name, api_key, project_id, finetune_id, redact_pii, created_at, updated_at
 			  FROM deployments WHERE project_id = $1 ORDER BY created_at DESC`
 
 	rows, err := r.Db.Query(query, projectID)
@@ -76,6 +78,7 @@ func (r *DeploymentRepositoryImpl) GetByProjectID(projectID uuid.UUID) ([]entiti
 			&model.APIKey,
 			&model.ProjectID,
 			&model.FinetuneID,
+			&model.RedactPII,
 			&model.CreatedAt,
 			&model.UpdatedAt,
 		)
@@ -89,7 +92,7 @@ func (r *DeploymentRepositoryImpl) GetByProjectID(projectID uuid.UUID) ([]entiti
 }
 
 func (r *DeploymentRepositoryImpl) GetByFinetuneID(finetuneID uuid.UUID) (*entities.Deployment, error) {
-	query := `SELECT id, model_name, api_key, project_id, finetune_id, created_at, updated_at
+	query := `SELECT id, model_name, api_key, project_id, finetune_id, redact_pii, created_at, updated_at
 			  FROM deployments WHERE finetune_id = $1`
 
 	var model DeploymentRepositoryModel
@@ -99,6 +102,7 @@ func (r *DeploymentRepositoryImpl) GetByFinetuneID(finetuneID uuid.UUID) (*entit
 		&model.APIKey,
 		&model.ProjectID,
 		&model.FinetuneID,
+		&model.RedactPII,
 		&model.CreatedAt,
 		&model.UpdatedAt,
 	)
@@ -114,7 +118,7 @@ func (r *DeploymentRepositoryImpl) GetByFinetuneID(finetuneID uuid.UUID) (*entit
 }
 
 func (r *DeploymentRepositoryImpl) GetByProjectIDAndModelName(projectID uuid.UUID, modelName string) (*entities.Deployment, error) {
-	query := `SELECT id, model_name, api_key, project_id, finetune_id, created_at, updated_at
+	query := `SELECT id, model_name, api_key, project_id, finetune_id, redact_pii, created_at, updated_at
 			  FROM deployments WHERE project_id = $1 AND model_name = $2`
 
 	var model DeploymentRepositoryModel
@@ -124,6 +128,7 @@ func (r *DeploymentRepositoryImpl) GetByProjectIDAndModelName(projectID uuid.UUI
 		&model.APIKey,
 		&model.ProjectID,
 		&model.FinetuneID,
+		&model.RedactPII,
 		&model.CreatedAt,
 		&model.UpdatedAt,
 	)
@@ -139,7 +144,7 @@ func (r *DeploymentRepositoryImpl) GetByProjectIDAndModelName(projectID uuid.UUI
 }
 
 func (r *DeploymentRepositoryImpl) GetByAPIKey(apiKey string) (*entities.Deployment, error) {
-	query := `SELECT id, model_name, api_key, project_id, finetune_id, created_at, updated_at
+	query := `SELECT id, model_name, api_key, project_id, finetune_id, redact_pii, created_at, updated_at
 			  FROM deployments WHERE api_key = $1`
 
 	var model DeploymentRepositoryModel
@@ -149,6 +154,7 @@ func (r *DeploymentRepositoryImpl) GetByAPIKey(apiKey string) (*entities.Deploym
 		&model.APIKey,
 		&model.ProjectID,
 		&model.FinetuneID,
+		&model.RedactPII,
 		&model.CreatedAt,
 		&model.UpdatedAt,
 	)
@@ -13,6 +13,7 @@ type DeploymentRepositoryModel struct {
 	APIKey     string     `db:"api_key"`
 	ProjectID  uuid.UUID  `db:"project_id"`
 	FinetuneID *uuid.UUID `db:"finetune_id"`
+	RedactPII  bool       `db:"redact_pii"`
 	CreatedAt  time.Time  `db:"created_at"`
 	UpdatedAt  time.Time  `db:"updated_at"`
 }
@@ -24,6 +25,7 @@ func (m *DeploymentRepositoryModel) ToEntity() *entities.Deployment {
 		APIKey:     m.APIKey,
 		ProjectID:  m.ProjectID,
 		FinetuneID: m.FinetuneID,
+		RedactPII:  m.RedactPII,
 		CreatedAt:  m.CreatedAt,
 		UpdatedAt:  m.UpdatedAt,
 	}
@@ -0,0 +1,68 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type FinetuneDispatchEventRepositoryImpl struct {
+	Db *sql.DB
+}
+
+// insertFinetuneDispatchEvent is shared with the finetune repository, which records the events of the scheduler in
+// the transaction of the status change
+func insertFinetuneDispatchEvent(ctx context.Context, exec sqlExecutor, event *entities.FinetuneDispatchEvent) error {
+	query := `INSERT INTO finetune_dispatch_events (id, finetune_id, type, message, created_at) VALUES ($1, $2, $3, $4, $5)`
+
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	event.CreatedAt = time.Now()
+
+	model := FromFinetuneDispatchEventEntity(event)
+	_, err := exec.ExecContext(ctx, query,
+		model.ID,
+		model.FinetuneID,
+		model.Type,
+		model.Message,
+		model.CreatedAt,
+	)
+	return err
+}
+
+func (r *FinetuneDispatchEventRepositoryImpl) Create(ctx context.Context, event *entities.FinetuneDispatchEvent) error {
+	return insertFinetuneDispatchEvent(ctx, r.Db, event)
+}
+
+func (r *FinetuneDispatchEventRepositoryImpl) ListByFinetuneID(ctx context.Context, finetuneID uuid.UUID) ([]*entities.FinetuneDispatchEvent, error) {
+	query := `SELECT id, finetune_id, type, message, created_at
+	FROM finetune_dispatch_events WHERE finetune_id = $1 ORDER BY created_at, id`
+
+	rows, err := r.Db.QueryContext(ctx, query, finetuneID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []*entities.FinetuneDispatchEvent{}
+	for rows.Next() {
+		var model FinetuneDispatchEventRepositoryModel
+		if err := rows.Scan(
+			&model.ID,
+			&model.FinetuneID,
+			&model.Type,
+			&model.Message,
+			&model.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		events = append(events, model.ToEntity())
+	}
+
+	return events, rows.Err()
+}
@@ -0,0 +1,37 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type FinetuneDispatchEventRepositoryModel struct {
+	ID         uuid.UUID `db:"id"`
+	FinetuneID uuid.UUID `db:"finetune_id"`
+	Type       string    `db:"type"`
+	Message    *string   `db:"message"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+func (m *FinetuneDispatchEventRepositoryModel) ToEntity() *entities.FinetuneDispatchEvent {
+	return &entities.FinetuneDispatchEvent{
+		ID:         m.ID,
+		FinetuneID: m.FinetuneID,
+		Type:       entities.FinetuneDispatchEventType(m.Type),
+		Message:    m.Message,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+func FromFinetuneDispatchEventEntity(event *entities.FinetuneDispatchEvent) *FinetuneDispatchEventRepositoryModel {
+	return &FinetuneDispatchEventRepositoryModel{
+		ID:         event.ID,
+		FinetuneID: event.FinetuneID,
+		Type:       string(event.Type),
+		Message:    event.Message,
+		CreatedAt:  event.CreatedAt,
+	}
+}
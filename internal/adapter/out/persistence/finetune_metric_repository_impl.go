@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type FinetuneMetricRepositoryImpl struct {
+	Db *sql.DB
+}
+
+func (r *FinetuneMetricRepositoryImpl) Save(ctx context.Context, metric *entities.FinetuneMetric) error {
+	query := `INSERT INTO finetune_metrics (
+		finetune_id, step, epoch, train_loss, eval_loss, learning_rate, gpu_memory_gb, created_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	ON CONFLICT (finetune_id, step) DO UPDATE SET
+		epoch = EXCLUDED.epoch,
+		train_loss = EXCLUDED.train_loss,
+		eval_loss = EXCLUDED.eval_loss,
+		learning_rate = EXCLUDED.learning_rate,
+		gpu_memory_gb = EXCLUDED.gpu_memory_gb,
+		created_at = EXCLUDED.created_at`
+
+	metric.CreatedAt = time.Now()
+
+	model := FromFinetuneMetricEntity(metric)
+	_, err := r.Db.ExecContext(ctx, query,
+		model.FinetuneID,
+		model.Step,
+		model.Epoch,
+		model.TrainLoss,
+		model.EvalLoss,
+		model.LearningRate,
+		model.GPUMemoryGB,
+		model.CreatedAt,
+	)
+	return err
+}
+
+func (r *FinetuneMetricRepositoryImpl) ListByFinetuneID(ctx context.Context, finetuneID uuid.UUID) ([]*entities.FinetuneMetric, error) {
+	query := `SELECT
+		finetune_id, step, epoch, train_loss, eval_loss, learning_rate, gpu_memory_gb, created_at
+	FROM finetune_metrics WHERE finetune_id = $1 ORDER BY step`
+
+	rows, err := r.Db.QueryContext(ctx, query, finetuneID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	metrics := []*entities.FinetuneMetric{}
+	for rows.Next() {
+		var model FinetuneMetricRepositoryModel
+		if err := rows.Scan(
+			&model.FinetuneID,
+			&model.Step,
+			&model.Epoch,
+			&model.TrainLoss,
+			&model.EvalLoss,
+			&model.LearningRate,
+			&model.GPUMemoryGB,
+			&model.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, model.ToEntity())
+	}
+
+	return metrics, rows.Err()
+}
@@ -0,0 +1,46 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type FinetuneMetricRepositoryModel struct {
+	FinetuneID   uuid.UUID `db:"finetune_id"`
+	Step         int       `db:"step"`
+	Epoch        float64   `db:"epoch"`
+	TrainLoss    *float64  `db:"train_loss"`
+	EvalLoss     *float64  `db:"eval_loss"`
+	LearningRate *float64  `db:"learning_rate"`
+	GPUMemoryGB  *float64  `db:"gpu_memory_gb"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+func (m *FinetuneMetricRepositoryModel) ToEntity() *entities.FinetuneMetric {
+	return &entities.FinetuneMetric{
+		FinetuneID:   m.FinetuneID,
+		Step:         m.Step,
+		Epoch:        m.Epoch,
+		TrainLoss:    m.TrainLoss,
+		EvalLoss:     m.EvalLoss,
+		LearningRate: m.LearningRate,
+		GPUMemoryGB:  m.GPUMemoryGB,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+func FromFinetuneMetricEntity(metric *entities.FinetuneMetric) *FinetuneMetricRepositoryModel {
+	return &FinetuneMetricRepositoryModel{
+		FinetuneID:   metric.FinetuneID,
+		Step:         metric.Step,
+		Epoch:        metric.Epoch,
+		TrainLoss:    metric.TrainLoss,
+		EvalLoss:     metric.EvalLoss,
+		LearningRate: metric.LearningRate,
+		GPUMemoryGB:  metric.GPUMemoryGB,
+		CreatedAt:    metric.CreatedAt,
+	}
+}
@@ -3,6 +3,7 @@ package persistence
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,12 +16,42 @@ type FinetuneRepositoryImpl struct {
 }
 
 func (r *FinetuneRepositoryImpl) Create(ctx context.Context, finetune *entities.Finetune) error {
+	return r.create(ctx, r.Db, finetune)
+}
+
+func (r *FinetuneRepositoryImpl) CreateWithOutboxJob(ctx context.Context, finetune *entities.Finetune, job *entities.OutboxJob, piiReport *entities.PIIReport, event *entities.FinetuneDispatchEvent) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := r.create(ctx, tx, finetune); err != nil {
+		return err
+	}
+	if err := insertOutboxJob(ctx, tx, job); err != nil {
+		return err
+	}
+	if piiReport != nil {
+		if err := insertPIIReport(ctx, tx, piiReport); err != nil {
+			return err
+		}
+	}
+	if err := insertFinetuneDispatchEvent(ctx, tx, event); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *FinetuneRepositoryImpl) create(ctx context.Context, exec sqlExecutor, finetune *entities.Finetune) error {
 	query := `INSERT INTO finetunes (
 		id, project_id, version, model_name, base_model_name,
 		model_size_gb, model_size_parameter, model_dtype, model_quantization,
 		inference_samples_json, training_dataset_id, training_dataset_number_examples,
-		training_dataset_select_random, training_time_seconds, status, created_at, updated_at
-	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`
+		training_dataset_select_random, training_dataset_min_quality_score, training_time_seconds, backend, backend_job_id, priority, status, created_at, updated_at,
+		hyperparameters_json
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)`
 
 	now := time.Now()
 	finetune.CreatedAt = now
@@ -31,7 +62,7 @@ func (r *FinetuneRepositoryImpl) Create(ctx context.Context, finetune *entities.
 		return err
 	}
 
-	_, err = r.Db.ExecContext(ctx, query,
+	_, err = exec.ExecContext(ctx, query,
 		model.ID,
 		model.ProjectID,
 		model.Version,
@@ -45,10 +76,15 @@ func (r *FinetuneRepositoryImpl) Create(ctx context.Context, finetune *entities.
 		model.TrainingDatasetID,
 		model.TrainingDatasetNumberExamples,
 		model.TrainingDatasetSelectRandom,
+		model.TrainingDatasetMinQualityScore,
 		model.TrainingTimeSeconds,
+		model.Backend,
+		model.BackendJobID,
+		model.Priority,
 		model.Status,
 		model.CreatedAt,
 		model.UpdatedAt,
+		model.HyperparametersJSON,
 	)
 
 	return err
@@ -59,7 +95,8 @@ func (r *FinetuneRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*en
 		id, project_id, version, model_name, base_model_name,
 		model_size_gb, model_size_parameter, model_dtype, model_quantization,
 		inference_samples_json, training_dataset_id, training_dataset_number_examples,
-		training_dataset_select_random, training_time_seconds, status, created_at, updated_at
+		training_dataset_select_random, training_dataset_min_quality_score, training_time_seconds, backend, backend_job_id, priority, status, failure_reason, created_at, updated_at,
+		hyperparameters_json, model_file_size_bytes, model_sha256
 	FROM finetunes WHERE id = $1`
 
 	var model FinetuneRepositoryModel
@@ -77,10 +114,18 @@ func (r *FinetuneRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*en
 		&model.TrainingDatasetID,
 		&model.TrainingDatasetNumberExamples,
 		&model.TrainingDatasetSelectRandom,
+		&model.TrainingDatasetMinQualityScore,
 		&model.TrainingTimeSeconds,
+		&model.Backend,
+		&model.BackendJobID,
+		&model.Priority,
 		&model.Status,
+		&model.FailureReason,
 		&model.CreatedAt,
 		&model.UpdatedAt,
+		&model.HyperparametersJSON,
+		&model.ModelFileSizeBytes,
+		&model.ModelSHA256,
 	)
 
 	if err != nil {
@@ -98,7 +143,8 @@ func (r *FinetuneRepositoryImpl) GetByProjectID(ctx context.Context, projectID u
 		id, project_id, version, model_name, base_model_name,
 		model_size_gb, model_size_parameter, model_dtype, model_quantization,
 		inference_samples_json, training_dataset_id, training_dataset_number_examples,
-		training_dataset_select_random, training_time_seconds, status, created_at, updated_at
+		training_dataset_select_random, training_dataset_min_quality_score, training_time_seconds, backend, backend_job_id, priority, status, failure_reason, created_at, updated_at,
+		hyperparameters_json, model_file_size_bytes, model_sha256
 	FROM finetunes WHERE project_id = $1 ORDER BY version DESC`
 
 	rows, err := r.Db.QueryContext(ctx, query, projectID)
@@ -124,10 +170,18 @@ func (r *FinetuneRepositoryImpl) GetByProjectID(ctx context.Context, projectID u
 			&model.TrainingDatasetID,
 			&model.TrainingDatasetNumberExamples,
 			&model.TrainingDatasetSelectRandom,
+			&model.TrainingDatasetMinQualityScore,
 			&model.TrainingTimeSeconds,
+			&model.Backend,
+			&model.BackendJobID,
+			&model.Priority,
 			&model.Status,
+			&model.FailureReason,
 			&model.CreatedAt,
 			&model.UpdatedAt,
+			&model.HyperparametersJSON,
+			&model.ModelFileSizeBytes,
+			&model.ModelSHA256,
 		)
 		if err != nil {
 			return nil, err
@@ -149,7 +203,8 @@ func (r *FinetuneRepositoryImpl) GetLatestByProjectID(ctx context.Context, proje
 		id, project_id, version, model_name, base_model_name,
 		model_size_gb, model_size_parameter, model_dtype, model_quantization,
 		inference_samples_json, training_dataset_id, training_dataset_number_examples,
-		training_dataset_select_random, training_time_seconds, status, created_at, updated_at
+		training_dataset_select_random, training_dataset_min_quality_score, training_time_seconds, backend, backend_job_id, priority, status, failure_reason, created_at, updated_at,
+		hyperparameters_json, model_file_size_bytes, model_sha256
 	FROM finetunes WHERE project_id = $1 ORDER BY version DESC LIMIT 1`
 
 	var model FinetuneRepositoryModel
@@ -167,10 +222,18 @@ func (r *FinetuneRepositoryImpl) GetLatestByProjectID(ctx context.Context, proje
 		&model.TrainingDatasetID,
 		&model.TrainingDatasetNumberExamples,
 		&model.TrainingDatasetSelectRandom,
+		&model.TrainingDatasetMinQualityScore,
 		&model.TrainingTimeSeconds,
+		&model.Backend,
+		&model.BackendJobID,
+		&model.Priority,
 		&model.Status,
+		&model.FailureReason,
 		&model.CreatedAt,
 		&model.UpdatedAt,
+		&model.HyperparametersJSON,
+		&model.ModelFileSizeBytes,
+		&model.ModelSHA256,
 	)
 
 	if err != nil {
@@ -188,8 +251,9 @@ func (r *FinetuneRepositoryImpl) Update(ctx context.Context, finetune *entities.
 		model_name = $1, base_model_name = $2, model_size_gb = $3, model_size_parameter = $4,
 		model_dtype = $5, model_quantization = $6, inference_samples_json = $7,
 		training_dataset_number_examples = $8, training_dataset_select_random = $9,
-		training_time_seconds = $10, status = $11, updated_at = $12
-	WHERE id = $13`
+		training_dataset_min_quality_score = $10, training_time_seconds = $11, backend_job_id = $12, status = $13, updated_at = $14,
+		model_file_size_bytes = $15, model_sha256 = $16
+	WHERE id = $17`
 
 	finetune.UpdatedAt = time.Now()
 
@@ -208,9 +272,13 @@ func (r *FinetuneRepositoryImpl) Update(ctx context.Context, finetune *entities.
 		model.InferenceSamplesJSON,
 		model.TrainingDatasetNumberExamples,
 		model.TrainingDatasetSelectRandom,
+		model.TrainingDatasetMinQualityScore,
 		model.TrainingTimeSeconds,
+		model.BackendJobID,
 		model.Status,
 		model.UpdatedAt,
+		model.ModelFileSizeBytes,
+		model.ModelSHA256,
 		model.ID,
 	)
 
@@ -218,11 +286,89 @@ func (r *FinetuneRepositoryImpl) Update(ctx context.Context, finetune *entities.
 }
 
 func (r *FinetuneRepositoryImpl) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.FinetuneStatus) error {
-	query := `UPDATE finetunes SET status = $1, updated_at = $2 WHERE id = $3`
+	// The failure reason belongs to the previous status
+	query := `UPDATE finetunes SET status = $1, failure_reason = NULL, updated_at = $2 WHERE id = $3`
 	_, err := r.Db.ExecContext(ctx, query, string(status), time.Now(), id)
 	return err
 }
 
+func (r *FinetuneRepositoryImpl) UpdateStatusFrom(ctx context.Context, id uuid.UUID, fromStatus entities.FinetuneStatus, status entities.FinetuneStatus) (bool, error) {
+	query := `UPDATE finetunes SET status = $1, failure_reason = NULL, updated_at = $2 WHERE id = $3 AND status = $4`
+	result, err := r.Db.ExecContext(ctx, query, string(status), time.Now(), id, string(fromStatus))
+	if err != nil {
+		return false, err
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+func (r *FinetuneRepositoryImpl) UpdateStatusWithReason(ctx context.Context, id uuid.UUID, status entities.FinetuneStatus, reason string) error {
+	query := `UPDATE finetunes SET status = $1, failure_reason = $2, updated_at = $3 WHERE id = $4`
+	_, err := r.Db.ExecContext(ctx, query, string(status), reason, time.Now(), id)
+	return err
+}
+
+func (r *FinetuneRepositoryImpl) UpdateInferenceSamples(ctx context.Context, id uuid.UUID, samples []entities.InferenceSample) error {
+	samplesJSON, err := json.Marshal(samples)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE finetunes SET inference_samples_json = $1, updated_at = $2 WHERE id = $3`
+	_, err = r.Db.ExecContext(ctx, query, string(samplesJSON), time.Now(), id)
+	return err
+}
+
+func (r *FinetuneRepositoryImpl) Complete(ctx context.Context, finetune *entities.Finetune, fromStatus entities.FinetuneStatus) (bool, error) {
+	query := `UPDATE finetunes SET
+		model_size_gb = $1, model_size_parameter = $2, model_dtype = $3, model_quantization = $4,
+		training_time_seconds = $5, model_file_size_bytes = $6, model_sha256 = $7,
+		status = $8, failure_reason = NULL, updated_at = $9
+	WHERE id = $10 AND status = $11`
+
+	finetune.UpdatedAt = time.Now()
+
+	result, err := r.Db.ExecContext(ctx, query,
+		finetune.ModelSizeGB,
+		finetune.ModelSizeParameter,
+		finetune.ModelDtype,
+		finetune.ModelQuantization,
+		finetune.TrainingTimeSeconds,
+		finetune.ModelFileSizeBytes,
+		finetune.ModelSHA256,
+		string(entities.FinetuneStatusDone),
+		finetune.UpdatedAt,
+		finetune.ID,
+		string(fromStatus),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected == 1, nil
+}
+
+func (r *FinetuneRepositoryImpl) SetBackendJobID(ctx context.Context, id uuid.UUID, backendJobID string) (bool, error) {
+	query := `UPDATE finetunes SET backend_job_id = $1, updated_at = $2 WHERE id = $3 AND backend_job_id IS NULL`
+	result, err := r.Db.ExecContext(ctx, query, backendJobID, time.Now(), id)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected == 1, nil
+}
+
 func (r *FinetuneRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `UPDATE finetunes SET status = $1, updated_at = $2 WHERE id = $3`
 	_, err := r.Db.ExecContext(ctx, query, string(entities.FinetuneStatusDeleted), time.Now(), id)
@@ -234,4 +380,119 @@ func (r *FinetuneRepositoryImpl) GetNextVersion(ctx context.Context, projectID u
 	var nextVersion int
 	err := r.Db.QueryRowContext(ctx, query, projectID).Scan(&nextVersion)
 	return nextVersion, err
-}
\ No newline at end of file
+}
+
+func (r *FinetuneRepositoryImpl) ListScheduled(ctx context.Context) ([]entities.FinetuneQueueEntry, error) {
+	return listScheduledFinetunes(ctx, r.Db)
+}
+
+// finetuneSchedulerLock is the advisory lock the instances take to list and dispatch the queue one at a time
+const finetuneSchedulerLock = `SELECT pg_advisory_xact_lock(hashtext('finetune_scheduler'))`
+
+func (r *FinetuneRepositoryImpl) DispatchScheduled(ctx context.Context, selectDispatchable func(entries []entities.FinetuneQueueEntry) []*entities.FinetuneDispatchEvent) (int, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// Held until the commit, the next instance lists the queue with the finetunes dispatched here
+	if _, err := tx.ExecContext(ctx, finetuneSchedulerLock); err != nil {
+		return 0, err
+	}
+
+	entries, err := listScheduledFinetunes(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	dispatched := 0
+	for _, event := range selectDispatchable(entries) {
+		left, err := leaveQueue(ctx, tx, event.FinetuneID, entities.FinetuneStatusPlanning, entities.OutboxJobStatusPending, event)
+		if err != nil {
+			return 0, err
+		}
+		if left {
+			dispatched++
+		}
+	}
+
+	return dispatched, tx.Commit()
+}
+
+type sqlQueryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func listScheduledFinetunes(ctx context.Context, db sqlQueryer) ([]entities.FinetuneQueueEntry, error) {
+	query := `SELECT f.id, p.owner_id, f.status, f.priority, f.created_at
+	FROM finetunes f JOIN projects p ON p.id = f.project_id
+	WHERE f.status IN ($1, $2, $3)`
+
+	rows, err := db.QueryContext(ctx, query,
+		string(entities.FinetuneStatusQueued),
+		string(entities.FinetuneStatusPlanning),
+		string(entities.FinetuneStatusRunning),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []entities.FinetuneQueueEntry{}
+	for rows.Next() {
+		var entry entities.FinetuneQueueEntry
+		if err := rows.Scan(&entry.FinetuneID, &entry.OwnerID, &entry.Status, &entry.Priority, &entry.CreatedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+func (r *FinetuneRepositoryImpl) CancelQueued(ctx context.Context, id uuid.UUID, event *entities.FinetuneDispatchEvent) (bool, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	cancelled, err := leaveQueue(ctx, tx, id, entities.FinetuneStatusAborted, entities.OutboxJobStatusCancelled, event)
+	if err != nil || !cancelled {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// leaveQueue only changes a finetune that is still QUEUED, so a finetune that is dispatched and cancelled at the same
+// time ends up in one of the two states
+func leaveQueue(ctx context.Context, tx *sql.Tx, id uuid.UUID, status entities.FinetuneStatus, outboxJobStatus entities.OutboxJobStatus, event *entities.FinetuneDispatchEvent) (bool, error) {
+	now := time.Now()
+	result, err := tx.ExecContext(ctx, `UPDATE finetunes SET status = $1, updated_at = $2 WHERE id = $3 AND status = $4`,
+		string(status), now, id, string(entities.FinetuneStatusQueued))
+	if err != nil {
+		return false, err
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if updated == 0 {
+		return false, nil
+	}
+
+	// The dispatcher picks up the released job right away
+	_, err = tx.ExecContext(ctx, `UPDATE outbox_jobs SET status = $1, next_attempt_at = $2, updated_at = $2
+	WHERE entity_id = $3 AND status = $4`,
+		string(outboxJobStatus), now, id, string(entities.OutboxJobStatusQueued))
+	if err != nil {
+		return false, err
+	}
+
+	if err := insertFinetuneDispatchEvent(ctx, tx, event); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -19,14 +19,22 @@ type FinetuneRepositoryModel struct {
 	ModelSizeParameter               *int       `db:"model_size_parameter"`
 	ModelDtype                       *string    `db:"model_dtype"`
 	ModelQuantization                *string    `db:"model_quantization"`
+	ModelFileSizeBytes               *int64     `db:"model_file_size_bytes"`
+	ModelSHA256                      *string    `db:"model_sha256"`
 	InferenceSamplesJSON             string     `db:"inference_samples_json"`
 	TrainingDatasetID                uuid.UUID  `db:"training_dataset_id"`
 	TrainingDatasetNumberExamples    *int       `db:"training_dataset_number_examples"`
 	TrainingDatasetSelectRandom      bool       `db:"training_dataset_select_random"`
+	TrainingDatasetMinQualityScore   *float64   `db:"training_dataset_min_quality_score"`
 	TrainingTimeSeconds              *float64   `db:"training_time_seconds"`
+	Backend                          string     `db:"backend"`
+	BackendJobID                     *string    `db:"backend_job_id"`
+	Priority                         string     `db:"priority"`
 	Status                           string     `db:"status"`
+	FailureReason                    *string    `db:"failure_reason"`
 	CreatedAt                        time.Time  `db:"created_at"`
 	UpdatedAt                        time.Time  `db:"updated_at"`
+	HyperparametersJSON              *string    `db:"hyperparameters_json"`
 }
 
 func (m *FinetuneRepositoryModel) ToEntity() (*entities.Finetune, error) {
@@ -37,6 +45,14 @@ func (m *FinetuneRepositoryModel) ToEntity() (*entities.Finetune, error) {
 		}
 	}
 
+	var hyperparameters *entities.FinetuneHyperparameters
+	if m.HyperparametersJSON != nil && *m.HyperparametersJSON != "" {
+		hyperparameters = &entities.FinetuneHyperparameters{}
+		if err := json.Unmarshal([]byte(*m.HyperparametersJSON), hyperparameters); err != nil {
+			return nil, err
+		}
+	}
+
 	return &entities.Finetune{
 		ID:                               m.ID,
 		ProjectID:                        m.ProjectID,
@@ -47,12 +63,20 @@ func (m *FinetuneRepositoryModel) ToEntity() (*entities.Finetune, error) {
 		ModelSizeParameter:               m.ModelSizeParameter,
 		ModelDtype:                       m.ModelDtype,
 		ModelQuantization:                m.ModelQuantization,
+		ModelFileSizeBytes:               m.ModelFileSizeBytes,
+		ModelSHA256:                      m.ModelSHA256,
 		InferenceSamples:                 inferenceSamples,
 		TrainingDatasetID:                m.TrainingDatasetID,
 		TrainingDatasetNumberExamples:    m.TrainingDatasetNumberExamples,
 		TrainingDatasetSelectRandom:      m.TrainingDatasetSelectRandom,
+		TrainingDatasetMinQualityScore:   m.TrainingDatasetMinQualityScore,
+		Hyperparameters:                  hyperparameters,
 		TrainingTimeSeconds:              m.TrainingTimeSeconds,
+		Backend:                          entities.FinetuneBackendType(m.Backend),
+		BackendJobID:                     m.BackendJobID,
+		Priority:                         entities.FinetunePriority(m.Priority),
 		Status:                           entities.FinetuneStatus(m.Status),
+		FailureReason:                    m.FailureReason,
 		CreatedAt:                        m.CreatedAt,
 		UpdatedAt:                        m.UpdatedAt,
 	}, nil
@@ -64,6 +88,16 @@ func FromFinetuneEntity(f *entities.Finetune) (*FinetuneRepositoryModel, error)
 		return nil, err
 	}
 
+	var hyperparametersJSON *string
+	if f.Hyperparameters != nil {
+		data, err := json.Marshal(f.Hyperparameters)
+		if err != nil {
+			return nil, err
+		}
+		value := string(data)
+		hyperparametersJSON = &value
+	}
+
 	return &FinetuneRepositoryModel{
 		ID:                               f.ID,
 		ProjectID:                        f.ProjectID,
@@ -74,13 +108,21 @@ func FromFinetuneEntity(f *entities.Finetune) (*FinetuneRepositoryModel, error)
 		ModelSizeParameter:               f.ModelSizeParameter,
 		ModelDtype:                       f.ModelDtype,
 		ModelQuantization:                f.ModelQuantization,
+		ModelFileSizeBytes:               f.ModelFileSizeBytes,
+		ModelSHA256:                      f.ModelSHA256,
 		InferenceSamplesJSON:             string(inferenceSamplesJSON),
 		TrainingDatasetID:                f.TrainingDatasetID,
 		TrainingDatasetNumberExamples:    f.TrainingDatasetNumberExamples,
 		TrainingDatasetSelectRandom:      f.TrainingDatasetSelectRandom,
+		TrainingDatasetMinQualityScore:   f.TrainingDatasetMinQualityScore,
 		TrainingTimeSeconds:              f.TrainingTimeSeconds,
+		Backend:                          string(f.Backend),
+		BackendJobID:                     f.BackendJobID,
+		Priority:                         string(f.Priority),
 		Status:                           string(f.Status),
+		FailureReason:                    f.FailureReason,
 		CreatedAt:                        f.CreatedAt,
 		UpdatedAt:                        f.UpdatedAt,
+		HyperparametersJSON:              hyperparametersJSON,
 	}, nil
 }
\ No newline at end of file
@@ -0,0 +1,110 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+// sqlExecutor is implemented by *sql.DB and *sql.Tx, so an insert can be part of another repository's transaction
+type sqlExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+type OutboxJobRepositoryImpl struct {
+	Db *sql.DB
+}
+
+func insertOutboxJob(ctx context.Context, exec sqlExecutor, job *entities.OutboxJob) error {
+	query := `INSERT INTO outbox_jobs (
+		id, type, entity_id, payload_json, status, attempts, last_error, next_attempt_at, delivered_at, created_at, updated_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	now := time.Now()
+	job.CreatedAt = now
+	job.UpdatedAt = now
+	if job.NextAttemptAt.IsZero() {
+		job.NextAttemptAt = now
+	}
+
+	model := FromOutboxJobEntity(job)
+	_, err := exec.ExecContext(ctx, query,
+		model.ID,
+		model.Type,
+		model.EntityID,
+		model.PayloadJSON,
+		model.Status,
+		model.Attempts,
+		model.LastError,
+		model.NextAttemptAt,
+		model.DeliveredAt,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+	return err
+}
+
+func (r *OutboxJobRepositoryImpl) ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]entities.OutboxJob, error) {
+	// SKIP LOCKED lets several dispatchers claim different jobs without waiting for each other
+	query := `UPDATE outbox_jobs SET next_attempt_at = $3, updated_at = $1
+	WHERE id IN (
+		SELECT id FROM outbox_jobs
+		WHERE status = $4 AND next_attempt_at <= $1
+		ORDER BY next_attempt_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING id, type, entity_id, payload_json, status, attempts, last_error, next_attempt_at, delivered_at, created_at, updated_at`
+
+	rows, err := r.Db.QueryContext(ctx, query, time.Now(), limit, leaseUntil, string(entities.OutboxJobStatusPending))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []entities.OutboxJob
+	for rows.Next() {
+		var model OutboxJobRepositoryModel
+		err := rows.Scan(
+			&model.ID,
+			&model.Type,
+			&model.EntityID,
+			&model.PayloadJSON,
+			&model.Status,
+			&model.Attempts,
+			&model.LastError,
+			&model.NextAttemptAt,
+			&model.DeliveredAt,
+			&model.CreatedAt,
+			&model.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *model.ToEntity())
+	}
+
+	return jobs, rows.Err()
+}
+
+func (r *OutboxJobRepositoryImpl) Update(ctx context.Context, job *entities.OutboxJob) error {
+	query := `UPDATE outbox_jobs SET
+		status = $2, attempts = $3, last_error = $4, next_attempt_at = $5, delivered_at = $6, updated_at = $7
+	WHERE id = $1`
+
+	job.UpdatedAt = time.Now()
+
+	model := FromOutboxJobEntity(job)
+	_, err := r.Db.ExecContext(ctx, query,
+		model.ID,
+		model.Status,
+		model.Attempts,
+		model.LastError,
+		model.NextAttemptAt,
+		model.DeliveredAt,
+		model.UpdatedAt,
+	)
+	return err
+}
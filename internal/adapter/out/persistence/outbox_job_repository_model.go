@@ -0,0 +1,55 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type OutboxJobRepositoryModel struct {
+	ID            uuid.UUID  `db:"id"`
+	Type          string     `db:"type"`
+	EntityID      uuid.UUID  `db:"entity_id"`
+	PayloadJSON   string     `db:"payload_json"`
+	Status        string     `db:"status"`
+	Attempts      int        `db:"attempts"`
+	LastError     *string    `db:"last_error"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	DeliveredAt   *time.Time `db:"delivered_at"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+func (m *OutboxJobRepositoryModel) ToEntity() *entities.OutboxJob {
+	return &entities.OutboxJob{
+		ID:            m.ID,
+		Type:          entities.OutboxJobType(m.Type),
+		EntityID:      m.EntityID,
+		Payload:       []byte(m.PayloadJSON),
+		Status:        entities.OutboxJobStatus(m.Status),
+		Attempts:      m.Attempts,
+		LastError:     m.LastError,
+		NextAttemptAt: m.NextAttemptAt,
+		DeliveredAt:   m.DeliveredAt,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+func FromOutboxJobEntity(job *entities.OutboxJob) *OutboxJobRepositoryModel {
+	return &OutboxJobRepositoryModel{
+		ID:            job.ID,
+		Type:          string(job.Type),
+		EntityID:      job.EntityID,
+		PayloadJSON:   string(job.Payload),
+		Status:        string(job.Status),
+		Attempts:      job.Attempts,
+		LastError:     job.LastError,
+		NextAttemptAt: job.NextAttemptAt,
+		DeliveredAt:   job.DeliveredAt,
+		CreatedAt:     job.CreatedAt,
+		UpdatedAt:     job.UpdatedAt,
+	}
+}
@@ -0,0 +1,89 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type PIIReportRepositoryImpl struct {
+	Db *sql.DB
+}
+
+func (r *PIIReportRepositoryImpl) Create(ctx context.Context, report *entities.PIIReport) error {
+	return insertPIIReport(ctx, r.Db, report)
+}
+
+// insertPIIReport is shared with the finetune repository, which stores the report in the transaction of the finetune
+func insertPIIReport(ctx context.Context, exec sqlExecutor, report *entities.PIIReport) error {
+	query := `INSERT INTO pii_reports (
+		id, training_dataset_id, finetune_id, redacted, items_scanned, items_with_pii,
+		counts_json, findings_json, created_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	if report.CreatedAt.IsZero() {
+		report.CreatedAt = time.Now()
+	}
+
+	model, err := FromPIIReportEntity(report)
+	if err != nil {
+		return err
+	}
+
+	_, err = exec.ExecContext(ctx, query,
+		model.ID,
+		model.TrainingDatasetID,
+		model.FinetuneID,
+		model.Redacted,
+		model.ItemsScanned,
+		model.ItemsWithPII,
+		model.CountsJSON,
+		model.FindingsJSON,
+		model.CreatedAt,
+	)
+	return err
+}
+
+func (r *PIIReportRepositoryImpl) GetByTrainingDatasetID(ctx context.Context, trainingDatasetID uuid.UUID) ([]*entities.PIIReport, error) {
+	query := `SELECT
+		id, training_dataset_id, finetune_id, redacted, items_scanned, items_with_pii,
+		counts_json, findings_json, created_at
+	FROM pii_reports WHERE training_dataset_id = $1 ORDER BY created_at DESC`
+
+	rows, err := r.Db.QueryContext(ctx, query, trainingDatasetID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []*entities.PIIReport
+	for rows.Next() {
+		var model PIIReportRepositoryModel
+		err := rows.Scan(
+			&model.ID,
+			&model.TrainingDatasetID,
+			&model.FinetuneID,
+			&model.Redacted,
+			&model.ItemsScanned,
+			&model.ItemsWithPII,
+			&model.CountsJSON,
+			&model.FindingsJSON,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		report, err := model.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, rows.Err()
+}
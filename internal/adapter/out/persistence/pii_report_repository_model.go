@@ -0,0 +1,74 @@
+package persistence
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type PIIReportRepositoryModel struct {
+	ID                uuid.UUID  `db:"id"`
+	TrainingDatasetID uuid.UUID  `db:"training_dataset_id"`
+	FinetuneID        *uuid.UUID `db:"finetune_id"`
+	Redacted          bool       `db:"redacted"`
+	ItemsScanned      int        `db:"items_scanned"`
+	ItemsWithPII      int        `db:"items_with_pii"`
+	CountsJSON        string     `db:"counts_json"`
+	FindingsJSON      string     `db:"findings_json"`
+	CreatedAt         time.Time  `db:"created_at"`
+}
+
+func (m *PIIReportRepositoryModel) ToEntity() (*entities.PIIReport, error) {
+	counts := make(map[entities.PIIType]int)
+	if err := json.Unmarshal([]byte(m.CountsJSON), &counts); err != nil {
+		return nil, err
+	}
+
+	var findings []entities.PIIFinding
+	if err := json.Unmarshal([]byte(m.FindingsJSON), &findings); err != nil {
+		return nil, err
+	}
+
+	return &entities.PIIReport{
+		ID:                m.ID,
+		TrainingDatasetID: m.TrainingDatasetID,
+		FinetuneID:        m.FinetuneID,
+		Redacted:          m.Redacted,
+		ItemsScanned:      m.ItemsScanned,
+		ItemsWithPII:      m.ItemsWithPII,
+		Counts:            counts,
+		Findings:          findings,
+		CreatedAt:         m.CreatedAt,
+	}, nil
+}
+
+func FromPIIReportEntity(r *entities.PIIReport) (*PIIReportRepositoryModel, error) {
+	countsJSON, err := json.Marshal(r.Counts)
+	if err != nil {
+		return nil, err
+	}
+
+	findings := r.Findings
+	if findings == nil {
+		findings = []entities.PIIFinding{}
+	}
+	findingsJSON, err := json.Marshal(findings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PIIReportRepositoryModel{
+		ID:                r.ID,
+		TrainingDatasetID: r.TrainingDatasetID,
+		FinetuneID:        r.FinetuneID,
+		Redacted:          r.Redacted,
+		ItemsScanned:      r.ItemsScanned,
+		ItemsWithPII:      r.ItemsWithPII,
+		CountsJSON:        string(countsJSON),
+		FindingsJSON:      string(findingsJSON),
+		CreatedAt:         r.CreatedAt,
+	}, nil
+}
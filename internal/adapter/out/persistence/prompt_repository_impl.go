@@ -3,6 +3,7 @@ package persistence
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,44 +17,92 @@ type PromptRepositoryImpl struct {
 
 
 func (r *PromptRepositoryImpl) Create(ctx context.Context, prompt *entities.Prompt) error {
-	query := `INSERT INTO prompts (id, version, text, created_at, updated_at)
-			  VALUES ($1, $2, $3, $4, $5)`
+	return insertPromptVersion(ctx, r.Db, prompt)
+}
+
+// promptVersionExecutor is implemented by *sql.DB and *sql.Tx, the version is inserted and read back in the same
+// transaction as the training dataset that uses it
+type promptVersionExecutor interface {
+	sqlExecutor
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// insertPromptVersion stores a new prompt version. When a concurrent request stored the same version of the project
+// first, its row is reused if it has the same text, and the prompt gets its ID.
+func insertPromptVersion(ctx context.Context, exec promptVersionExecutor, prompt *entities.Prompt) error {
+	query := `INSERT INTO prompts (id, project_id, version, previous_prompt_id, text, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7)
+			  ON CONFLICT (project_id, version) DO NOTHING`
 
 	now := time.Now()
 	prompt.CreatedAt = now
 	prompt.UpdatedAt = now
 
-	_, err := r.Db.ExecContext(ctx, query,
-		prompt.ID,
-		prompt.Version,
-		prompt.Text,
-		prompt.CreatedAt,
-		prompt.UpdatedAt,
+	model := FromPromptEntity(prompt)
+	_, err := exec.ExecContext(ctx, query,
+		model.ID,
+		model.ProjectID,
+		model.Version,
+		model.PreviousPromptID,
+		model.Text,
+		model.CreatedAt,
+		model.UpdatedAt,
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	var stored PromptRepositoryModel
+	err = exec.QueryRowContext(ctx, `SELECT id, text, created_at, updated_at FROM prompts WHERE project_id = $1 AND version = $2`,
+		model.ProjectID, model.Version,
+	).Scan(&stored.ID, &stored.Text, &stored.CreatedAt, &stored.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if stored.Text != model.Text {
+		return fmt.Errorf("prompt conflict, version %d was saved with another text", model.Version)
+	}
+
+	prompt.ID = stored.ID
+	prompt.CreatedAt = stored.CreatedAt
+	prompt.UpdatedAt = stored.UpdatedAt
+	return nil
 }
 
 func (r *PromptRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entities.Prompt, error) {
-	query := `SELECT id, version, text, created_at, updated_at FROM prompts WHERE id = $1`
-
-	var model PromptRepositoryModel
-	err := r.Db.QueryRowContext(ctx, query, id).Scan(
-		&model.ID,
-		&model.Version,
-		&model.Text,
-		&model.CreatedAt,
-		&model.UpdatedAt,
-	)
+	query := `SELECT id, project_id, version, previous_prompt_id, text, created_at, updated_at FROM prompts WHERE id = $1`
 
+	prompts, err := r.queryPrompts(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(prompts) == 0 {
+		return nil, nil
+	}
+
+	return prompts[0], nil
+}
+
+func (r *PromptRepositoryImpl) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entities.Prompt, error) {
+	query := `SELECT id, project_id, version, previous_prompt_id, text, created_at, updated_at
+			  FROM prompts WHERE project_id = $1 ORDER BY version DESC`
+
+	return r.queryPrompts(ctx, query, projectID)
+}
+
+func (r *PromptRepositoryImpl) GetLatestByProjectID(ctx context.Context, projectID uuid.UUID) (*entities.Prompt, error) {
+	query := `SELECT id, project_id, version, previous_prompt_id, text, created_at, updated_at
+			  FROM prompts WHERE project_id = $1 ORDER BY version DESC LIMIT 1`
+
+	prompts, err := r.queryPrompts(ctx, query, projectID)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
 		return nil, err
 	}
+	if len(prompts) == 0 {
+		return nil, nil
+	}
 
-	return model.ToEntity(), nil
+	return prompts[0], nil
 }
 
 func (r *PromptRepositoryImpl) Update(ctx context.Context, prompt *entities.Prompt) error {
@@ -75,4 +124,33 @@ func (r *PromptRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM prompts WHERE id = $1`
 	_, err := r.Db.ExecContext(ctx, query, id)
 	return err
-}
\ No newline at end of file
+}
+
+func (r *PromptRepositoryImpl) queryPrompts(ctx context.Context, query string, args ...interface{}) ([]*entities.Prompt, error) {
+	rows, err := r.Db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prompts []*entities.Prompt
+	for rows.Next() {
+		var model PromptRepositoryModel
+		err := rows.Scan(
+			&model.ID,
+			&model.ProjectID,
+			&model.Version,
+			&model.PreviousPromptID,
+			&model.Text,
+			&model.CreatedAt,
+			&model.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		prompts = append(prompts, model.ToEntity())
+	}
+
+	return prompts, rows.Err()
+}
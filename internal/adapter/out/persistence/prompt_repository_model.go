@@ -9,29 +9,41 @@ import (
 )
 
 type PromptRepositoryModel struct {
-	ID        uuid.UUID `db:"id"`
-	Version   int       `db:"version"`
-	Text      string    `db:"text"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID               uuid.UUID  `db:"id"`
+	ProjectID        *uuid.UUID `db:"project_id"`
+	Version          int        `db:"version"`
+	PreviousPromptID *uuid.UUID `db:"previous_prompt_id"`
+	Text             string     `db:"text"`
+	CreatedAt        time.Time  `db:"created_at"`
+	UpdatedAt        time.Time  `db:"updated_at"`
 }
 
 func (m *PromptRepositoryModel) ToEntity() *entities.Prompt {
+	// Prompts that no training dataset used before prompts were versioned have no project
+	var projectID uuid.UUID
+	if m.ProjectID != nil {
+		projectID = *m.ProjectID
+	}
+
 	return &entities.Prompt{
-		ID:        m.ID,
-		Version:   m.Version,
-		Text:      m.Text,
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
+		ID:               m.ID,
+		ProjectID:        projectID,
+		Version:          m.Version,
+		PreviousPromptID: m.PreviousPromptID,
+		Text:             m.Text,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
 	}
 }
 
 func FromPromptEntity(prompt *entities.Prompt) *PromptRepositoryModel {
 	return &PromptRepositoryModel{
-		ID:        prompt.ID,
-		Version:   prompt.Version,
-		Text:      prompt.Text,
-		CreatedAt: prompt.CreatedAt,
-		UpdatedAt: prompt.UpdatedAt,
+		ID:               prompt.ID,
+		ProjectID:        &prompt.ProjectID,
+		Version:          prompt.Version,
+		PreviousPromptID: prompt.PreviousPromptID,
+		Text:             prompt.Text,
+		CreatedAt:        prompt.CreatedAt,
+		UpdatedAt:        prompt.UpdatedAt,
 	}
 }
\ No newline at end of file
@@ -0,0 +1,144 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type QualityScoringJobRepositoryImpl struct {
+	Db *sql.DB
+}
+
+const qualityScoringJobColumns = `id, training_dataset_id, status, model, rubric, rescore, items_total, items_scored,
+	items_failed, attempts, last_error, lease_until, started_at, finished_at, created_at, updated_at`
+
+func (r *QualityScoringJobRepositoryImpl) Create(ctx context.Context, job *entities.QualityScoringJob) (bool, error) {
+	// The partial unique index is the guard against two runs over the same training dataset
+	query := `INSERT INTO quality_scoring_jobs (` + qualityScoringJobColumns + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+	ON CONFLICT (training_dataset_id) WHERE status IN ('PENDING', 'RUNNING') DO NOTHING`
+
+	if job.ID == uuid.Nil {
+		job.ID = uuid.New()
+	}
+	now := time.Now()
+	job.CreatedAt = now
+	job.UpdatedAt = now
+
+	model := FromQualityScoringJobEntity(job)
+	result, err := r.Db.ExecContext(ctx, query,
+		model.ID,
+		model.TrainingDatasetID,
+		model.Status,
+		model.Model,
+		model.Rubric,
+		model.Rescore,
+		model.ItemsTotal,
+		model.ItemsScored,
+		model.ItemsFailed,
+		model.Attempts,
+		model.LastError,
+		model.LeaseUntil,
+		model.StartedAt,
+		model.FinishedAt,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected == 1, nil
+}
+
+func (r *QualityScoringJobRepositoryImpl) ClaimNext(ctx context.Context, leaseUntil time.Time) (*entities.QualityScoringJob, error) {
+	// SKIP LOCKED lets several workers claim different jobs without waiting for each other
+	query := `UPDATE quality_scoring_jobs SET
+		status = $3, attempts = attempts + 1, lease_until = $2, started_at = COALESCE(started_at, $1), updated_at = $1
+	WHERE id = (
+		SELECT id FROM quality_scoring_jobs
+		WHERE status = $4 OR (status = $3 AND lease_until < $1)
+		ORDER BY created_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING ` + qualityScoringJobColumns
+
+	return r.queryQualityScoringJob(ctx, query,
+		time.Now(),
+		leaseUntil,
+		string(entities.QualityScoringJobStatusRunning),
+		string(entities.QualityScoringJobStatusPending),
+	)
+}
+
+func (r *QualityScoringJobRepositoryImpl) Update(ctx context.Context, job *entities.QualityScoringJob) error {
+	query := `UPDATE quality_scoring_jobs SET
+		status = $2, items_total = $3, items_scored = $4, items_failed = $5, last_error = $6, lease_until = $7,
+		finished_at = $8, updated_at = $9
+	WHERE id = $1`
+
+	job.UpdatedAt = time.Now()
+
+	model := FromQualityScoringJobEntity(job)
+	_, err := r.Db.ExecContext(ctx, query,
+		model.ID,
+		model.Status,
+		model.ItemsTotal,
+		model.ItemsScored,
+		model.ItemsFailed,
+		model.LastError,
+		model.LeaseUntil,
+		model.FinishedAt,
+		model.UpdatedAt,
+	)
+	return err
+}
+
+func (r *QualityScoringJobRepositoryImpl) GetLatestByTrainingDatasetID(ctx context.Context, trainingDatasetID uuid.UUID) (*entities.QualityScoringJob, error) {
+	query := `SELECT ` + qualityScoringJobColumns + `
+	FROM quality_scoring_jobs WHERE training_dataset_id = $1
+	ORDER BY created_at DESC
+	LIMIT 1`
+
+	return r.queryQualityScoringJob(ctx, query, trainingDatasetID)
+}
+
+func (r *QualityScoringJobRepositoryImpl) queryQualityScoringJob(ctx context.Context, query string, args ...interface{}) (*entities.QualityScoringJob, error) {
+	var model QualityScoringJobRepositoryModel
+	err := r.Db.QueryRowContext(ctx, query, args...).Scan(
+		&model.ID,
+		&model.TrainingDatasetID,
+		&model.Status,
+		&model.Model,
+		&model.Rubric,
+		&model.Rescore,
+		&model.ItemsTotal,
+		&model.ItemsScored,
+		&model.ItemsFailed,
+		&model.Attempts,
+		&model.LastError,
+		&model.LeaseUntil,
+		&model.StartedAt,
+		&model.FinishedAt,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
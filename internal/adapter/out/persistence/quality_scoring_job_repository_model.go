@@ -0,0 +1,70 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type QualityScoringJobRepositoryModel struct {
+	ID                uuid.UUID  `db:"id"`
+	TrainingDatasetID uuid.UUID  `db:"training_dataset_id"`
+	Status            string     `db:"status"`
+	Model             string     `db:"model"`
+	Rubric            string     `db:"rubric"`
+	Rescore           bool       `db:"rescore"`
+	ItemsTotal        int        `db:"items_total"`
+	ItemsScored       int        `db:"items_scored"`
+	ItemsFailed       int        `db:"items_failed"`
+	Attempts          int        `db:"attempts"`
+	LastError         *string    `db:"last_error"`
+	LeaseUntil        *time.Time `db:"lease_until"`
+	StartedAt         *time.Time `db:"started_at"`
+	FinishedAt        *time.Time `db:"finished_at"`
+	CreatedAt         time.Time  `db:"created_at"`
+	UpdatedAt         time.Time  `db:"updated_at"`
+}
+
+func (m *QualityScoringJobRepositoryModel) ToEntity() *entities.QualityScoringJob {
+	return &entities.QualityScoringJob{
+		ID:                m.ID,
+		TrainingDatasetID: m.TrainingDatasetID,
+		Status:            entities.QualityScoringJobStatus(m.Status),
+		Model:             m.Model,
+		Rubric:            m.Rubric,
+		Rescore:           m.Rescore,
+		ItemsTotal:        m.ItemsTotal,
+		ItemsScored:       m.ItemsScored,
+		ItemsFailed:       m.ItemsFailed,
+		Attempts:          m.Attempts,
+		LastError:         m.LastError,
+		LeaseUntil:        m.LeaseUntil,
+		StartedAt:         m.StartedAt,
+		FinishedAt:        m.FinishedAt,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}
+}
+
+func FromQualityScoringJobEntity(job *entities.QualityScoringJob) *QualityScoringJobRepositoryModel {
+	return &QualityScoringJobRepositoryModel{
+		ID:                job.ID,
+		TrainingDatasetID: job.TrainingDatasetID,
+		Status:            string(job.Status),
+		Model:             job.Model,
+		Rubric:            job.Rubric,
+		Rescore:           job.Rescore,
+		ItemsTotal:        job.ItemsTotal,
+		ItemsScored:       job.ItemsScored,
+		ItemsFailed:       job.ItemsFailed,
+		Attempts:          job.Attempts,
+		LastError:         job.LastError,
+		LeaseUntil:        job.LeaseUntil,
+		StartedAt:         job.StartedAt,
+		FinishedAt:        job.FinishedAt,
+		CreatedAt:         job.CreatedAt,
+		UpdatedAt:         job.UpdatedAt,
+	}
+}
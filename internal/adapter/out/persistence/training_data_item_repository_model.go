@@ -19,6 +19,12 @@ type TrainingDataItemRepositoryModel struct {
 	SourceDocumentEnd     *string    `db:"source_document_end"`
 	GenerationTimeSeconds float64    `db:"generation_time_seconds"`
 	Deleted               bool       `db:"deleted"`
+	DeletedReason         *string    `db:"deleted_reason"`
+	Split                 string     `db:"split"`
+	QualityScore          *float64   `db:"quality_score"`
+	QualityRationale      *string    `db:"quality_rationale"`
+	GeneratePromptID      *uuid.UUID `db:"generate_prompt_id"`
+	MessagesJSON          *string    `db:"messages_json"`
 	CreatedAt             time.Time  `db:"created_at"`
 	UpdatedAt             time.Time  `db:"updated_at"`
 }
@@ -29,15 +35,28 @@ func (m *TrainingDataItemRepositoryModel) ToEntity() (*entities.TrainingDataItem
 		return nil, err
 	}
 
+	var messages []entities.ConversationMessage
+	if m.MessagesJSON != nil {
+		if err := json.Unmarshal([]byte(*m.MessagesJSON), &messages); err != nil {
+			return nil, err
+		}
+	}
+
 	return &entities.TrainingDataItem{
 		ID:                    m.ID,
 		Values:                values,
+		Messages:              messages,
 		CorrectsID:            m.CorrectsID,
 		SourceDocument:        m.SourceDocument,
 		SourceDocumentStart:   m.SourceDocumentStart,
 		SourceDocumentEnd:     m.SourceDocumentEnd,
 		GenerationTimeSeconds: m.GenerationTimeSeconds,
 		Deleted:               m.Deleted,
+		DeletedReason:         m.DeletedReason,
+		Split:                 entities.TrainingDataItemSplit(m.Split),
+		QualityScore:          m.QualityScore,
+		QualityRationale:      m.QualityRationale,
+		GeneratePromptID:      m.GeneratePromptID,
 		CreatedAt:             m.CreatedAt,
 		UpdatedAt:             m.UpdatedAt,
 	}, nil
@@ -49,6 +68,22 @@ func FromTrainingDataItemEntity(tdi *entities.TrainingDataItem, trainingDatasetI
 		return nil, err
 	}
 
+	var messagesJSON *string
+	if len(tdi.Messages) > 0 {
+		data, err := json.Marshal(tdi.Messages)
+		if err != nil {
+			return nil, err
+		}
+		value := string(data)
+		messagesJSON = &value
+	}
+
+	// Items without an explicit split are part of the training split
+	split := tdi.Split
+	if split == "" {
+		split = entities.TrainingDataItemSplitTrain
+	}
+
 	return &TrainingDataItemRepositoryModel{
 		ID:                    tdi.ID,
 		TrainingDatasetID:     trainingDatasetID,
@@ -59,6 +94,12 @@ func FromTrainingDataItemEntity(tdi *entities.TrainingDataItem, trainingDatasetI
 		SourceDocumentEnd:     tdi.SourceDocumentEnd,
 		GenerationTimeSeconds: tdi.GenerationTimeSeconds,
 		Deleted:               tdi.Deleted,
+		DeletedReason:         tdi.DeletedReason,
+		Split:                 string(split),
+		QualityScore:          tdi.QualityScore,
+		QualityRationale:      tdi.QualityRationale,
+		GeneratePromptID:      tdi.GeneratePromptID,
+		MessagesJSON:          messagesJSON,
 		CreatedAt:             tdi.CreatedAt,
 		UpdatedAt:             tdi.UpdatedAt,
 	}, nil
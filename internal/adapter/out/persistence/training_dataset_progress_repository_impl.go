@@ -0,0 +1,79 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type TrainingDatasetProgressRepositoryImpl struct {
+	Db *sql.DB
+}
+
+func (r *TrainingDatasetProgressRepositoryImpl) Save(ctx context.Context, progress *entities.TrainingDatasetProgress) error {
+	query := `INSERT INTO training_dataset_progress (
+		training_dataset_id, items_generated, chunks_processed, chunks_total, tokens_in, tokens_out,
+		eta_seconds, started_at, updated_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	ON CONFLICT (training_dataset_id) DO UPDATE SET
+		items_generated = EXCLUDED.items_generated,
+		chunks_processed = EXCLUDED.chunks_processed,
+		chunks_total = EXCLUDED.chunks_total,
+		tokens_in = EXCLUDED.tokens_in,
+		tokens_out = EXCLUDED.tokens_out,
+		eta_seconds = EXCLUDED.eta_seconds,
+		started_at = EXCLUDED.started_at,
+		updated_at = EXCLUDED.updated_at`
+
+	now := time.Now()
+	if progress.StartedAt.IsZero() {
+		progress.StartedAt = now
+	}
+	progress.UpdatedAt = now
+
+	model := FromTrainingDatasetProgressEntity(progress)
+	_, err := r.Db.ExecContext(ctx, query,
+		model.TrainingDatasetID,
+		model.ItemsGenerated,
+		model.ChunksProcessed,
+		model.ChunksTotal,
+		model.TokensIn,
+		model.TokensOut,
+		model.EtaSeconds,
+		model.StartedAt,
+		model.UpdatedAt,
+	)
+	return err
+}
+
+func (r *TrainingDatasetProgressRepositoryImpl) GetByTrainingDatasetID(ctx context.Context, trainingDatasetID uuid.UUID) (*entities.TrainingDatasetProgress, error) {
+	query := `SELECT
+		training_dataset_id, items_generated, chunks_processed, chunks_total, tokens_in, tokens_out,
+		eta_seconds, started_at, updated_at
+	FROM training_dataset_progress WHERE training_dataset_id = $1`
+
+	var model TrainingDatasetProgressRepositoryModel
+	err := r.Db.QueryRowContext(ctx, query, trainingDatasetID).Scan(
+		&model.TrainingDatasetID,
+		&model.ItemsGenerated,
+		&model.ChunksProcessed,
+		&model.ChunksTotal,
+		&model.TokensIn,
+		&model.TokensOut,
+		&model.EtaSeconds,
+		&model.StartedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
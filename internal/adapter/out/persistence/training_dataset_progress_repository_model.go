@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type TrainingDatasetProgressRepositoryModel struct {
+	TrainingDatasetID uuid.UUID `db:"training_dataset_id"`
+	ItemsGenerated    int       `db:"items_generated"`
+	ChunksProcessed   int       `db:"chunks_processed"`
+	ChunksTotal       *int      `db:"chunks_total"`
+	TokensIn          int       `db:"tokens_in"`
+	TokensOut         int       `db:"tokens_out"`
+	EtaSeconds        *float64  `db:"eta_seconds"`
+	StartedAt         time.Time `db:"started_at"`
+	UpdatedAt         time.Time `db:"updated_at"`
+}
+
+func (m *TrainingDatasetProgressRepositoryModel) ToEntity() *entities.TrainingDatasetProgress {
+	return &entities.TrainingDatasetProgress{
+		TrainingDatasetID: m.TrainingDatasetID,
+		ItemsGenerated:    m.ItemsGenerated,
+		ChunksProcessed:   m.ChunksProcessed,
+		ChunksTotal:       m.ChunksTotal,
+		TokensIn:          m.TokensIn,
+		TokensOut:         m.TokensOut,
+		EtaSeconds:        m.EtaSeconds,
+		StartedAt:         m.StartedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}
+}
+
+func FromTrainingDatasetProgressEntity(progress *entities.TrainingDatasetProgress) *TrainingDatasetProgressRepositoryModel {
+	return &TrainingDatasetProgressRepositoryModel{
+		TrainingDatasetID: progress.TrainingDatasetID,
+		ItemsGenerated:    progress.ItemsGenerated,
+		ChunksProcessed:   progress.ChunksProcessed,
+		ChunksTotal:       progress.ChunksTotal,
+		TokensIn:          progress.TokensIn,
+		TokensOut:         progress.TokensOut,
+		EtaSeconds:        progress.EtaSeconds,
+		StartedAt:         progress.StartedAt,
+		UpdatedAt:         progress.UpdatedAt,
+	}
+}
@@ -3,6 +3,9 @@ package persistence
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,14 +18,41 @@ type TrainingDatasetRepositoryImpl struct {
 }
 
 func (r *TrainingDatasetRepositoryImpl) Create(ctx context.Context, trainingDataset *entities.TrainingDataset) error {
+	return r.create(ctx, r.Db, trainingDataset)
+}
+
+func (r *TrainingDatasetRepositoryImpl) CreateWithOutboxJob(ctx context.Context, trainingDataset *entities.TrainingDataset, job *entities.OutboxJob, prompt *entities.Prompt) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if prompt != nil {
+		if err := insertPromptVersion(ctx, tx, prompt); err != nil {
+			return err
+		}
+		trainingDataset.GeneratePromptID = prompt.ID
+	}
+	if err := r.create(ctx, tx, trainingDataset); err != nil {
+		return err
+	}
+	if err := insertOutboxJob(ctx, tx, job); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *TrainingDatasetRepositoryImpl) create(ctx context.Context, exec sqlExecutor, trainingDataset *entities.TrainingDataset) error {
 	query := `INSERT INTO training_datasets (
 		id, project_id, version, generate_model, generate_model_runner,
 		generate_gpu_info_card, generate_gpu_info_total_gb, generate_gpu_info_cuda_version,
 		input_field, output_field, json_object_fields_json, expected_output_size_chars,
 		total_generation_time_seconds,
 		generate_prompt_history_ids_json, generate_prompt_id, corpus_id,
-		language_iso, status, field_names_json, generate_examples_number, created_at, updated_at
-	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)`
+		language_iso, status, field_names_json, generate_examples_number, chunking_config_json, extends_training_dataset_id, type, conversation_config_json, created_at, updated_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26)`
 
 	now := time.Now()
 	trainingDataset.CreatedAt = now
@@ -33,7 +63,7 @@ func (r *TrainingDatasetRepositoryImpl) Create(ctx context.Context, trainingData
 		return err
 	}
 
-	_, err = r.Db.ExecContext(ctx, query,
+	_, err = exec.ExecContext(ctx, query,
 		model.ID,
 		model.ProjectID,
 		model.Version,
@@ -51,9 +81,12 @@ func (r *TrainingDatasetRepositoryImpl) Create(ctx context.Context, trainingData
 		model.GeneratePromptID,
 		model.CorpusID,
 		model.LanguageISO,
-		model.Status,
 		model.FieldNamesJSON,
 		model.GenerateExamplesNumber,
+		model.ChunkingConfigJSON,
+		model.ExtendsTrainingDatasetID,
+		model.Type,
+		model.ConversationConfigJSON,
 		model.CreatedAt,
 		model.UpdatedAt,
 	)
@@ -63,17 +96,32 @@ func (r *TrainingDatasetRepositoryImpl) Create(ctx context.Context, trainingData
 	}
 
 	// Create training data items
-	return r.createTrainingDataItems(ctx, trainingDataset)
+	return r.createTrainingDataItems(ctx, exec, trainingDataset)
 }
 
 func (r *TrainingDatasetRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entities.TrainingDataset, error) {
+	entity, err := r.GetMetadataByID(ctx, id)
+	if err != nil || entity == nil {
+		return entity, err
+	}
+
+	// Load training data items
+	entity.Data, err = r.getTrainingDataItemsByDatasetID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (r *TrainingDatasetRepositoryImpl) GetMetadataByID(ctx context.Context, id uuid.UUID) (*entities.TrainingDataset, error) {
 	query := `SELECT
 		id, project_id, version, generate_model, generate_model_runner,
 		generate_gpu_info_card, generate_gpu_info_total_gb, generate_gpu_info_cuda_version,
 		input_field, output_field, json_object_fields_json, expected_output_size_chars,
 		total_generation_time_seconds, tokens_in, tokens_out,
 		generate_prompt_history_ids_json, generate_prompt_id, corpus_id,
-		language_iso, status, field_names_json, generate_examples_number, created_at, updated_at
+		language_iso, status, failure_reason, field_names_json, generate_examples_number, chunking_config_json, extends_training_dataset_id, type, conversation_config_json, created_at, updated_at
 	FROM training_datasets WHERE id = $1`
 
 	var model TrainingDatasetRepositoryModel
@@ -98,8 +146,13 @@ func (r *TrainingDatasetRepositoryImpl) GetByID(ctx context.Context, id uuid.UUI
 		&model.CorpusID,
 		&model.LanguageISO,
 		&model.Status,
+		&model.FailureReason,
 		&model.FieldNamesJSON,
 		&model.GenerateExamplesNumber,
+		&model.ChunkingConfigJSON,
+		&model.ExtendsTrainingDatasetID,
+		&model.Type,
+		&model.ConversationConfigJSON,
 		&model.CreatedAt,
 		&model.UpdatedAt,
 	)
@@ -111,18 +164,7 @@ func (r *TrainingDatasetRepositoryImpl) GetByID(ctx context.Context, id uuid.UUI
 		return nil, err
 	}
 
-	entity, err := model.ToEntity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Load training data items
-	entity.Data, err = r.getTrainingDataItemsByDatasetID(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-
-	return entity, nil
+	return model.ToEntity()
 }
 
 func (r *TrainingDatasetRepositoryImpl) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entities.TrainingDataset, error) {
@@ -132,7 +174,7 @@ func (r *TrainingDatasetRepositoryImpl) GetByProjectID(ctx context.Context, proj
 		input_field, output_field, json_object_fields_json, expected_output_size_chars,
 		total_generation_time_seconds, tokens_in, tokens_out,
 		generate_prompt_history_ids_json, generate_prompt_id, corpus_id,
-		language_iso, status, field_names_json, generate_examples_number, created_at, updated_at
+		language_iso, status, failure_reason, field_names_json, generate_examples_number, chunking_config_json, extends_training_dataset_id, type, conversation_config_json, created_at, updated_at
 	FROM training_datasets WHERE project_id = $1 ORDER BY version DESC`
 
 	rows, err := r.Db.QueryContext(ctx, query, projectID)
@@ -165,8 +207,13 @@ func (r *TrainingDatasetRepositoryImpl) GetByProjectID(ctx context.Context, proj
 			&model.CorpusID,
 			&model.LanguageISO,
 			&model.Status,
+			&model.FailureReason,
 			&model.FieldNamesJSON,
 			&model.GenerateExamplesNumber,
+			&model.ChunkingConfigJSON,
+			&model.ExtendsTrainingDatasetID,
+			&model.Type,
+			&model.ConversationConfigJSON,
 			&model.CreatedAt,
 			&model.UpdatedAt,
 		)
@@ -198,7 +245,7 @@ func (r *TrainingDatasetRepositoryImpl) GetLatestByProjectID(ctx context.Context
 		input_field, output_field, json_object_fields_json, expected_output_size_chars,
 		total_generation_time_seconds, tokens_in, tokens_out,
 		generate_prompt_history_ids_json, generate_prompt_id, corpus_id,
-		language_iso, status, field_names_json, generate_examples_number, created_at, updated_at
+		language_iso, status, failure_reason, field_names_json, generate_examples_number, chunking_config_json, extends_training_dataset_id, type, conversation_config_json, created_at, updated_at
 	FROM training_datasets WHERE project_id = $1 ORDER BY version DESC LIMIT 1`
 
 	var model TrainingDatasetRepositoryModel
@@ -223,8 +270,13 @@ func (r *TrainingDatasetRepositoryImpl) GetLatestByProjectID(ctx context.Context
 		&model.CorpusID,
 		&model.LanguageISO,
 		&model.Status,
+		&model.FailureReason,
 		&model.FieldNamesJSON,
 		&model.GenerateExamplesNumber,
+		&model.ChunkingConfigJSON,
+		&model.ExtendsTrainingDatasetID,
+		&model.Type,
+		&model.ConversationConfigJSON,
 		&model.CreatedAt,
 		&model.UpdatedAt,
 	)
@@ -250,14 +302,129 @@ func (r *TrainingDatasetRepositoryImpl) GetLatestByProjectID(ctx context.Context
 	return entity, nil
 }
 
+// GetPreviousVersionID returns the ID of the newest training dataset of the project older than version, if any
+func (r *TrainingDatasetRepositoryImpl) GetPreviousVersionID(ctx context.Context, projectID uuid.UUID, version int) (*uuid.UUID, error) {
+	query := `SELECT id FROM training_datasets WHERE project_id = $1 AND version < $2 ORDER BY version DESC LIMIT 1`
+
+	var id uuid.UUID
+	err := r.Db.QueryRowContext(ctx, query, projectID, version).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ListGenerationHistory returns the measured usage of the newest finished generations of the project. The examples
+// are the generated items, corrections made after the generation are not counted. Versions extending another
+// version also contain the copied items, for them the requested number of examples is used.
+func (r *TrainingDatasetRepositoryImpl) ListGenerationHistory(ctx context.Context, projectID uuid.UUID, limit int) ([]entities.TrainingDatasetGenerationHistory, error) {
+	query := `SELECT
+		CASE WHEN td.extends_training_dataset_id IS NULL
+			THEN (SELECT COUNT(*) FROM training_data_items i WHERE i.training_dataset_id = td.id AND i.corrects_id IS NULL)
+			ELSE td.generate_examples_number
+		END,
+		td.expected_output_size_chars, td.tokens_in, td.tokens_out, td.total_generation_time_seconds
+	FROM training_datasets td
+	WHERE td.project_id = $1 AND td.status = $2
+		AND td.tokens_in IS NOT NULL AND td.tokens_out IS NOT NULL AND td.total_generation_time_seconds IS NOT NULL
+	ORDER BY td.version DESC LIMIT $3`
+
+	rows, err := r.Db.QueryContext(ctx, query, projectID, entities.TrainingDatasetStatusDone, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []entities.TrainingDatasetGenerationHistory{}
+	for rows.Next() {
+		var generation entities.TrainingDatasetGenerationHistory
+		if err := rows.Scan(
+			&generation.ExamplesNumber,
+			&generation.ExpectedOutputSizeChars,
+			&generation.TokensIn,
+			&generation.TokensOut,
+			&generation.GenerationTimeSeconds,
+		); err != nil {
+			return nil, err
+		}
+		history = append(history, generation)
+	}
+
+	return history, rows.Err()
+}
+
+// ListPromptItems counts the active items of the training datasets of a project per prompt version that generated
+// them. Corrected items count for the correction, which was not generated by a prompt.
+func (r *TrainingDatasetRepositoryImpl) ListPromptItems(ctx context.Context, projectID uuid.UUID) ([]entities.TrainingDatasetPromptItems, error) {
+	query := `SELECT td.id, td.version, td.status, td.generate_prompt_id, i.generate_prompt_id, COUNT(i.id)
+	FROM training_datasets td
+	LEFT JOIN training_data_items i ON i.training_dataset_id = td.id AND i.deleted = false AND i.generate_prompt_id IS NOT NULL
+		AND NOT EXISTS (
+			SELECT 1 FROM training_data_items c WHERE c.corrects_id = i.id AND c.deleted = false
+		)
+	WHERE td.project_id = $1 AND td.status <> $2
+	GROUP BY td.id, td.version, td.status, td.generate_prompt_id, i.generate_prompt_id
+	ORDER BY td.version DESC`
+
+	rows, err := r.Db.QueryContext(ctx, query, projectID, entities.TrainingDatasetStatusDeleted)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	trainingDatasets := []entities.TrainingDatasetPromptItems{}
+	for rows.Next() {
+		var trainingDataset entities.TrainingDatasetPromptItems
+		var itemsPromptID *uuid.UUID
+		var items int
+		if err := rows.Scan(
+			&trainingDataset.TrainingDatasetID,
+			&trainingDataset.TrainingDatasetVersion,
+			&trainingDataset.Status,
+			&trainingDataset.GeneratePromptID,
+			&itemsPromptID,
+			&items,
+		); err != nil {
+			return nil, err
+		}
+
+		// The rows of a dataset follow each other, one row per prompt version of its items
+		last := len(trainingDatasets) - 1
+		if last < 0 || trainingDatasets[last].TrainingDatasetID != trainingDataset.TrainingDatasetID {
+			trainingDataset.ItemsByPromptID = map[uuid.UUID]int{}
+			trainingDatasets = append(trainingDatasets, trainingDataset)
+			last++
+		}
+		if itemsPromptID != nil {
+			trainingDatasets[last].ItemsByPromptID[*itemsPromptID] = items
+		}
+	}
+
+	return trainingDatasets, rows.Err()
+}
+
 func (r *TrainingDatasetRepositoryImpl) Update(ctx context.Context, trainingDataset *entities.TrainingDataset) error {
+	err := r.UpdateMetadata(ctx, trainingDataset)
+	if err != nil {
+		return err
+	}
+
+	// Update training data items
+	return r.updateTrainingDataItems(ctx, trainingDataset)
+}
+
+func (r *TrainingDatasetRepositoryImpl) UpdateMetadata(ctx context.Context, trainingDataset *entities.TrainingDataset) error {
 	query := `UPDATE training_datasets SET
 		generate_model = $3, generate_model_runner = $4, generate_gpu_info_card = $5,
 		generate_gpu_info_total_gb = $6, generate_gpu_info_cuda_version = $7,
 		input_field = $8, output_field = $9, json_object_fields_json = $10, expected_output_size_chars = $11,
 		total_generation_time_seconds = $12, tokens_in = $13, tokens_out = $14,
 		generate_prompt_history_ids_json = $15, generate_prompt_id = $16, corpus_id = $17,
-		language_iso = $18, status = $19, field_names_json = $20, generate_examples_number = $21, updated_at = $22
+		language_iso = $18, field_names_json = $19, generate_examples_number = $20, chunking_config_json = $21, updated_at = $22
 	WHERE id = $1 AND project_id = $2`
 
 	trainingDataset.UpdatedAt = time.Now()
@@ -286,26 +453,52 @@ func (r *TrainingDatasetRepositoryImpl) Update(ctx context.Context, trainingData
 		model.GeneratePromptID,
 		model.CorpusID,
 		model.LanguageISO,
-		model.Status,
 		model.FieldNamesJSON,
 		model.GenerateExamplesNumber,
+		model.ChunkingConfigJSON,
 		model.UpdatedAt,
 	)
 
+	return err
+}
+
+func (r *TrainingDatasetRepositoryImpl) UpdateChunkingConfig(ctx context.Context, id uuid.UUID, config entities.CorpusChunkingConfig) error {
+	configJSON, err := json.Marshal(config)
 	if err != nil {
 		return err
 	}
 
-	// Update training data items
-	return r.updateTrainingDataItems(ctx, trainingDataset)
+	query := `UPDATE training_datasets SET chunking_config_json = $2, updated_at = $3 WHERE id = $1`
+	_, err = r.Db.ExecContext(ctx, query, id, string(configJSON), time.Now())
+	return err
 }
 
 func (r *TrainingDatasetRepositoryImpl) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.TrainingDatasetStatus) error {
-	query := `UPDATE training_datasets SET status = $2, updated_at = $3 WHERE id = $1`
+	// The failure reason belongs to the previous status
+	query := `UPDATE training_datasets SET status = $2, failure_reason = NULL, updated_at = $3 WHERE id = $1`
 	_, err := r.Db.ExecContext(ctx, query, id, status, time.Now())
 	return err
 }
 
+func (r *TrainingDatasetRepositoryImpl) UpdateStatusFrom(ctx context.Context, id uuid.UUID, fromStatus entities.TrainingDatasetStatus, status entities.TrainingDatasetStatus) (bool, error) {
+	query := `UPDATE training_datasets SET status = $2, failure_reason = NULL, updated_at = $3 WHERE id = $1 AND status = $4`
+	result, err := r.Db.ExecContext(ctx, query, id, status, time.Now(), fromStatus)
+	if err != nil {
+		return false, err
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+func (r *TrainingDatasetRepositoryImpl) UpdateStatusWithReason(ctx context.Context, id uuid.UUID, status entities.TrainingDatasetStatus, reason string) error {
+	query := `UPDATE training_datasets SET status = $2, failure_reason = $3, updated_at = $4 WHERE id = $1`
+	_, err := r.Db.ExecContext(ctx, query, id, status, reason, time.Now())
+	return err
+}
+
 func (r *TrainingDatasetRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
 	// Training data items will be deleted automatically due to CASCADE
 	query := `DELETE FROM training_datasets WHERE id = $1`
@@ -315,16 +508,11 @@ func (r *TrainingDatasetRepositoryImpl) Delete(ctx context.Context, id uuid.UUID
 
 // Helper methods for managing TrainingDataItems
 
-func (r *TrainingDatasetRepositoryImpl) createTrainingDataItems(ctx context.Context, trainingDataset *entities.TrainingDataset) error {
+func (r *TrainingDatasetRepositoryImpl) createTrainingDataItems(ctx context.Context, exec sqlExecutor, trainingDataset *entities.TrainingDataset) error {
 	if len(trainingDataset.Data) == 0 {
 		return nil
 	}
 
-	query := `INSERT INTO training_data_items (
-		id, training_dataset_id, values_json, corrects_id, source_document,
-		source_document_start, source_document_end, generation_time_seconds, deleted, created_at, updated_at
-	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
-
 	for _, item := range trainingDataset.Data {
 		if item.ID == uuid.Nil {
 			item.ID = uuid.New()
@@ -332,25 +520,7 @@ func (r *TrainingDatasetRepositoryImpl) createTrainingDataItems(ctx context.Cont
 		item.CreatedAt = trainingDataset.CreatedAt
 		item.UpdatedAt = trainingDataset.UpdatedAt
 
-		model, err := FromTrainingDataItemEntity(&item, trainingDataset.ID)
-		if err != nil {
-			return err
-		}
-
-		_, err = r.Db.ExecContext(ctx, query,
-			model.ID,
-			model.TrainingDatasetID,
-			model.ValuesJSON,
-			model.CorrectsID,
-			model.SourceDocument,
-			model.SourceDocumentStart,
-			model.SourceDocumentEnd,
-			model.GenerationTimeSeconds,
-			model.Deleted,
-			model.CreatedAt,
-			model.UpdatedAt,
-		)
-		if err != nil {
+		if err := r.insertTrainingDataItem(ctx, exec, &item, trainingDataset.ID); err != nil {
 			return err
 		}
 	}
@@ -358,10 +528,43 @@ func (r *TrainingDatasetRepositoryImpl) createTrainingDataItems(ctx context.Cont
 	return nil
 }
 
+func (r *TrainingDatasetRepositoryImpl) insertTrainingDataItem(ctx context.Context, exec sqlExecutor, item *entities.TrainingDataItem, trainingDatasetID uuid.UUID) error {
+	query := `INSERT INTO training_data_items (
+		id, training_dataset_id, values_json, corrects_id, source_document,
+		source_document_start, source_document_end, generation_time_seconds, deleted, deleted_reason, split, quality_score, quality_rationale, generate_prompt_id, messages_json, created_at, updated_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`
+
+	model, err := FromTrainingDataItemEntity(item, trainingDatasetID)
+	if err != nil {
+		return err
+	}
+
+	_, err = exec.ExecContext(ctx, query,
+		model.ID,
+		model.TrainingDatasetID,
+		model.ValuesJSON,
+		model.CorrectsID,
+		model.SourceDocument,
+		model.SourceDocumentStart,
+		model.SourceDocumentEnd,
+		model.GenerationTimeSeconds,
+		model.Deleted,
+		model.DeletedReason,
+		model.Split,
+		model.QualityScore,
+		model.QualityRationale,
+		model.GeneratePromptID,
+		model.MessagesJSON,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+	return err
+}
+
 func (r *TrainingDatasetRepositoryImpl) getTrainingDataItemsByDatasetID(ctx context.Context, datasetID uuid.UUID) ([]entities.TrainingDataItem, error) {
 	query := `SELECT
 		id, training_dataset_id, values_json, corrects_id, source_document,
-		source_document_start, source_document_end, generation_time_seconds, deleted, created_at, updated_at
+		source_document_start, source_document_end, generation_time_seconds, deleted, deleted_reason, split, quality_score, quality_rationale, generate_prompt_id, messages_json, created_at, updated_at
 	FROM training_data_items WHERE training_dataset_id = $1 AND deleted = false ORDER BY created_at`
 
 	rows, err := r.Db.QueryContext(ctx, query, datasetID)
@@ -383,6 +586,12 @@ func (r *TrainingDatasetRepositoryImpl) getTrainingDataItemsByDatasetID(ctx cont
 			&model.SourceDocumentEnd,
 			&model.GenerationTimeSeconds,
 			&model.Deleted,
+			&model.DeletedReason,
+			&model.Split,
+			&model.QualityScore,
+			&model.QualityRationale,
+			&model.GeneratePromptID,
+			&model.MessagesJSON,
 			&model.CreatedAt,
 			&model.UpdatedAt,
 		)
@@ -410,5 +619,236 @@ func (r *TrainingDatasetRepositoryImpl) updateTrainingDataItems(ctx context.Cont
 	}
 
 	// Create new items
-	return r.createTrainingDataItems(ctx, trainingDataset)
-}
\ No newline at end of file
+	return r.createTrainingDataItems(ctx, r.Db, trainingDataset)
+}
+
+// Item-level methods, used to change single items without rewriting the whole dataset
+
+func (r *TrainingDatasetRepositoryImpl) ListItems(ctx context.Context, trainingDatasetID uuid.UUID, filter entities.TrainingDataItemFilter, after *entities.TrainingDataItemCursor, limit int) ([]entities.TrainingDataItem, error) {
+	where, args := trainingDataItemFilterWhere(trainingDatasetID, filter)
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		where += fmt.Sprintf(` AND (i.created_at, i.id) > ($%d, $%d)`, len(args)-1, len(args))
+	}
+	args = append(args, limit)
+
+	query := `SELECT
+		i.id, i.training_dataset_id, i.values_json, i.corrects_id, i.source_document,
+		i.source_document_start, i.source_document_end, i.generation_time_seconds, i.deleted, i.deleted_reason, i.split, i.quality_score, i.quality_rationale, i.generate_prompt_id, i.messages_json, i.created_at, i.updated_at
+	FROM training_data_items i ` + where + fmt.Sprintf(` ORDER BY i.created_at, i.id LIMIT $%d`, len(args))
+
+	return r.queryTrainingDataItems(ctx, query, args...)
+}
+
+func (r *TrainingDatasetRepositoryImpl) CountItems(ctx context.Context, trainingDatasetID uuid.UUID, filter entities.TrainingDataItemFilter) (int, error) {
+	where, args := trainingDataItemFilterWhere(trainingDatasetID, filter)
+
+	var total int
+	err := r.Db.QueryRowContext(ctx, `SELECT COUNT(*) FROM training_data_items i `+where, args...).Scan(&total)
+	return total, err
+}
+
+// trainingDataItemFilterWhere builds the WHERE clause for the items of a dataset, the first argument is the dataset ID
+func trainingDataItemFilterWhere(trainingDatasetID uuid.UUID, filter entities.TrainingDataItemFilter) (string, []interface{}) {
+	args := []interface{}{trainingDatasetID}
+
+	// Items that were corrected by a newer, non-deleted item are replaced by their correction
+	where := `WHERE i.training_dataset_id = $1
+		AND NOT EXISTS (
+			SELECT 1 FROM training_data_items c WHERE c.corrects_id = i.id AND c.deleted = false
+		)`
+
+	if filter.Deleted != nil {
+		args = append(args, *filter.Deleted)
+		where += fmt.Sprintf(` AND i.deleted = $%d`, len(args))
+	}
+	if filter.Corrected != nil {
+		if *filter.Corrected {
+			where += ` AND i.corrects_id IS NOT NULL`
+		} else {
+			where += ` AND i.corrects_id IS NULL`
+		}
+	}
+	if filter.SourceDocument != nil {
+		args = append(args, *filter.SourceDocument)
+		where += fmt.Sprintf(` AND i.source_document = $%d`, len(args))
+	}
+	if filter.GeneratePromptID != nil {
+		args = append(args, *filter.GeneratePromptID)
+		where += fmt.Sprintf(` AND i.generate_prompt_id = $%d`, len(args))
+	}
+	if query := strings.TrimSpace(filter.Query); query != "" {
+		// Full-text search finds words in any form of the value, the substring match also finds parts of words.
+		// Both are backed by GIN indexes on values_json, the messages of conversations are searched by substring.
+		args = append(args, query, "%"+escapeLikePattern(query)+"%")
+		where += fmt.Sprintf(` AND (
+			to_tsvector('simple', i.values_json) @@ plainto_tsquery('simple', $%d)
+			OR i.values_json ILIKE $%d
+			OR i.messages_json ILIKE $%d
+		)`, len(args)-1, len(args), len(args))
+	}
+
+	return where, args
+}
+
+// escapeLikePattern escapes the wildcard characters of a LIKE pattern
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+func (r *TrainingDatasetRepositoryImpl) GetItemByID(ctx context.Context, trainingDatasetID uuid.UUID, itemID uuid.UUID) (*entities.TrainingDataItem, error) {
+	query := `SELECT
+		id, training_dataset_id, values_json, corrects_id, source_document,
+		source_document_start, source_document_end, generation_time_seconds, deleted, deleted_reason, split, quality_score, quality_rationale, generate_prompt_id, messages_json, created_at, updated_at
+	FROM training_data_items WHERE id = $1 AND training_dataset_id = $2`
+
+	items, err := r.queryTrainingDataItems(ctx, query, itemID, trainingDatasetID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	return &items[0], nil
+}
+
+func (r *TrainingDatasetRepositoryImpl) GetItemCorrections(ctx context.Context, trainingDatasetID uuid.UUID, itemID uuid.UUID) ([]entities.TrainingDataItem, error) {
+	query := `SELECT
+		id, training_dataset_id, values_json, corrects_id, source_document,
+		source_document_start, source_document_end, generation_time_seconds, deleted, deleted_reason, split, quality_score, quality_rationale, generate_prompt_id, messages_json, created_at, updated_at
+	FROM training_data_items WHERE corrects_id = $1 AND training_dataset_id = $2 ORDER BY created_at`
+
+	return r.queryTrainingDataItems(ctx, query, itemID, trainingDatasetID)
+}
+
+func (r *TrainingDatasetRepositoryImpl) GetAllItems(ctx context.Context, trainingDatasetID uuid.UUID) ([]entities.TrainingDataItem, error) {
+	query := `SELECT
+		id, training_dataset_id, values_json, corrects_id, source_document,
+		source_document_start, source_document_end, generation_time_seconds, deleted, deleted_reason, split, quality_score, quality_rationale, generate_prompt_id, messages_json, created_at, updated_at
+	FROM training_data_items WHERE training_dataset_id = $1 ORDER BY created_at, id`
+
+	return r.queryTrainingDataItems(ctx, query, trainingDatasetID)
+}
+
+func (r *TrainingDatasetRepositoryImpl) CreateItems(ctx context.Context, trainingDatasetID uuid.UUID, items []entities.TrainingDataItem) error {
+	now := time.Now()
+	for _, item := range items {
+		if item.ID == uuid.Nil {
+			item.ID = uuid.New()
+		}
+		item.CreatedAt = now
+		item.UpdatedAt = now
+
+		if err := r.insertTrainingDataItem(ctx, r.Db, &item, trainingDatasetID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TrainingDatasetRepositoryImpl) UpdateItemDeleted(ctx context.Context, trainingDatasetID uuid.UUID, itemID uuid.UUID, deleted bool) error {
+	// A manual delete or restore replaces any previously recorded reason
+	query := `UPDATE training_data_items SET deleted = $3, deleted_reason = NULL, updated_at = $4 WHERE id = $1 AND training_dataset_id = $2`
+	_, err := r.Db.ExecContext(ctx, query, itemID, trainingDatasetID, deleted, time.Now())
+	return err
+}
+
+func (r *TrainingDatasetRepositoryImpl) MarkItemsDeleted(ctx context.Context, trainingDatasetID uuid.UUID, reasons map[uuid.UUID]string) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE training_data_items SET deleted = true, deleted_reason = $3, updated_at = $4 WHERE id = $1 AND training_dataset_id = $2`
+	now := time.Now()
+	for itemID, reason := range reasons {
+		if _, err := tx.ExecContext(ctx, query, itemID, trainingDatasetID, reason, now); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *TrainingDatasetRepositoryImpl) UpdateItemSplits(ctx context.Context, trainingDatasetID uuid.UUID, splits map[uuid.UUID]entities.TrainingDataItemSplit) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE training_data_items SET split = $3, updated_at = $4 WHERE id = $1 AND training_dataset_id = $2`
+	now := time.Now()
+	for itemID, split := range splits {
+		if _, err := tx.ExecContext(ctx, query, itemID, trainingDatasetID, string(split), now); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *TrainingDatasetRepositoryImpl) UpdateItemQualityScores(ctx context.Context, trainingDatasetID uuid.UUID, scores map[uuid.UUID]entities.TrainingDataItemQualityScore) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE training_data_items SET quality_score = $3, quality_rationale = $4, updated_at = $5 WHERE id = $1 AND training_dataset_id = $2`
+	now := time.Now()
+	for itemID, score := range scores {
+		if _, err := tx.ExecContext(ctx, query, itemID, trainingDatasetID, score.Score, score.Rationale, now); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *TrainingDatasetRepositoryImpl) queryTrainingDataItems(ctx context.Context, query string, args ...interface{}) ([]entities.TrainingDataItem, error) {
+	rows, err := r.Db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []entities.TrainingDataItem
+	for rows.Next() {
+		var model TrainingDataItemRepositoryModel
+		err := rows.Scan(
+			&model.ID,
+			&model.TrainingDatasetID,
+			&model.ValuesJSON,
+			&model.CorrectsID,
+			&model.SourceDocument,
+			&model.SourceDocumentStart,
+			&model.SourceDocumentEnd,
+			&model.GenerationTimeSeconds,
+			&model.Deleted,
+			&model.DeletedReason,
+			&model.Split,
+			&model.QualityScore,
+			&model.QualityRationale,
+			&model.GeneratePromptID,
+			&model.MessagesJSON,
+			&model.CreatedAt,
+			&model.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		entity, err := model.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, *entity)
+	}
+
+	return items, rows.Err()
+}
@@ -31,8 +31,13 @@ type TrainingDatasetRepositoryModel struct {
 	CorpusID                        *uuid.UUID `db:"corpus_id"`
 	LanguageISO                     string     `db:"language_iso"`
 	Status                          string    `db:"status"`
+	FailureReason                   *string   `db:"failure_reason"`
 	FieldNamesJSON                  string    `db:"field_names_json"`
 	GenerateExamplesNumber          int       `db:"generate_examples_number"`
+	ChunkingConfigJSON              *string   `db:"chunking_config_json"`
+	ExtendsTrainingDatasetID        *uuid.UUID `db:"extends_training_dataset_id"`
+	Type                            string     `db:"type"`
+	ConversationConfigJSON          *string    `db:"conversation_config_json"`
 	CreatedAt                       time.Time `db:"created_at"`
 	UpdatedAt                       time.Time `db:"updated_at"`
 }
@@ -60,10 +65,31 @@ func (m *TrainingDatasetRepositoryModel) ToEntity() (*entities.TrainingDataset,
 		jsonObjectFields = make(map[string]string)
 	}
 
+	var chunkingConfig *entities.CorpusChunkingConfig
+	if m.ChunkingConfigJSON != nil {
+		if err := json.Unmarshal([]byte(*m.ChunkingConfigJSON), &chunkingConfig); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal chunking_config: %w", err)
+		}
+	}
+
+	var conversation *entities.ConversationConfig
+	if m.ConversationConfigJSON != nil {
+		if err := json.Unmarshal([]byte(*m.ConversationConfigJSON), &conversation); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal conversation_config: %w", err)
+		}
+	}
+
+	datasetType := entities.TrainingDatasetType(m.Type)
+	if datasetType == "" {
+		datasetType = entities.TrainingDatasetTypeRecords
+	}
+
 	return &entities.TrainingDataset{
 		ID:                              m.ID,
 		ProjectID:                       m.ProjectID,
 		Version:                         m.Version,
+		Type:                            datasetType,
+		Conversation:                    conversation,
 		GenerateModel:                   m.GenerateModel,
 		GenerateModelRunner:             m.GenerateModelRunner,
 		GenerateGPUInfoCard:             m.GenerateGPUInfoCard,
@@ -81,8 +107,11 @@ func (m *TrainingDatasetRepositoryModel) ToEntity() (*entities.TrainingDataset,
 		CorpusID:                        m.CorpusID,
 		LanguageISO:                     m.LanguageISO,
 		Status:                          entities.TrainingDatasetStatus(m.Status),
+		FailureReason:                   m.FailureReason,
 		FieldNames:                      fieldNames,
 		GenerateExamplesNumber:          m.GenerateExamplesNumber,
+		ChunkingConfig:                  chunkingConfig,
+		ExtendsTrainingDatasetID:        m.ExtendsTrainingDatasetID,
 		Data:                            []entities.TrainingDataItem{}, // Will be populated separately
 		CreatedAt:                       m.CreatedAt,
 		UpdatedAt:                       m.UpdatedAt,
@@ -105,6 +134,32 @@ func FromTrainingDatasetEntity(td *entities.TrainingDataset) (*TrainingDatasetRe
 		return nil, err
 	}
 
+	var chunkingConfigJSON *string
+	if td.ChunkingConfig != nil {
+		data, err := json.Marshal(td.ChunkingConfig)
+		if err != nil {
+			return nil, err
+		}
+		value := string(data)
+		chunkingConfigJSON = &value
+	}
+
+	var conversationConfigJSON *string
+	if td.Conversation != nil {
+		data, err := json.Marshal(td.Conversation)
+		if err != nil {
+			return nil, err
+		}
+		value := string(data)
+		conversationConfigJSON = &value
+	}
+
+	// Datasets without a type are records, the type of all datasets before conversations existed
+	datasetType := td.Type
+	if datasetType == "" {
+		datasetType = entities.TrainingDatasetTypeRecords
+	}
+
 	return &TrainingDatasetRepositoryModel{
 		ID:                              td.ID,
 		ProjectID:                       td.ProjectID,
@@ -126,8 +181,13 @@ func FromTrainingDatasetEntity(td *entities.TrainingDataset) (*TrainingDatasetRe
 		CorpusID:                        td.CorpusID,
 		LanguageISO:                     td.LanguageISO,
 		Status:                          string(td.Status),
+		FailureReason:                   td.FailureReason,
 		FieldNamesJSON:                  string(fieldNamesJSON),
 		GenerateExamplesNumber:          td.GenerateExamplesNumber,
+		ChunkingConfigJSON:              chunkingConfigJSON,
+		ExtendsTrainingDatasetID:        td.ExtendsTrainingDatasetID,
+		Type:                            string(datasetType),
+		ConversationConfigJSON:          conversationConfigJSON,
 		CreatedAt:                       td.CreatedAt,
 		UpdatedAt:                       td.UpdatedAt,
 	}, nil
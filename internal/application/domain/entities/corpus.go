@@ -6,11 +6,71 @@ import (
 	"github.com/google/uuid"
 )
 
+type CorpusDocumentFormat string
+
+const (
+	CorpusDocumentFormatPDF      CorpusDocumentFormat = "pdf"
+	CorpusDocumentFormatMarkdown CorpusDocumentFormat = "markdown"
+	CorpusDocumentFormatText     CorpusDocumentFormat = "text"
+	CorpusDocumentFormatJSON     CorpusDocumentFormat = "json"
+)
+
 type Corpus struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	S3Path      string    `json:"s3_path"`
-	FilesSubset *[]string `json:"files_subset,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-}
\ No newline at end of file
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	S3Path      string     `json:"s3_path"`
+	FilesSubset *[]string  `json:"files_subset,omitempty"`
+	OwnerID     *uuid.UUID `json:"owner_id,omitempty"`
+	ProjectID   *uuid.UUID `json:"project_id,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// CorpusDocument is the metadata of a file stored in the S3 prefix of a corpus
+type CorpusDocument struct {
+	ID          uuid.UUID            `json:"id"`
+	CorpusID    uuid.UUID            `json:"corpus_id"`
+	FileName    string               `json:"file_name"`
+	Format      CorpusDocumentFormat `json:"format"`
+	SizeBytes   int64                `json:"size_bytes"`
+	LanguageISO *string              `json:"language_iso,omitempty"`
+	PageCount   *int                 `json:"page_count,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+// CorpusStorageObject is a file found in the S3 prefix of a corpus
+type CorpusStorageObject struct {
+	FileName  string
+	SizeBytes int64
+}
+
+type CorpusChunkingStrategy string
+
+const (
+	CorpusChunkingStrategyTokenWindow CorpusChunkingStrategy = "token_window"
+	CorpusChunkingStrategyHeading     CorpusChunkingStrategy = "heading"
+	CorpusChunkingStrategyParagraph   CorpusChunkingStrategy = "paragraph"
+)
+
+// CorpusChunkingConfig controls how the documents of a corpus are split into the passages used for generation
+type CorpusChunkingConfig struct {
+	Strategy        CorpusChunkingStrategy `json:"strategy"`
+	ChunkSizeTokens int                    `json:"chunk_size_tokens"`
+	OverlapTokens   int                    `json:"overlap_tokens"`
+}
+
+// CorpusChunk is a passage of a corpus document. Start and End are character offsets in the document text, they
+// match SourceDocumentStart and SourceDocumentEnd of the training data items generated from the chunk.
+type CorpusChunk struct {
+	ID         uuid.UUID `json:"id"`
+	CorpusID   uuid.UUID `json:"corpus_id"`
+	ConfigKey  string    `json:"config_key"`
+	FileName   string    `json:"file_name"`
+	Index      int       `json:"index"`
+	Start      int       `json:"start"`
+	End        int       `json:"end"`
+	TokenCount int       `json:"token_count"`
+	Text       string    `json:"text"`
+	CreatedAt  time.Time `json:"created_at"`
+}
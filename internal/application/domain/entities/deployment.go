@@ -12,6 +12,7 @@ type Deployment struct {
 	APIKey     string     `json:"api_key"`
 	ProjectID  uuid.UUID  `json:"project_id"`
 	FinetuneID *uuid.UUID `json:"finetune_id,omitempty"`
+	RedactPII  bool       `json:"redact_pii"`
 	CreatedAt  time.Time  `json:"created_at"`
 	UpdatedAt  time.Time  `json:"updated_at"`
 }
@@ -9,6 +9,8 @@ import (
 type FinetuneStatus string
 
 const (
+	// FinetuneStatusQueued waits for the scheduler, which moves it to PLANNING when a slot is free
+	FinetuneStatusQueued   FinetuneStatus = "QUEUED"
 	FinetuneStatusPlanning FinetuneStatus = "PLANNING"
 	FinetuneStatusRunning  FinetuneStatus = "RUNNING"
 	FinetuneStatusAborted  FinetuneStatus = "ABORTED"
@@ -17,6 +19,25 @@ const (
 	FinetuneStatusDeleted  FinetuneStatus = "DELETED"
 )
 
+// FinetuneBackendType is the backend that trains a finetune
+type FinetuneBackendType string
+
+const (
+	FinetuneBackendRunpod FinetuneBackendType = "RUNPOD"
+	// FinetuneBackendLocal runs a local command or a fake trainer, for development and integration tests
+	FinetuneBackendLocal FinetuneBackendType = "LOCAL"
+)
+
+// FinetunePriority orders the queued finetunes, finetunes with the same priority are dispatched in the order they
+// were created
+type FinetunePriority string
+
+const (
+	FinetunePriorityLow    FinetunePriority = "LOW"
+	FinetunePriorityNormal FinetunePriority = "NORMAL"
+	FinetunePriorityHigh   FinetunePriority = "HIGH"
+)
+
 type Finetune struct {
 	ID                               uuid.UUID         `json:"id"`
 	ProjectID                        uuid.UUID         `json:"project_id"`
@@ -27,16 +48,54 @@ type Finetune struct {
 	ModelSizeParameter               *int              `json:"model_size_parameter,omitempty"`
 	ModelDtype                       *string           `json:"model_dtype,omitempty"`
 	ModelQuantization                *string           `json:"model_quantization,omitempty"`
+	ModelFileSizeBytes               *int64            `json:"model_file_size_bytes,omitempty"`
+	ModelSHA256                      *string           `json:"model_sha256,omitempty"`
 	InferenceSamples                 []InferenceSample `json:"inference_samples"`
 	TrainingDatasetID                uuid.UUID         `json:"training_dataset_id"`
 	TrainingDatasetNumberExamples    *int              `json:"training_dataset_number_examples,omitempty"`
 	TrainingDatasetSelectRandom      bool              `json:"training_dataset_select_random"`
+	TrainingDatasetMinQualityScore   *float64          `json:"training_dataset_min_quality_score,omitempty"`
+	Hyperparameters                  *FinetuneHyperparameters `json:"hyperparameters,omitempty"`
 	TrainingTimeSeconds              *float64          `json:"training_time_seconds,omitempty"`
+	Backend                          FinetuneBackendType `json:"backend"`
+	BackendJobID                     *string           `json:"backend_job_id,omitempty"`
+	Priority                         FinetunePriority  `json:"priority"`
 	Status                           FinetuneStatus    `json:"status"`
+	FailureReason                    *string           `json:"failure_reason,omitempty"`
 	CreatedAt                        time.Time         `json:"created_at"`
 	UpdatedAt                        time.Time         `json:"updated_at"`
 }
 
+// FinetuneHyperparameters are the training settings of a finetune, they are stored so a model can be reproduced
+type FinetuneHyperparameters struct {
+	Epochs                    int      `json:"epochs"`
+	LearningRate              float64  `json:"learning_rate"`
+	BatchSize                 int      `json:"batch_size"`
+	GradientAccumulationSteps int      `json:"gradient_accumulation_steps"`
+	LoraRank                  int      `json:"lora_rank"`
+	LoraAlpha                 int      `json:"lora_alpha"`
+	LoraDropout               float64  `json:"lora_dropout"`
+	TargetModules             []string `json:"target_modules"`
+	MaxSeqLength              int      `json:"max_seq_length"`
+	WarmupSteps               int      `json:"warmup_steps"`
+	Seed                      int      `json:"seed"`
+}
+
+// FinetuneHyperparameterOverrides are the hyperparameters set by the user, nil fields keep the default
+type FinetuneHyperparameterOverrides struct {
+	Epochs                    *int
+	LearningRate              *float64
+	BatchSize                 *int
+	GradientAccumulationSteps *int
+	LoraRank                  *int
+	LoraAlpha                 *int
+	LoraDropout               *float64
+	TargetModules             []string
+	MaxSeqLength              *int
+	WarmupSteps               *int
+	Seed                      *int
+}
+
 type InferenceSample struct {
 	AtStep int                         `json:"at_step"`
 	Items  []InferenceSampleItem      `json:"items"`
@@ -47,12 +106,76 @@ type InferenceSampleItem struct {
 	Output string `json:"output"`
 }
 
+// FinetuneResult is the metadata of a trained model, reported by the trainer when the finetune is done. Fields the
+// trainer does not know are nil.
+type FinetuneResult struct {
+	ModelSizeGB         *int     `json:"model_size_gb,omitempty"`
+	ModelSizeParameter  *int     `json:"model_size_parameter,omitempty"`
+	ModelDtype          *string  `json:"model_dtype,omitempty"`
+	ModelQuantization   *string  `json:"model_quantization,omitempty"`
+	TrainingTimeSeconds *float64 `json:"training_time_seconds,omitempty"`
+	ModelSHA256         *string  `json:"model_sha256,omitempty"`
+}
+
+// FinetuneMetric is the state of a training at one step, the trainer reports it every few steps
+type FinetuneMetric struct {
+	FinetuneID   uuid.UUID `json:"finetune_id"`
+	Step         int       `json:"step"`
+	Epoch        float64   `json:"epoch"`
+	TrainLoss    *float64  `json:"train_loss,omitempty"`
+	EvalLoss     *float64  `json:"eval_loss,omitempty"`
+	LearningRate *float64  `json:"learning_rate,omitempty"`
+	GPUMemoryGB  *float64  `json:"gpu_memory_gb,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// FinetuneQueueEntry is a finetune that is queued or holds a slot of the scheduler
+type FinetuneQueueEntry struct {
+	FinetuneID uuid.UUID        `json:"finetune_id"`
+	OwnerID    uuid.UUID        `json:"owner_id"`
+	Status     FinetuneStatus   `json:"status"`
+	Priority   FinetunePriority `json:"priority"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
+
+type FinetuneDispatchEventType string
+
+const (
+	FinetuneDispatchEventQueued     FinetuneDispatchEventType = "QUEUED"
+	FinetuneDispatchEventDispatched FinetuneDispatchEventType = "DISPATCHED"
+	FinetuneDispatchEventSubmitted  FinetuneDispatchEventType = "SUBMITTED"
+	// FinetuneDispatchEventSubmitFailed is recorded for every failed attempt to start the job on the backend
+	FinetuneDispatchEventSubmitFailed FinetuneDispatchEventType = "SUBMIT_FAILED"
+	FinetuneDispatchEventCancelled    FinetuneDispatchEventType = "CANCELLED"
+)
+
+// FinetuneDispatchEvent is a step on the way of a finetune from the queue to its backend
+type FinetuneDispatchEvent struct {
+	ID         uuid.UUID                 `json:"id"`
+	FinetuneID uuid.UUID                 `json:"finetune_id"`
+	Type       FinetuneDispatchEventType `json:"type"`
+	Message    *string                   `json:"message,omitempty"`
+	CreatedAt  time.Time                 `json:"created_at"`
+}
+
+// FinetuneJobDataFormat is the layout of the training data of a finetune job. Records map the field names to the
+// values, chat items hold the messages of a conversation including the system prompt.
+type FinetuneJobDataFormat string
+
+const (
+	FinetuneJobDataFormatRecords FinetuneJobDataFormat = "records"
+	FinetuneJobDataFormatChat    FinetuneJobDataFormat = "chat"
+)
+
 type FinetuneJob struct {
 	FinetuneID        string                   `json:"finetune_id"`
 	TrainingDatasetID string                   `json:"training_dataset_id"`
+	DataFormat        FinetuneJobDataFormat    `json:"data_format"`
 	InputField        string                   `json:"input_field"`
 	OutputField       string                   `json:"output_field"`
 	UserID            string                   `json:"user_id"`
+	Hyperparameters   FinetuneHyperparameters  `json:"hyperparameters"`
 	TrainingData      []map[string]interface{} `json:"training_data"`
+	ValidationData    []map[string]interface{} `json:"validation_data,omitempty"`
 }
 
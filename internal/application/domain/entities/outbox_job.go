@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OutboxJobType string
+
+const (
+	OutboxJobTypeTrainingDataset OutboxJobType = "TRAINING_DATASET"
+	OutboxJobTypeFinetune        OutboxJobType = "FINETUNE"
+)
+
+type OutboxJobStatus string
+
+const (
+	// OutboxJobStatusQueued is a finetune job that waits for the finetune scheduler, it becomes PENDING when the
+	// finetune is dispatched
+	OutboxJobStatusQueued    OutboxJobStatus = "QUEUED"
+	OutboxJobStatusPending   OutboxJobStatus = "PENDING"
+	OutboxJobStatusDelivered OutboxJobStatus = "DELIVERED"
+	OutboxJobStatusFailed    OutboxJobStatus = "FAILED"
+	OutboxJobStatusCancelled OutboxJobStatus = "CANCELLED"
+)
+
+// OutboxJob is a job that is stored together with its training dataset or finetune and delivered to S3 or Runpod
+// afterwards, so an entity can not exist without its job
+type OutboxJob struct {
+	ID            uuid.UUID       `json:"id"`
+	Type          OutboxJobType   `json:"type"`
+	EntityID      uuid.UUID       `json:"entity_id"`
+	Payload       []byte          `json:"payload"`
+	Status        OutboxJobStatus `json:"status"`
+	Attempts      int             `json:"attempts"`
+	LastError     *string         `json:"last_error,omitempty"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	DeliveredAt   *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// FinetuneOutboxPayload is everything needed to upload the finetune job and start it on Runpod
+type FinetuneOutboxPayload struct {
+	Job             FinetuneJob `json:"job"`
+	DocumentsS3Path string      `json:"documents_s3_path"`
+	BaseModelName   string      `json:"base_model_name"`
+	ModelName       string      `json:"model_name"`
+}
@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PIIType string
+
+const (
+	PIITypeEmail      PIIType = "email"
+	PIITypePhone      PIIType = "phone"
+	PIITypeIBAN       PIIType = "iban"
+	PIITypeNationalID PIIType = "national_id"
+	PIITypePersonName PIIType = "person_name"
+)
+
+// PIIMatch is a piece of personal data found in a text, Start and End are byte offsets
+type PIIMatch struct {
+	Type  PIIType
+	Start int
+	End   int
+	Value string
+}
+
+// PIIFinding counts the matches of one type in one field of a training data item.
+// The matched values are not stored so that a report does not leak the data it found.
+type PIIFinding struct {
+	ItemID    uuid.UUID `json:"item_id"`
+	FieldName string    `json:"field_name"`
+	Type      PIIType   `json:"type"`
+	Count     int       `json:"count"`
+}
+
+// PIIReport summarizes a PII detection run over the items of a training dataset
+type PIIReport struct {
+	ID                uuid.UUID       `json:"id"`
+	TrainingDatasetID uuid.UUID       `json:"training_dataset_id"`
+	FinetuneID        *uuid.UUID      `json:"finetune_id,omitempty"`
+	Redacted          bool            `json:"redacted"`
+	ItemsScanned      int             `json:"items_scanned"`
+	ItemsWithPII      int             `json:"items_with_pii"`
+	Counts            map[PIIType]int `json:"counts"`
+	Findings          []PIIFinding    `json:"findings"`
+	CreatedAt         time.Time       `json:"created_at"`
+}
@@ -6,10 +6,61 @@ import (
 	"github.com/google/uuid"
 )
 
+// Prompt is a version of the generation prompt of a project. An edit creates the next version, the earlier
+// versions stay unchanged because training datasets and their items refer to them.
 type Prompt struct {
-	ID        uuid.UUID `json:"id"`
-	Version   int       `json:"version"`
-	Text      string    `json:"text"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-}
\ No newline at end of file
+	ID               uuid.UUID  `json:"id"`
+	ProjectID        uuid.UUID  `json:"project_id"`
+	Version          int        `json:"version"`
+	PreviousPromptID *uuid.UUID `json:"previous_prompt_id,omitempty"`
+	Text             string     `json:"text"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+type PromptDiffOperation string
+
+const (
+	PromptDiffOperationEqual  PromptDiffOperation = "equal"
+	PromptDiffOperationInsert PromptDiffOperation = "insert"
+	PromptDiffOperationDelete PromptDiffOperation = "delete"
+)
+
+// PromptDiffLine is a line of the prompt text that both versions share, or that only one of them has
+type PromptDiffLine struct {
+	Operation PromptDiffOperation `json:"operation"`
+	Text      string              `json:"text"`
+}
+
+type PromptDiff struct {
+	From  *Prompt
+	To    *Prompt
+	Lines []PromptDiffLine
+}
+
+// PromptTrainingDatasetUsage is how a training dataset version uses a prompt version. A dataset can hold items of
+// several prompt versions when it was extended with an edited prompt or copied items of an earlier version.
+type PromptTrainingDatasetUsage struct {
+	TrainingDatasetID      uuid.UUID             `json:"training_dataset_id"`
+	TrainingDatasetVersion int                   `json:"training_dataset_version"`
+	Status                 TrainingDatasetStatus `json:"status"`
+	// Generated is true when the prompt is the generation prompt of the dataset
+	Generated bool `json:"generated"`
+	// Items is the number of active items the prompt generated in the dataset
+	Items int `json:"items"`
+}
+
+// TrainingDatasetPromptItems is a training dataset version with the number of its active items per prompt version
+// that generated them
+type TrainingDatasetPromptItems struct {
+	TrainingDatasetID      uuid.UUID
+	TrainingDatasetVersion int
+	Status                 TrainingDatasetStatus
+	GeneratePromptID       uuid.UUID
+	ItemsByPromptID        map[uuid.UUID]int
+}
+
+type PromptHistoryEntry struct {
+	Prompt           *Prompt
+	TrainingDatasets []PromptTrainingDatasetUsage
+}
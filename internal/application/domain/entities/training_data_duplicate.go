@@ -0,0 +1,24 @@
+package entities
+
+import "github.com/google/uuid"
+
+type DuplicateMatchMethod string
+
+const (
+	DuplicateMatchMethodExact      DuplicateMatchMethod = "exact"
+	DuplicateMatchMethodNormalized DuplicateMatchMethod = "normalized"
+	DuplicateMatchMethodMinHash    DuplicateMatchMethod = "minhash"
+)
+
+// DuplicateCluster groups items that are duplicates of each other.
+// The KeepID item stays active and all Duplicates are marked as deleted.
+type DuplicateCluster struct {
+	KeepID     uuid.UUID        `json:"keep_id"`
+	Duplicates []DuplicateMatch `json:"duplicates"`
+}
+
+type DuplicateMatch struct {
+	ItemID     uuid.UUID            `json:"item_id"`
+	Method     DuplicateMatchMethod `json:"method"`
+	Similarity float64              `json:"similarity"`
+}
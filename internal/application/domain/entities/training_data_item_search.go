@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrainingDataItemFilter restricts the items of a training dataset. Items that were replaced by a correction are
+// never part of the result, a nil field does not filter.
+type TrainingDataItemFilter struct {
+	// Query is matched against the item values with full-text search and substring matching
+	Query string
+	// Deleted selects only deleted or only non-deleted items
+	Deleted *bool
+	// Corrected selects only items that are corrections of another item or only original items
+	Corrected      *bool
+	SourceDocument *string
+	// GeneratePromptID selects the items generated by a prompt version
+	GeneratePromptID *uuid.UUID
+}
+
+// TrainingDataItemCursor is the position after which the next page of items starts.
+// Items are ordered by creation time and ID.
+type TrainingDataItemCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrainingDataItemQualityScore is the judgement of an LLM about the quality of a training data item
+type TrainingDataItemQualityScore struct {
+	Score     float64 `json:"score"`
+	Rationale string  `json:"rationale"`
+}
+
+// QualityScoreBucket counts the items whose score rounds down to Score
+type QualityScoreBucket struct {
+	Score int `json:"score"`
+	Count int `json:"count"`
+}
+
+// QualityScoreHistogram summarizes the quality scores of the items of a training dataset
+type QualityScoreHistogram struct {
+	Buckets       []QualityScoreBucket `json:"buckets"`
+	ScoredItems   int                  `json:"scored_items"`
+	UnscoredItems int                  `json:"unscored_items"`
+	AverageScore  *float64             `json:"average_score,omitempty"`
+}
+
+type QualityScoringJobStatus string
+
+const (
+	QualityScoringJobStatusPending QualityScoringJobStatus = "PENDING"
+	QualityScoringJobStatusRunning QualityScoringJobStatus = "RUNNING"
+	QualityScoringJobStatusDone    QualityScoringJobStatus = "DONE"
+	QualityScoringJobStatusFailed  QualityScoringJobStatus = "FAILED"
+)
+
+// QualityScoringJob is a run of the LLM judge over the items of a training dataset. A training dataset has at most one
+// PENDING or RUNNING job, a worker holds a RUNNING job until LeaseUntil and renews the lease while it scores.
+type QualityScoringJob struct {
+	ID                uuid.UUID               `json:"id"`
+	TrainingDatasetID uuid.UUID               `json:"training_dataset_id"`
+	Status            QualityScoringJobStatus `json:"status"`
+	Model             string                  `json:"model"`
+	Rubric            string                  `json:"rubric"`
+	// Rescore also scores items that already have a quality score
+	Rescore     bool       `json:"rescore"`
+	ItemsTotal  int        `json:"items_total"`
+	ItemsScored int        `json:"items_scored"`
+	ItemsFailed int        `json:"items_failed"`
+	Attempts    int        `json:"attempts"`
+	LastError   *string    `json:"last_error,omitempty"`
+	LeaseUntil  *time.Time `json:"-"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func (s QualityScoringJobStatus) IsActive() bool {
+	return s == QualityScoringJobStatusPending || s == QualityScoringJobStatusRunning
+}
@@ -17,10 +17,68 @@ const (
 	TrainingDatasetStatusDeleted  TrainingDatasetStatus = "DELETED"
 )
 
+// TrainingDatasetType is the structure of the items, records have one value per field name and conversations a list
+// of messages
+type TrainingDatasetType string
+
+const (
+	TrainingDatasetTypeRecords      TrainingDatasetType = "records"
+	TrainingDatasetTypeConversation TrainingDatasetType = "conversation"
+)
+
+type ConversationMessageRole string
+
+const (
+	ConversationMessageRoleSystem    ConversationMessageRole = "system"
+	ConversationMessageRoleUser      ConversationMessageRole = "user"
+	ConversationMessageRoleAssistant ConversationMessageRole = "assistant"
+)
+
+type ConversationMessage struct {
+	Role    ConversationMessageRole `json:"role"`
+	Content string                  `json:"content"`
+}
+
+// ConversationConfig configures the generation of a conversation dataset. The system prompt is put in front of the
+// messages of every item that does not have its own system message.
+type ConversationConfig struct {
+	SystemPrompt string `json:"system_prompt"`
+	// Turns is the number of user and assistant message pairs of a generated conversation
+	Turns int `json:"turns"`
+}
+
+type TrainingDataItemSplit string
+
+const (
+	TrainingDataItemSplitTrain      TrainingDataItemSplit = "train"
+	TrainingDataItemSplitValidation TrainingDataItemSplit = "validation"
+	TrainingDataItemSplitTest       TrainingDataItemSplit = "test"
+)
+
+type TrainingDatasetExportFormat string
+
+const (
+	TrainingDatasetExportFormatCSV    TrainingDatasetExportFormat = "csv"
+	TrainingDatasetExportFormatJSONL  TrainingDatasetExportFormat = "jsonl"
+	TrainingDatasetExportFormatChat   TrainingDatasetExportFormat = "chat"
+	TrainingDatasetExportFormatAlpaca TrainingDatasetExportFormat = "alpaca"
+)
+
+type TrainingDatasetImportFormat string
+
+const (
+	TrainingDatasetImportFormatCSV   TrainingDatasetImportFormat = "csv"
+	TrainingDatasetImportFormatJSONL TrainingDatasetImportFormat = "jsonl"
+	TrainingDatasetImportFormatJSON  TrainingDatasetImportFormat = "json"
+)
+
 type TrainingDataset struct {
 	ID                              uuid.UUID             `json:"id"`
 	ProjectID                       uuid.UUID             `json:"project_id"`
 	Version                         int                   `json:"version"`
+	Type                            TrainingDatasetType   `json:"type"`
+	// Conversation is set for conversation datasets, which have no field names
+	Conversation                    *ConversationConfig   `json:"conversation,omitempty"`
 	GenerateModel                   *string               `json:"generate_model,omitempty"`
 	GenerateModelRunner             *string               `json:"generate_model_runner,omitempty"`
 	GenerateGPUInfoCard             *string               `json:"generate_gpu_info_card,omitempty"`
@@ -38,8 +96,13 @@ type TrainingDataset struct {
 	CorpusID                        *uuid.UUID            `json:"corpus_id,omitempty"`
 	LanguageISO                     string                `json:"language_iso"`
 	Status                          TrainingDatasetStatus `json:"status"`
+	FailureReason                   *string               `json:"failure_reason,omitempty"`
 	FieldNames                      []string              `json:"field_names"`
 	GenerateExamplesNumber          int                   `json:"generate_examples_number"`
+	ChunkingConfig                  *CorpusChunkingConfig `json:"chunking_config,omitempty"`
+	// ExtendsTrainingDatasetID is the version whose items were copied by "generate more", GenerateExamplesNumber
+	// then counts only the added examples
+	ExtendsTrainingDatasetID        *uuid.UUID            `json:"extends_training_dataset_id,omitempty"`
 	Data                            []TrainingDataItem    `json:"data"`
 	CreatedAt                       time.Time             `json:"created_at"`
 	UpdatedAt                       time.Time             `json:"updated_at"`
@@ -48,12 +111,20 @@ type TrainingDataset struct {
 type TrainingDataItem struct {
 	ID                       uuid.UUID `json:"id"`
 	Values                   []string  `json:"values"`
+	// Messages is the conversation of an item of a conversation dataset, its values are empty
+	Messages                 []ConversationMessage `json:"messages,omitempty"`
 	CorrectsID               *uuid.UUID `json:"corrects_id,omitempty"`
 	SourceDocument           *string   `json:"source_document,omitempty"`
 	SourceDocumentStart      *string   `json:"source_document_start,omitempty"`
 	SourceDocumentEnd        *string   `json:"source_document_end,omitempty"`
 	GenerationTimeSeconds    float64   `json:"generation_time_seconds"`
 	Deleted                  bool      `json:"deleted"`
+	DeletedReason            *string   `json:"deleted_reason,omitempty"`
+	Split                    TrainingDataItemSplit `json:"split"`
+	QualityScore             *float64  `json:"quality_score,omitempty"`
+	QualityRationale         *string   `json:"quality_rationale,omitempty"`
+	// GeneratePromptID is the prompt version that generated the item, nil for uploaded items and corrections
+	GeneratePromptID         *uuid.UUID `json:"generate_prompt_id,omitempty"`
 	CreatedAt                time.Time `json:"created_at"`
 	UpdatedAt                time.Time `json:"updated_at"`
 }
@@ -64,6 +135,8 @@ type TrainingDatasetJob struct {
 	LanguageISO             string            `json:"language_iso"`
 	UserID                  string            `json:"user_id"`
 	TrainingDatasetID       string            `json:"training_dataset_id"`
+	// Conversation is set when conversations are generated instead of records
+	Conversation            *ConversationConfig `json:"conversation,omitempty"`
 	GeneratePrompt          string            `json:"generate_prompt"`
 	GenerateExamplesNumber  int               `json:"generate_examples_number"`
 	GenerateModel           string            `json:"generate_model"`
@@ -72,5 +145,31 @@ type TrainingDatasetJob struct {
 	OutputField             string            `json:"output_field"`
 	JSONObjectFields        map[string]string `json:"json_object_fields"`
 	ExpectedOutputSizeChars int               `json:"expected_output_size_chars"`
+	// Chunking is the explicit chunking config of the corpus, nil leaves the chunking to the runner
+	Chunking *CorpusChunkingConfig `json:"chunking,omitempty"`
+	// SkipChunks are the chunks that already have results when an aborted or failed generation is resumed
+	SkipChunks []TrainingDatasetJobChunk `json:"skip_chunks,omitempty"`
+	// ResumedExamplesNumber is the number of examples generated before the resume, GenerateExamplesNumber is the rest
+	ResumedExamplesNumber int `json:"resumed_examples_number,omitempty"`
 }
 
+// TrainingDatasetJobChunk identifies a chunk by the document and the character offsets of its text
+type TrainingDatasetJobChunk struct {
+	FileName string `json:"file_name"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
+
+// TrainingDatasetProgress is the live state of a generation, reported by the runner between PLANNING and DONE
+type TrainingDatasetProgress struct {
+	TrainingDatasetID uuid.UUID `json:"training_dataset_id"`
+	ItemsGenerated    int       `json:"items_generated"`
+	ChunksProcessed   int       `json:"chunks_processed"`
+	// ChunksTotal is nil when the runner does not know the number of chunks up front
+	ChunksTotal *int      `json:"chunks_total,omitempty"`
+	TokensIn    int       `json:"tokens_in"`
+	TokensOut   int       `json:"tokens_out"`
+	EtaSeconds  *float64  `json:"eta_seconds,omitempty"`
+	StartedAt   time.Time `json:"started_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
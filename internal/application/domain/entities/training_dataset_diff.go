@@ -0,0 +1,22 @@
+package entities
+
+// TrainingDataItemChange pairs an item of the older version with its counterpart in the newer version
+type TrainingDataItemChange struct {
+	From          TrainingDataItem
+	To            TrainingDataItem
+	ChangedFields []string
+}
+
+// TrainingDatasetDiff describes what changed between two versions of a training dataset
+type TrainingDatasetDiff struct {
+	From              *TrainingDataset
+	To                *TrainingDataset
+	FieldNamesAdded   []string
+	FieldNamesRemoved []string
+	FieldOrderChanged bool
+	Added             []TrainingDataItem
+	Removed           []TrainingDataItem
+	Modified          []TrainingDataItemChange
+	Corrected         []TrainingDataItemChange
+	Unchanged         int
+}
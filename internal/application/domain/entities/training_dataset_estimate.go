@@ -0,0 +1,33 @@
+package entities
+
+// TrainingDatasetEstimateInput is the configuration of a training dataset that has not been generated yet.
+// CorpusSizeChars is 0 when the dataset is generated without a corpus.
+type TrainingDatasetEstimateInput struct {
+	GeneratePrompt          string
+	JSONObjectFields        map[string]string
+	ExpectedOutputSizeChars int
+	GenerateExamplesNumber  int
+	CorpusSizeChars         int64
+	Chunking                CorpusChunkingConfig
+}
+
+// TrainingDatasetGenerationHistory is the measured usage of a finished generation, it calibrates the estimates
+type TrainingDatasetGenerationHistory struct {
+	ExamplesNumber          int
+	ExpectedOutputSizeChars int
+	TokensIn                int
+	TokensOut               int
+	GenerationTimeSeconds   float64
+}
+
+// TrainingDatasetEstimate predicts what generating a training dataset costs. HistoricalDatasets is the number of
+// finished generations the estimate was calibrated with, 0 means only defaults were used.
+type TrainingDatasetEstimate struct {
+	GenerationRequests    int     `json:"generation_requests"`
+	TokensIn              int     `json:"tokens_in"`
+	TokensOut             int     `json:"tokens_out"`
+	GenerationTimeSeconds float64 `json:"generation_time_seconds"`
+	GPUPricePerHour       float64 `json:"gpu_price_per_hour"`
+	CostUSD               float64 `json:"cost_usd"`
+	HistoricalDatasets    int     `json:"historical_datasets"`
+}
@@ -0,0 +1,40 @@
+package entities
+
+import "github.com/google/uuid"
+
+// Distribution summarizes a list of numbers, percentiles use the nearest rank
+type Distribution struct {
+	Count int     `json:"count"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Mean  float64 `json:"mean"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P99   float64 `json:"p99"`
+}
+
+// TrainingDatasetFieldStats describes the values of one field over the active items
+type TrainingDatasetFieldStats struct {
+	FieldName   string       `json:"field_name"`
+	EmptyValues int          `json:"empty_values"`
+	Characters  Distribution `json:"characters"`
+	Tokens      Distribution `json:"tokens"`
+}
+
+// TrainingDatasetStats summarizes a training dataset version. Everything except the item counts is computed over
+// the active items, i.e. without deleted and corrected items.
+type TrainingDatasetStats struct {
+	TotalItems                 int                         `json:"total_items"`
+	ActiveItems                int                         `json:"active_items"`
+	DeletedItems               int                         `json:"deleted_items"`
+	CorrectedItems             int                         `json:"corrected_items"`
+	Fields                     []TrainingDatasetFieldStats `json:"fields"`
+	SourceDocuments            int                         `json:"source_documents"`
+	ItemsWithoutSourceDocument int                         `json:"items_without_source_document"`
+	ItemsPerSourceDocument     Distribution                `json:"items_per_source_document"`
+	LanguageISO                string                      `json:"language_iso"`
+	LanguageChecked            bool                        `json:"language_checked"`
+	LanguageUndetectedItems    int                         `json:"language_undetected_items"`
+	LanguageMismatchItemIDs    []uuid.UUID                 `json:"language_mismatch_item_ids"`
+	GenerationTimeSeconds      Distribution                `json:"generation_time_seconds"`
+}
@@ -0,0 +1,297 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+const (
+	MinChunkSizeTokens = 32
+	MaxChunkSizeTokens = 8192
+)
+
+var (
+	paragraphSeparatorPattern = regexp.MustCompile(`\n[ \t]*\n\s*`)
+	markdownHeadingPattern    = regexp.MustCompile(`(?m)^#{1,6}[ \t]`)
+)
+
+// CorpusDocumentChunks is the result of chunking one document of a corpus
+type CorpusDocumentChunks struct {
+	FileName string
+	Chunks   []entities.CorpusChunk
+}
+
+type CorpusChunkingService struct {
+	CorpusDocumentRepository persistence.CorpusDocumentRepository
+	CorpusChunkRepository    persistence.CorpusChunkRepository
+	CorpusStorageClient      clients.CorpusStorageClient
+}
+
+// textSpan is a half-open range of rune offsets in a document text
+type textSpan struct {
+	start int
+	end   int
+}
+
+func (s *CorpusChunkingService) DefaultChunkingConfig() entities.CorpusChunkingConfig {
+	return entities.CorpusChunkingConfig{
+		Strategy:        entities.CorpusChunkingStrategyParagraph,
+		ChunkSizeTokens: 512,
+		OverlapTokens:   64,
+	}
+}
+
+func (s *CorpusChunkingService) ValidateChunkingConfig(config entities.CorpusChunkingConfig) error {
+	switch config.Strategy {
+	case entities.CorpusChunkingStrategyTokenWindow, entities.CorpusChunkingStrategyHeading, entities.CorpusChunkingStrategyParagraph:
+	default:
+		return errors.New("chunking strategy must be token_window, heading or paragraph")
+	}
+	if config.ChunkSizeTokens < MinChunkSizeTokens || config.ChunkSizeTokens > MaxChunkSizeTokens {
+		return fmt.Errorf("chunk size must be between %d and %d tokens", MinChunkSizeTokens, MaxChunkSizeTokens)
+	}
+	if config.OverlapTokens < 0 || config.OverlapTokens >= config.ChunkSizeTokens {
+		return errors.New("chunk overlap must be at least 0 and smaller than the chunk size")
+	}
+	return nil
+}
+
+// ChunkingConfigKey identifies the chunks of a corpus created with the config
+func (s *CorpusChunkingService) ChunkingConfigKey(config entities.CorpusChunkingConfig) string {
+	return fmt.Sprintf("%s:%d:%d", config.Strategy, config.ChunkSizeTokens, config.OverlapTokens)
+}
+
+// ChunkID is stable, chunking the same document with the same config again results in the same IDs
+func (s *CorpusChunkingService) ChunkID(corpusID uuid.UUID, configKey string, fileName string, start int, end int) uuid.UUID {
+	return uuid.NewSHA1(corpusID, []byte(fmt.Sprintf("%s|%s|%d|%d", configKey, fileName, start, end)))
+}
+
+// DocumentText returns the text of a document that chunk offsets refer to
+func (s *CorpusChunkingService) DocumentText(format entities.CorpusDocumentFormat, content []byte) string {
+	if format == entities.CorpusDocumentFormatPDF {
+		return extractPDFText(content)
+	}
+	return string(content)
+}
+
+// ChunkText splits the text of a document into chunks. Offsets are in characters (runes) so that they match the
+// offsets reported by the runner.
+func (s *CorpusChunkingService) ChunkText(corpusID uuid.UUID, fileName string, text string, config entities.CorpusChunkingConfig) []entities.CorpusChunk {
+	runes := []rune(text)
+	configKey := s.ChunkingConfigKey(config)
+
+	var segments []textSpan
+	switch config.Strategy {
+	case entities.CorpusChunkingStrategyTokenWindow:
+		segments = wordSpans(runes, textSpan{start: 0, end: len(runes)})
+	case entities.CorpusChunkingStrategyHeading:
+		for _, section := range headingSpans(runes) {
+			segments = append(segments, splitOversizedSpan(runes, section, config.ChunkSizeTokens)...)
+		}
+	default:
+		for _, paragraph := range paragraphSpans(runes, textSpan{start: 0, end: len(runes)}) {
+			segments = append(segments, splitOversizedSpan(runes, paragraph, config.ChunkSizeTokens)...)
+		}
+	}
+
+	chunks := []entities.CorpusChunk{}
+	for _, span := range packSpans(segments, config.ChunkSizeTokens, config.OverlapTokens) {
+		chunks = append(chunks, entities.CorpusChunk{
+			ID:         s.ChunkID(corpusID, configKey, fileName, span.start, span.end),
+			CorpusID:   corpusID,
+			ConfigKey:  configKey,
+			FileName:   fileName,
+			Index:      len(chunks),
+			Start:      span.start,
+			End:        span.end,
+			TokenCount: spanTokens(span),
+			Text:       string(runes[span.start:span.end]),
+		})
+	}
+	return chunks
+}
+
+// ChunkDocument downloads a document of the corpus and splits it into chunks without storing them
+func (s *CorpusChunkingService) ChunkDocument(ctx context.Context, corpus *entities.Corpus, document *entities.CorpusDocument, config entities.CorpusChunkingConfig) ([]entities.CorpusChunk, error) {
+	content, err := s.CorpusStorageClient.DownloadDocument(ctx, corpus.S3Path, document.FileName)
+	if err != nil {
+		return nil, err
+	}
+
+	text := s.DocumentText(document.Format, content)
+	return s.ChunkText(corpus.ID, document.FileName, text, config), nil
+}
+
+// ChunkCorpus splits the documents of the corpus used for generation, which is the files subset if it is set,
+// and stores the chunks
+func (s *CorpusChunkingService) ChunkCorpus(ctx context.Context, corpus *entities.Corpus, config entities.CorpusChunkingConfig) ([]CorpusDocumentChunks, error) {
+	documents, err := s.CorpusDocumentRepository.GetByCorpusID(ctx, corpus.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get corpus documents: %w", err)
+	}
+
+	var filesSubset map[string]bool
+	if corpus.FilesSubset != nil {
+		filesSubset = make(map[string]bool, len(*corpus.FilesSubset))
+		for _, fileName := range *corpus.FilesSubset {
+			filesSubset[fileName] = true
+		}
+	}
+
+	configKey := s.ChunkingConfigKey(config)
+	results := []CorpusDocumentChunks{}
+	for _, document := range documents {
+		if filesSubset != nil && !filesSubset[document.FileName] {
+			continue
+		}
+
+		chunks, err := s.ChunkDocument(ctx, corpus, document, config)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.CorpusChunkRepository.ReplaceForDocument(ctx, corpus.ID, configKey, document.FileName, chunks); err != nil {
+			return nil, fmt.Errorf("failed to save corpus chunks: %w", err)
+		}
+
+		results = append(results, CorpusDocumentChunks{
+			FileName: document.FileName,
+			Chunks:   chunks,
+		})
+	}
+
+	return results, nil
+}
+
+func spanTokens(span textSpan) int {
+	return ApproximateTokenCount(span.end - span.start)
+}
+
+// trimSpan removes the leading and trailing whitespace of a span
+func trimSpan(runes []rune, span textSpan) textSpan {
+	for span.start < span.end && unicode.IsSpace(runes[span.start]) {
+		span.start++
+	}
+	for span.end > span.start && unicode.IsSpace(runes[span.end-1]) {
+		span.end--
+	}
+	return span
+}
+
+func wordSpans(runes []rune, span textSpan) []textSpan {
+	words := []textSpan{}
+	start := -1
+	for i := span.start; i < span.end; i++ {
+		if unicode.IsSpace(runes[i]) {
+			if start >= 0 {
+				words = append(words, textSpan{start: start, end: i})
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, textSpan{start: start, end: span.end})
+	}
+	return words
+}
+
+func paragraphSpans(runes []rune, span textSpan) []textSpan {
+	text := string(runes[span.start:span.end])
+
+	paragraphs := []textSpan{}
+	offset := 0
+	appendParagraph := func(startByte int, endByte int) {
+		start := span.start + len([]rune(text[:startByte]))
+		paragraph := trimSpan(runes, textSpan{start: start, end: start + len([]rune(text[startByte:endByte]))})
+		if paragraph.end > paragraph.start {
+			paragraphs = append(paragraphs, paragraph)
+		}
+	}
+	for _, separator := range paragraphSeparatorPattern.FindAllStringIndex(text, -1) {
+		appendParagraph(offset, separator[0])
+		offset = separator[1]
+	}
+	appendParagraph(offset, len(text))
+
+	return paragraphs
+}
+
+// headingSpans splits a document into sections that start at Markdown headings, text without headings is one section
+func headingSpans(runes []rune) []textSpan {
+	text := string(runes)
+
+	sections := []textSpan{}
+	previous := 0
+	appendSection := func(endByte int) {
+		start := len([]rune(text[:previous]))
+		section := trimSpan(runes, textSpan{start: start, end: start + len([]rune(text[previous:endByte]))})
+		if section.end > section.start {
+			sections = append(sections, section)
+		}
+	}
+	for _, heading := range markdownHeadingPattern.FindAllStringIndex(text, -1) {
+		appendSection(heading[0])
+		previous = heading[0]
+	}
+	appendSection(len(text))
+
+	return sections
+}
+
+// splitOversizedSpan splits a span that does not fit into a chunk into paragraphs and then into words
+func splitOversizedSpan(runes []rune, span textSpan, chunkSizeTokens int) []textSpan {
+	if spanTokens(span) <= chunkSizeTokens {
+		return []textSpan{span}
+	}
+
+	paragraphs := paragraphSpans(runes, span)
+	if len(paragraphs) > 1 {
+		segments := []textSpan{}
+		for _, paragraph := range paragraphs {
+			segments = append(segments, splitOversizedSpan(runes, paragraph, chunkSizeTokens)...)
+		}
+		return segments
+	}
+
+	return wordSpans(runes, span)
+}
+
+// packSpans joins consecutive segments into chunks of at most chunkSizeTokens. The next chunk starts with the
+// trailing segments of the previous chunk that fit into overlapTokens. A single segment larger than a chunk becomes
+// a chunk of its own.
+func packSpans(segments []textSpan, chunkSizeTokens int, overlapTokens int) []textSpan {
+	chunks := []textSpan{}
+	for i := 0; i < len(segments); {
+		j := i
+		for j+1 < len(segments) && spanTokens(textSpan{start: segments[i].start, end: segments[j+1].end}) <= chunkSizeTokens {
+			j++
+		}
+		chunks = append(chunks, textSpan{start: segments[i].start, end: segments[j].end})
+		if j == len(segments)-1 {
+			break
+		}
+
+		next := j + 1
+		for k := j; k > i; k-- {
+			if spanTokens(textSpan{start: segments[k].start, end: segments[j].end}) > overlapTokens {
+				break
+			}
+			next = k
+		}
+		i = next
+	}
+	return chunks
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func TestCorpusChunkingService_ValidateChunkingConfig(t *testing.T) {
+	service := &CorpusChunkingService{}
+
+	assert.NoError(t, service.ValidateChunkingConfig(service.DefaultChunkingConfig()))
+	assert.EqualError(t, service.ValidateChunkingConfig(entities.CorpusChunkingConfig{Strategy: "sentence", ChunkSizeTokens: 256}),
+		"chunking strategy must be token_window, heading or paragraph")
+	assert.Error(t, service.ValidateChunkingConfig(entities.CorpusChunkingConfig{Strategy: entities.CorpusChunkingStrategyParagraph, ChunkSizeTokens: 8}))
+	assert.EqualError(t, service.ValidateChunkingConfig(entities.CorpusChunkingConfig{Strategy: entities.CorpusChunkingStrategyParagraph, ChunkSizeTokens: 64, OverlapTokens: 64}),
+		"chunk overlap must be at least 0 and smaller than the chunk size")
+}
+
+func TestCorpusChunkingService_ChunkText_TokenWindow(t *testing.T) {
+	service := &CorpusChunkingService{}
+	corpusID := uuid.New()
+	config := entities.CorpusChunkingConfig{Strategy: entities.CorpusChunkingStrategyTokenWindow, ChunkSizeTokens: 32, OverlapTokens: 8}
+
+	// 100 words of 4 characters with spaces are about 125 tokens
+	text := strings.TrimSpace(strings.Repeat("word ", 100))
+	chunks := service.ChunkText(corpusID, "words.txt", text, config)
+
+	require.Greater(t, len(chunks), 3)
+	runes := []rune(text)
+	for i, chunk := range chunks {
+		assert.Equal(t, i, chunk.Index)
+		assert.LessOrEqual(t, chunk.TokenCount, 32)
+		assert.Equal(t, string(runes[chunk.Start:chunk.End]), chunk.Text)
+		if i > 0 {
+			// Consecutive chunks overlap, but the next chunk always moves forward
+			assert.Less(t, chunk.Start, chunks[i-1].End)
+			assert.Greater(t, chunk.Start, chunks[i-1].Start)
+		}
+	}
+	assert.Equal(t, 0, chunks[0].Start)
+	assert.Equal(t, len(runes), chunks[len(chunks)-1].End)
+
+	// Chunking again results in the same IDs
+	again := service.ChunkText(corpusID, "words.txt", text, config)
+	assert.Equal(t, chunks[1].ID, again[1].ID)
+	assert.NotEqual(t, chunks[1].ID, service.ChunkText(uuid.New(), "words.txt", text, config)[1].ID)
+}
+
+func TestCorpusChunkingService_ChunkText_Paragraph(t *testing.T) {
+	service := &CorpusChunkingService{}
+	config := entities.CorpusChunkingConfig{Strategy: entities.CorpusChunkingStrategyParagraph, ChunkSizeTokens: 32, OverlapTokens: 0}
+
+	first := strings.Repeat("a", 60)
+	second := strings.Repeat("b", 60)
+	third := strings.Repeat("ü", 100)
+	text := first + "\n\n" + second + "\n  \n" + third + "\n"
+
+	chunks := service.ChunkText(uuid.New(), "paragraphs.md", text, config)
+
+	require.Len(t, chunks, 2)
+	assert.Equal(t, first+"\n\n"+second, chunks[0].Text)
+	assert.Equal(t, third, chunks[1].Text)
+	// Offsets are in characters, not bytes
+	assert.Equal(t, 60+2+60+4, chunks[1].Start)
+	assert.Equal(t, 60+2+60+4+100, chunks[1].End)
+}
+
+func TestCorpusChunkingService_ChunkText_Heading(t *testing.T) {
+	service := &CorpusChunkingService{}
+	config := entities.CorpusChunkingConfig{Strategy: entities.CorpusChunkingStrategyHeading, ChunkSizeTokens: 32, OverlapTokens: 0}
+
+	introduction := "# Introduction\n" + strings.Repeat("x", 100)
+	usage := "## Usage\n" + strings.Repeat("y", 100)
+	text := introduction + "\n" + usage
+
+	chunks := service.ChunkText(uuid.New(), "guide.md", text, config)
+
+	require.Len(t, chunks, 2)
+	assert.Equal(t, introduction, chunks[0].Text)
+	assert.Equal(t, usage, chunks[1].Text)
+}
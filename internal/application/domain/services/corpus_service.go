@@ -0,0 +1,329 @@
+package services
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+// MaxCorpusDocumentSizeBytes is the largest document that can be uploaded into a corpus
+const MaxCorpusDocumentSizeBytes = 50 * 1024 * 1024
+
+const (
+	// maxPDFStreamInflatedBytes caps the inflation of a single stream, a small compressed stream can inflate to gigabytes
+	maxPDFStreamInflatedBytes = 1024 * 1024
+	// maxPDFTextBytes is the text extracted from a PDF, language detection only needs a few KB
+	maxPDFTextBytes = 16 * 1024
+)
+
+var (
+	pdfPagePattern       = regexp.MustCompile(`/Type\s*/Page\b`)
+	pdfStreamPattern     = regexp.MustCompile(`(?s)stream\r?\n(.*?)endstream`)
+	pdfTextStringPattern = regexp.MustCompile(`\(((?:\\.|[^\\)])*)\)`)
+)
+
+type CorpusService struct {
+	CorpusRepository persistence.CorpusRepository
+}
+
+func (s *CorpusService) CreateCorpus(name string, ownerID uuid.UUID, projectID *uuid.UUID) *entities.Corpus {
+	id := uuid.New()
+	return &entities.Corpus{
+		ID:        id,
+		Name:      name,
+		S3Path:    fmt.Sprintf("/documents/%s", id),
+		OwnerID:   &ownerID,
+		ProjectID: projectID,
+	}
+}
+
+func (s *CorpusService) ValidateCorpusName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("corpus name cannot be empty")
+	}
+	if len(name) > 255 {
+		return errors.New("corpus name cannot exceed 255 characters")
+	}
+	return nil
+}
+
+// GetCorpus returns a corpus the user can read, which are the corpora of the user and the shared corpora
+func (s *CorpusService) GetCorpus(ctx context.Context, corpusID uuid.UUID, userID uuid.UUID) (*entities.Corpus, error) {
+	corpus, err := s.CorpusRepository.GetByID(ctx, corpusID)
+	if err != nil {
+		return nil, err
+	}
+
+	if corpus == nil {
+		return nil, errors.New("corpus not found")
+	}
+
+	if corpus.OwnerID != nil && *corpus.OwnerID != userID {
+		return nil, errors.New("corpus not found")
+	}
+
+	return corpus, nil
+}
+
+// GetOwnedCorpus returns a corpus the user can modify, shared corpora are read-only
+func (s *CorpusService) GetOwnedCorpus(ctx context.Context, corpusID uuid.UUID, userID uuid.UUID) (*entities.Corpus, error) {
+	corpus, err := s.GetCorpus(ctx, corpusID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if corpus.OwnerID == nil {
+		return nil, errors.New("access denied")
+	}
+
+	return corpus, nil
+}
+
+// ValidateDocumentFileName makes sure the file name stays inside the S3 path of the corpus
+func (s *CorpusService) ValidateDocumentFileName(fileName string) error {
+	if fileName == "" {
+		return errors.New("file name cannot be empty")
+	}
+	if len(fileName) > 255 {
+		return errors.New("file name cannot exceed 255 characters")
+	}
+	if strings.ContainsAny(fileName, "/\\") || fileName == "." || fileName == ".." {
+		return errors.New("invalid file name")
+	}
+	return nil
+}
+
+// DocumentFormatFromFileName returns the format of a document from its file extension
+func (s *CorpusService) DocumentFormatFromFileName(fileName string) (entities.CorpusDocumentFormat, error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".pdf":
+		return entities.CorpusDocumentFormatPDF, nil
+	case ".md", ".markdown":
+		return entities.CorpusDocumentFormatMarkdown, nil
+	case ".txt":
+		return entities.CorpusDocumentFormatText, nil
+	case ".json", ".jsonl":
+		return entities.CorpusDocumentFormatJSON, nil
+	default:
+		return "", errors.New("unsupported document format, supported formats are PDF, Markdown, plain text and JSON")
+	}
+}
+
+// DocumentContentType returns the content type the document is stored with
+func (s *CorpusService) DocumentContentType(format entities.CorpusDocumentFormat) string {
+	switch format {
+	case entities.CorpusDocumentFormatPDF:
+		return "application/pdf"
+	case entities.CorpusDocumentFormatMarkdown:
+		return "text/markdown; charset=utf-8"
+	case entities.CorpusDocumentFormatJSON:
+		return "application/json"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
+// ExtractDocumentMetadata validates the content of an uploaded document and returns its metadata
+func (s *CorpusService) ExtractDocumentMetadata(corpusID uuid.UUID, fileName string, content []byte) (*entities.CorpusDocument, error) {
+	if err := s.ValidateDocumentFileName(fileName); err != nil {
+		return nil, err
+	}
+
+	format, err := s.DocumentFormatFromFileName(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) == 0 {
+		return nil, errors.New("document is empty")
+	}
+	if len(content) > MaxCorpusDocumentSizeBytes {
+		return nil, fmt.Errorf("document cannot exceed %d bytes", MaxCorpusDocumentSizeBytes)
+	}
+
+	document := &entities.CorpusDocument{
+		ID:        uuid.New(),
+		CorpusID:  corpusID,
+		FileName:  fileName,
+		Format:    format,
+		SizeBytes: int64(len(content)),
+	}
+
+	var text string
+	switch format {
+	case entities.CorpusDocumentFormatPDF:
+		if !bytes.HasPrefix(content, []byte("%PDF-")) {
+			return nil, errors.New("document is not a valid PDF")
+		}
+		pageCount := len(pdfPagePattern.FindAll(content, -1))
+		document.PageCount = &pageCount
+		text = extractPDFText(content)
+	case entities.CorpusDocumentFormatJSON:
+		if !utf8.Valid(content) {
+			return nil, errors.New("document is not valid UTF-8")
+		}
+		if err := validateJSONDocument(fileName, content); err != nil {
+			return nil, err
+		}
+		text = string(content)
+	default:
+		if !utf8.Valid(content) {
+			return nil, errors.New("document is not valid UTF-8")
+		}
+		text = string(content)
+	}
+
+	if languageISO := DetectLanguage(text); languageISO != "" {
+		document.LanguageISO = &languageISO
+	}
+
+	return document, nil
+}
+
+// ValidateFilesSubset makes sure every file of the subset is a document of the corpus
+func (s *CorpusService) ValidateFilesSubset(filesSubset []string, documents []*entities.CorpusDocument) error {
+	fileNames := make(map[string]bool, len(documents))
+	for _, document := range documents {
+		fileNames[document.FileName] = true
+	}
+
+	seen := make(map[string]bool, len(filesSubset))
+	for _, fileName := range filesSubset {
+		if !fileNames[fileName] {
+			return fmt.Errorf("file not found in corpus: %s", fileName)
+		}
+		if seen[fileName] {
+			return fmt.Errorf("duplicate file in files subset: %s", fileName)
+		}
+		seen[fileName] = true
+	}
+	return nil
+}
+
+// RemoveFromFilesSubset removes a deleted document from the files subset of the corpus.
+// It returns true when the subset was changed.
+func (s *CorpusService) RemoveFromFilesSubset(corpus *entities.Corpus, fileName string) bool {
+	if corpus.FilesSubset == nil {
+		return false
+	}
+
+	filesSubset := []string{}
+	for _, name := range *corpus.FilesSubset {
+		if name != fileName {
+			filesSubset = append(filesSubset, name)
+		}
+	}
+	if len(filesSubset) == len(*corpus.FilesSubset) {
+		return false
+	}
+
+	corpus.FilesSubset = &filesSubset
+	return true
+}
+
+// ReconcileDocuments compares the documents known for a corpus with the files in its S3 path.
+// Files without metadata are returned as new documents with the metadata that can be derived without
+// downloading them, documents whose file is gone are returned by file name.
+func (s *CorpusService) ReconcileDocuments(corpusID uuid.UUID, objects []entities.CorpusStorageObject, documents []*entities.CorpusDocument) ([]*entities.CorpusDocument, []string) {
+	existing := make(map[string]*entities.CorpusDocument, len(documents))
+	for _, document := range documents {
+		existing[document.FileName] = document
+	}
+
+	stored := make(map[string]bool, len(objects))
+	changed := []*entities.CorpusDocument{}
+	for _, object := range objects {
+		stored[object.FileName] = true
+
+		format, err := s.DocumentFormatFromFileName(object.FileName)
+		if err != nil {
+			// Files in a format we do not support are left alone
+			continue
+		}
+
+		document, ok := existing[object.FileName]
+		if !ok {
+			changed = append(changed, &entities.CorpusDocument{
+				ID:        uuid.New(),
+				CorpusID:  corpusID,
+				FileName:  object.FileName,
+				Format:    format,
+				SizeBytes: object.SizeBytes,
+			})
+			continue
+		}
+		if document.SizeBytes != object.SizeBytes {
+			document.SizeBytes = object.SizeBytes
+			changed = append(changed, document)
+		}
+	}
+
+	removed := []string{}
+	for _, document := range documents {
+		if !stored[document.FileName] {
+			removed = append(removed, document.FileName)
+		}
+	}
+
+	return changed, removed
+}
+
+func validateJSONDocument(fileName string, content []byte) error {
+	if strings.ToLower(filepath.Ext(fileName)) != ".jsonl" {
+		if !json.Valid(content) {
+			return errors.New("document is not valid JSON")
+		}
+		return nil
+	}
+
+	for i, line := range bytes.Split(content, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		if !json.Valid(line) {
+			return fmt.Errorf("document is not valid JSON Lines, line %d", i+1)
+		}
+	}
+	return nil
+}
+
+// extractPDFText returns the literal text strings of the content streams of a PDF, up to maxPDFTextBytes.
+// It is only good enough for language detection, the text is not in reading order.
+func extractPDFText(content []byte) string {
+	var text strings.Builder
+	for _, match := range pdfStreamPattern.FindAllSubmatch(content, -1) {
+		stream := match[1]
+		if reader, err := zlib.NewReader(bytes.NewReader(stream)); err == nil {
+			// A stream that inflates beyond the limit is cut off, its beginning still has text
+			if inflated, err := io.ReadAll(io.LimitReader(reader, maxPDFStreamInflatedBytes)); err == nil {
+				stream = inflated
+			}
+			reader.Close()
+		}
+
+		for _, str := range pdfTextStringPattern.FindAllSubmatch(stream, -1) {
+			if utf8.Valid(str[1]) {
+				text.Write(str[1])
+				text.WriteString(" ")
+			}
+			if text.Len() >= maxPDFTextBytes {
+				return text.String()
+			}
+		}
+	}
+	return text.String()
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"bytes"
+	"compress/zlib"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func TestCorpusService_DocumentFormatFromFileName(t *testing.T) {
+	service := &CorpusService{}
+
+	tests := []struct {
+		fileName string
+		expected entities.CorpusDocumentFormat
+		wantErr  bool
+	}{
+		{fileName: "report.pdf", expected: entities.CorpusDocumentFormatPDF},
+		{fileName: "README.MD", expected: entities.CorpusDocumentFormatMarkdown},
+		{fileName: "notes.txt", expected: entities.CorpusDocumentFormatText},
+		{fileName: "laws.jsonl", expected: entities.CorpusDocumentFormatJSON},
+		{fileName: "archive.zip", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fileName, func(t *testing.T) {
+			format, err := service.DocumentFormatFromFileName(tt.fileName)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, format)
+		})
+	}
+}
+
+func TestCorpusService_ExtractDocumentMetadata(t *testing.T) {
+	service := &CorpusService{}
+	corpusID := uuid.New()
+
+	t.Run("text document", func(t *testing.T) {
+		content := []byte("Die Hauptstadt von Frankreich ist Paris und sie ist sehr groß.")
+		document, err := service.ExtractDocumentMetadata(corpusID, "paris.txt", content)
+
+		require.NoError(t, err)
+		assert.Equal(t, corpusID, document.CorpusID)
+		assert.Equal(t, entities.CorpusDocumentFormatText, document.Format)
+		assert.Equal(t, int64(len(content)), document.SizeBytes)
+		require.NotNil(t, document.LanguageISO)
+		assert.Equal(t, "deu", *document.LanguageISO)
+		assert.Nil(t, document.PageCount)
+	})
+
+	t.Run("pdf document", func(t *testing.T) {
+		var stream bytes.Buffer
+		writer := zlib.NewWriter(&stream)
+		writer.Write([]byte("BT (What is the capital of France and why is it important?) Tj ET"))
+		writer.Close()
+
+		var content bytes.Buffer
+		content.WriteString("%PDF-1.4\n1 0 obj << /Type /Pages /Count 2 >> endobj\n")
+		content.WriteString("2 0 obj << /Type /Page >> endobj\n3 0 obj << /Type/Page >> endobj\n")
+		content.WriteString("4 0 obj << /Filter /FlateDecode >> stream\n")
+		content.Write(stream.Bytes())
+		content.WriteString("endstream endobj\n%%EOF")
+
+		document, err := service.ExtractDocumentMetadata(corpusID, "paris.pdf", content.Bytes())
+
+		require.NoError(t, err)
+		require.NotNil(t, document.PageCount)
+		assert.Equal(t, 2, *document.PageCount)
+		require.NotNil(t, document.LanguageISO)
+		assert.Equal(t, "eng", *document.LanguageISO)
+	})
+
+	t.Run("pdf document with a compression bomb", func(t *testing.T) {
+		var stream bytes.Buffer
+		writer := zlib.NewWriter(&stream)
+		writer.Write(bytes.Repeat([]byte("BT (Paris is the capital of France.) Tj ET "), 200000))
+		writer.Write(make([]byte, 64*1024*1024))
+		writer.Close()
+
+		var content bytes.Buffer
+		content.WriteString("%PDF-1.4\n1 0 obj << /Type /Page >> endobj\n")
+		content.WriteString("2 0 obj << /Filter /FlateDecode >> stream\n")
+		content.Write(stream.Bytes())
+		content.WriteString("endstream endobj\n%%EOF")
+
+		text := extractPDFText(content.Bytes())
+
+		assert.LessOrEqual(t, len(text), maxPDFTextBytes+len("Paris is the capital of France. "))
+		assert.True(t, strings.HasPrefix(text, "Paris is the capital of France. "))
+
+		document, err := service.ExtractDocumentMetadata(corpusID, "paris.pdf", content.Bytes())
+		require.NoError(t, err)
+		require.NotNil(t, document.LanguageISO)
+		assert.Equal(t, "eng", *document.LanguageISO)
+	})
+
+	t.Run("invalid documents", func(t *testing.T) {
+		_, err := service.ExtractDocumentMetadata(corpusID, "broken.json", []byte(`{"a":`))
+		assert.EqualError(t, err, "document is not valid JSON")
+
+		_, err = service.ExtractDocumentMetadata(corpusID, "broken.jsonl", []byte("{\"a\":1}\n{"))
+		assert.EqualError(t, err, "document is not valid JSON Lines, line 2")
+
+		_, err = service.ExtractDocumentMetadata(corpusID, "fake.pdf", []byte("hello"))
+		assert.EqualError(t, err, "document is not a valid PDF")
+
+		_, err = service.ExtractDocumentMetadata(corpusID, "../escape.txt", []byte("hello"))
+		assert.EqualError(t, err, "invalid file name")
+
+		_, err = service.ExtractDocumentMetadata(corpusID, "empty.md", nil)
+		assert.EqualError(t, err, "document is empty")
+	})
+}
+
+func TestCorpusService_ValidateFilesSubset(t *testing.T) {
+	service := &CorpusService{}
+	documents := []*entities.CorpusDocument{{FileName: "a.json"}, {FileName: "b.json"}}
+
+	assert.NoError(t, service.ValidateFilesSubset([]string{"a.json"}, documents))
+	assert.EqualError(t, service.ValidateFilesSubset([]string{"c.json"}, documents), "file not found in corpus: c.json")
+	assert.EqualError(t, service.ValidateFilesSubset([]string{"a.json", "a.json"}, documents), "duplicate file in files subset: a.json")
+}
+
+func TestCorpusService_ReconcileDocuments(t *testing.T) {
+	service := &CorpusService{}
+	corpusID := uuid.New()
+
+	documents := []*entities.CorpusDocument{
+		{CorpusID: corpusID, FileName: "kept.json", SizeBytes: 10},
+		{CorpusID: corpusID, FileName: "resized.json", SizeBytes: 10},
+		{CorpusID: corpusID, FileName: "gone.json", SizeBytes: 10},
+	}
+	objects := []entities.CorpusStorageObject{
+		{FileName: "kept.json", SizeBytes: 10},
+		{FileName: "resized.json", SizeBytes: 20},
+		{FileName: "new.md", SizeBytes: 5},
+		{FileName: "image.png", SizeBytes: 5},
+	}
+
+	changed, removed := service.ReconcileDocuments(corpusID, objects, documents)
+
+	require.Len(t, changed, 2)
+	assert.Equal(t, "resized.json", changed[0].FileName)
+	assert.Equal(t, int64(20), changed[0].SizeBytes)
+	assert.Equal(t, "new.md", changed[1].FileName)
+	assert.Equal(t, entities.CorpusDocumentFormatMarkdown, changed[1].Format)
+	assert.Equal(t, []string{"gone.json"}, removed)
+}
+
+func TestCorpusService_RemoveFromFilesSubset(t *testing.T) {
+	service := &CorpusService{}
+	filesSubset := []string{"a.json", "b.json"}
+	corpus := &entities.Corpus{FilesSubset: &filesSubset}
+
+	assert.True(t, service.RemoveFromFilesSubset(corpus, "a.json"))
+	assert.Equal(t, []string{"b.json"}, *corpus.FilesSubset)
+	assert.False(t, service.RemoveFromFilesSubset(corpus, "c.json"))
+	assert.False(t, service.RemoveFromFilesSubset(&entities.Corpus{}, "a.json"))
+}
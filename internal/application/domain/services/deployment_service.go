@@ -16,7 +16,7 @@ type DeploymentService struct {
 	FinetuneRepository   persistence.FinetuneRepository
 }
 
-func (s *DeploymentService) CreateDeployment(modelName string, projectID uuid.UUID, finetuneID *uuid.UUID) *entities.Deployment {
+func (s *DeploymentService) CreateDeployment(modelName string, projectID uuid.UUID, finetuneID *uuid.UUID, redactPII bool) *entities.Deployment {
 	apiKey := s.generateAPIKey()
 
 	return &entities.Deployment{
@@ -25,6 +25,7 @@ func (s *DeploymentService) CreateDeployment(modelName string, projectID uuid.UU
 		APIKey:     apiKey,
 		ProjectID:  projectID,
 		FinetuneID: finetuneID,
+		RedactPII:  redactPII,
 	}
 }
 
@@ -24,6 +24,11 @@ func (m *MockFinetuneRepository) Create(ctx context.Context, finetune *entities.
 	return args.Error(0)
 }
 
+func (m *MockFinetuneRepository) CreateWithOutboxJob(ctx context.Context, finetune *entities.Finetune, job *entities.OutboxJob, piiReport *entities.PIIReport, event *entities.FinetuneDispatchEvent) error {
+	args := m.Called(ctx, finetune, job, piiReport, event)
+	return args.Error(0)
+}
+
 func (m *MockFinetuneRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Finetune, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -55,6 +60,46 @@ func (m *MockFinetuneRepository) UpdateStatus(ctx context.Context, id uuid.UUID,
 	return args.Error(0)
 }
 
+func (m *MockFinetuneRepository) UpdateStatusWithReason(ctx context.Context, id uuid.UUID, status entities.FinetuneStatus, reason string) error {
+	args := m.Called(ctx, id, status, reason)
+	return args.Error(0)
+}
+
+func (m *MockFinetuneRepository) UpdateInferenceSamples(ctx context.Context, id uuid.UUID, samples []entities.InferenceSample) error {
+	args := m.Called(ctx, id, samples)
+	return args.Error(0)
+}
+
+func (m *MockFinetuneRepository) Complete(ctx context.Context, finetune *entities.Finetune, fromStatus entities.FinetuneStatus) (bool, error) {
+	args := m.Called(ctx, finetune, fromStatus)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFinetuneRepository) SetBackendJobID(ctx context.Context, id uuid.UUID, backendJobID string) (bool, error) {
+	args := m.Called(ctx, id, backendJobID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFinetuneRepository) UpdateStatusFrom(ctx context.Context, id uuid.UUID, fromStatus entities.FinetuneStatus, status entities.FinetuneStatus) (bool, error) {
+	args := m.Called(ctx, id, fromStatus, status)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFinetuneRepository) ListScheduled(ctx context.Context) ([]entities.FinetuneQueueEntry, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entities.FinetuneQueueEntry), args.Error(1)
+}
+
+func (m *MockFinetuneRepository) DispatchScheduled(ctx context.Context, selectDispatchable func(entries []entities.FinetuneQueueEntry) []*entities.FinetuneDispatchEvent) (int, error) {
+	args := m.Called(ctx, selectDispatchable)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockFinetuneRepository) CancelQueued(ctx context.Context, id uuid.UUID, event *entities.FinetuneDispatchEvent) (bool, error) {
+	args := m.Called(ctx, id, event)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockFinetuneRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
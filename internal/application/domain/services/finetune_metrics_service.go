@@ -0,0 +1,49 @@
+package services
+
+import (
+	"errors"
+	"sort"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type FinetuneMetricsService struct{}
+
+// ValidateMetric checks the values reported by the trainer
+func (s *FinetuneMetricsService) ValidateMetric(metric *entities.FinetuneMetric) error {
+	if metric.Step < 0 || metric.Epoch < 0 {
+		return errors.New("metric values must not be negative")
+	}
+	for _, value := range []*float64{metric.TrainLoss, metric.EvalLoss, metric.LearningRate, metric.GPUMemoryGB} {
+		if value != nil && *value < 0 {
+			return errors.New("metric values must not be negative")
+		}
+	}
+	return nil
+}
+
+// IsMetricsReportable tells if a finetune with the given status can still report metrics
+func (s *FinetuneMetricsService) IsMetricsReportable(status entities.FinetuneStatus) bool {
+	return status == entities.FinetuneStatusPlanning || status == entities.FinetuneStatusRunning
+}
+
+// MergeInferenceSamples adds the samples of a step to the samples of a finetune. A step that is reported again
+// replaces its samples, so a retried report does not duplicate them. The result is ordered by step.
+func (s *FinetuneMetricsService) MergeInferenceSamples(
+	samples []entities.InferenceSample,
+	atStep int,
+	items []entities.InferenceSampleItem,
+) []entities.InferenceSample {
+	merged := make([]entities.InferenceSample, 0, len(samples)+1)
+	for _, sample := range samples {
+		if sample.AtStep != atStep {
+			merged = append(merged, sample)
+		}
+	}
+	merged = append(merged, entities.InferenceSample{AtStep: atStep, Items: items})
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].AtStep < merged[j].AtStep
+	})
+	return merged
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func TestFinetuneMetricsService_ValidateMetric(t *testing.T) {
+	service := &FinetuneMetricsService{}
+	loss := 1.25
+	negative := -0.1
+
+	assert.NoError(t, service.ValidateMetric(&entities.FinetuneMetric{Step: 10, Epoch: 0.5, TrainLoss: &loss}))
+	assert.EqualError(t, service.ValidateMetric(&entities.FinetuneMetric{Step: -1}), "metric values must not be negative")
+	assert.EqualError(t, service.ValidateMetric(&entities.FinetuneMetric{Step: 10, EvalLoss: &negative}), "metric values must not be negative")
+}
+
+func TestFinetuneMetricsService_IsMetricsReportable(t *testing.T) {
+	service := &FinetuneMetricsService{}
+
+	assert.True(t, service.IsMetricsReportable(entities.FinetuneStatusPlanning))
+	assert.True(t, service.IsMetricsReportable(entities.FinetuneStatusRunning))
+	assert.False(t, service.IsMetricsReportable(entities.FinetuneStatusDone))
+	assert.False(t, service.IsMetricsReportable(entities.FinetuneStatusAborted))
+}
+
+func TestFinetuneMetricsService_MergeInferenceSamples(t *testing.T) {
+	service := &FinetuneMetricsService{}
+
+	samples := []entities.InferenceSample{
+		{AtStep: 100, Items: []entities.InferenceSampleItem{{Input: "Q", Output: "old"}}},
+		{AtStep: 200, Items: []entities.InferenceSampleItem{{Input: "Q", Output: "later"}}},
+	}
+
+	// A new step is inserted in order
+	merged := service.MergeInferenceSamples(samples, 50, []entities.InferenceSampleItem{{Input: "Q", Output: "first"}})
+	assert.Equal(t, []int{50, 100, 200}, []int{merged[0].AtStep, merged[1].AtStep, merged[2].AtStep})
+
+	// A reported step replaces its samples
+	merged = service.MergeInferenceSamples(samples, 100, []entities.InferenceSampleItem{{Input: "Q", Output: "new"}})
+	if assert.Len(t, merged, 2) {
+		assert.Equal(t, "new", merged[0].Items[0].Output)
+	}
+	assert.Equal(t, "old", samples[0].Items[0].Output)
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+var finetunePriorityRanks = map[entities.FinetunePriority]int{
+	entities.FinetunePriorityLow:    0,
+	entities.FinetunePriorityNormal: 1,
+	entities.FinetunePriorityHigh:   2,
+}
+
+type FinetuneQueueService struct{}
+
+// ResolvePriority returns the priority of a new finetune, NORMAL when none is given
+func (s *FinetuneQueueService) ResolvePriority(priority *entities.FinetunePriority) entities.FinetunePriority {
+	if priority == nil {
+		return entities.FinetunePriorityNormal
+	}
+	return *priority
+}
+
+func (s *FinetuneQueueService) ValidatePriority(priority entities.FinetunePriority) error {
+	if _, ok := finetunePriorityRanks[priority]; !ok {
+		return fmt.Errorf("priority must be one of %s, %s, %s",
+			entities.FinetunePriorityLow, entities.FinetunePriorityNormal, entities.FinetunePriorityHigh)
+	}
+	return nil
+}
+
+// IsActive tells if a finetune holds a slot of the scheduler
+func (s *FinetuneQueueService) IsActive(status entities.FinetuneStatus) bool {
+	return status == entities.FinetuneStatusPlanning || status == entities.FinetuneStatusRunning
+}
+
+// QueuedInOrder returns the queued finetunes in the order they are dispatched, by priority and then the oldest first
+func (s *FinetuneQueueService) QueuedInOrder(entries []entities.FinetuneQueueEntry) []entities.FinetuneQueueEntry {
+	queued := []entities.FinetuneQueueEntry{}
+	for _, entry := range entries {
+		if entry.Status == entities.FinetuneStatusQueued {
+			queued = append(queued, entry)
+		}
+	}
+
+	sort.SliceStable(queued, func(i, j int) bool {
+		if queued[i].Priority != queued[j].Priority {
+			return finetunePriorityRanks[queued[i].Priority] > finetunePriorityRanks[queued[j].Priority]
+		}
+		return queued[i].CreatedAt.Before(queued[j].CreatedAt)
+	})
+	return queued
+}
+
+// QueuePosition is the 1-based position of a finetune in the queue, 0 when it is not queued. Finetunes of users at
+// their limit are passed over, so a finetune can be dispatched before the ones ahead of it.
+func (s *FinetuneQueueService) QueuePosition(entries []entities.FinetuneQueueEntry, finetuneID uuid.UUID) int {
+	for i, entry := range s.QueuedInOrder(entries) {
+		if entry.FinetuneID == finetuneID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// SelectDispatchable returns the queued finetunes that can be dispatched without exceeding the global limit and the
+// limit per user on active finetunes. A limit of 0 disables it.
+func (s *FinetuneQueueService) SelectDispatchable(entries []entities.FinetuneQueueEntry, maxConcurrent int, maxConcurrentPerUser int) []entities.FinetuneQueueEntry {
+	active := 0
+	activeByOwner := make(map[uuid.UUID]int)
+	for _, entry := range entries {
+		if s.IsActive(entry.Status) {
+			active++
+			activeByOwner[entry.OwnerID]++
+		}
+	}
+
+	dispatchable := []entities.FinetuneQueueEntry{}
+	for _, entry := range s.QueuedInOrder(entries) {
+		if maxConcurrent > 0 && active >= maxConcurrent {
+			break
+		}
+		// A user at the limit does not block the finetunes of other users
+		if maxConcurrentPerUser > 0 && activeByOwner[entry.OwnerID] >= maxConcurrentPerUser {
+			continue
+		}
+		dispatchable = append(dispatchable, entry)
+		active++
+		activeByOwner[entry.OwnerID]++
+	}
+	return dispatchable
+}
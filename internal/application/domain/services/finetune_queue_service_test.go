@@ -0,0 +1,80 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func queueEntry(ownerID uuid.UUID, status entities.FinetuneStatus, priority entities.FinetunePriority, createdAt time.Time) entities.FinetuneQueueEntry {
+	return entities.FinetuneQueueEntry{
+		FinetuneID: uuid.New(),
+		OwnerID:    ownerID,
+		Status:     status,
+		Priority:   priority,
+		CreatedAt:  createdAt,
+	}
+}
+
+func TestFinetuneQueueService_Priority(t *testing.T) {
+	service := &FinetuneQueueService{}
+	high := entities.FinetunePriorityHigh
+
+	assert.Equal(t, entities.FinetunePriorityNormal, service.ResolvePriority(nil))
+	assert.Equal(t, entities.FinetunePriorityHigh, service.ResolvePriority(&high))
+	assert.NoError(t, service.ValidatePriority(entities.FinetunePriorityLow))
+	assert.EqualError(t, service.ValidatePriority("URGENT"), "priority must be one of LOW, NORMAL, HIGH")
+}
+
+func TestFinetuneQueueService_QueuedInOrder(t *testing.T) {
+	service := &FinetuneQueueService{}
+	owner := uuid.New()
+	now := time.Now()
+
+	oldNormal := queueEntry(owner, entities.FinetuneStatusQueued, entities.FinetunePriorityNormal, now.Add(-time.Hour))
+	newNormal := queueEntry(owner, entities.FinetuneStatusQueued, entities.FinetunePriorityNormal, now)
+	high := queueEntry(owner, entities.FinetuneStatusQueued, entities.FinetunePriorityHigh, now)
+	low := queueEntry(owner, entities.FinetuneStatusQueued, entities.FinetunePriorityLow, now.Add(-2*time.Hour))
+	running := queueEntry(owner, entities.FinetuneStatusRunning, entities.FinetunePriorityHigh, now)
+
+	entries := []entities.FinetuneQueueEntry{newNormal, low, running, high, oldNormal}
+	queued := service.QueuedInOrder(entries)
+
+	assert.Equal(t, []uuid.UUID{high.FinetuneID, oldNormal.FinetuneID, newNormal.FinetuneID, low.FinetuneID},
+		[]uuid.UUID{queued[0].FinetuneID, queued[1].FinetuneID, queued[2].FinetuneID, queued[3].FinetuneID})
+	assert.Equal(t, 2, service.QueuePosition(entries, oldNormal.FinetuneID))
+	assert.Equal(t, 0, service.QueuePosition(entries, running.FinetuneID))
+}
+
+func TestFinetuneQueueService_SelectDispatchable(t *testing.T) {
+	service := &FinetuneQueueService{}
+	busyUser := uuid.New()
+	otherUser := uuid.New()
+	now := time.Now()
+
+	busyRunning := queueEntry(busyUser, entities.FinetuneStatusRunning, entities.FinetunePriorityNormal, now)
+	busyQueued := queueEntry(busyUser, entities.FinetuneStatusQueued, entities.FinetunePriorityHigh, now.Add(-time.Hour))
+	otherFirst := queueEntry(otherUser, entities.FinetuneStatusQueued, entities.FinetunePriorityNormal, now.Add(-time.Minute))
+	otherSecond := queueEntry(otherUser, entities.FinetuneStatusQueued, entities.FinetunePriorityNormal, now)
+	entries := []entities.FinetuneQueueEntry{busyRunning, busyQueued, otherFirst, otherSecond}
+
+	// The user at the limit is passed over, the other user gets one slot
+	dispatchable := service.SelectDispatchable(entries, 3, 1)
+	if assert.Len(t, dispatchable, 1) {
+		assert.Equal(t, otherFirst.FinetuneID, dispatchable[0].FinetuneID)
+	}
+
+	// The global limit counts the running finetunes
+	dispatchable = service.SelectDispatchable(entries, 2, 0)
+	if assert.Len(t, dispatchable, 1) {
+		assert.Equal(t, busyQueued.FinetuneID, dispatchable[0].FinetuneID)
+	}
+
+	// Without limits everything is dispatched in queue order
+	dispatchable = service.SelectDispatchable(entries, 0, 0)
+	assert.Len(t, dispatchable, 3)
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"strings"
 
@@ -11,6 +12,26 @@ import (
 	"ai-platform/internal/application/domain/entities"
 )
 
+// The defaults follow the recommendations of Unsloth for LoRA finetunes
+const (
+	DefaultFinetuneEpochs                    = 3
+	DefaultFinetuneLearningRate              = 2e-4
+	DefaultFinetuneBatchSize                 = 2
+	DefaultFinetuneGradientAccumulationSteps = 4
+	DefaultFinetuneLoraRank                  = 16
+	DefaultFinetuneLoraAlpha                 = 16
+	DefaultFinetuneLoraDropout               = 0.0
+	DefaultFinetuneMaxSeqLength              = 2048
+	DefaultFinetuneWarmupSteps               = 5
+	DefaultFinetuneSeed                      = 3407
+)
+
+var DefaultFinetuneTargetModules = []string{"q_proj", "k_proj", "v_proj", "o_proj", "gate_proj", "up_proj", "down_proj"}
+
+var targetModulePattern = regexp.MustCompile(`^[A-Za-z0-9_.]+$`)
+
+var sha256Pattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
 type FinetuneService struct{}
 
 func (s *FinetuneService) ValidateBaseModelName(baseModelName string) error {
@@ -56,7 +77,110 @@ func (s *FinetuneService) convertProjectNameToModelString(projectName string) st
 	return result
 }
 
-func (s *FinetuneService) CreateFinetune(projectID, trainingDatasetID uuid.UUID, version int, modelName, baseModelName string, trainingDatasetNumberExamples *int, trainingDatasetSelectRandom bool) *entities.Finetune {
+// ResolveHyperparameters fills the hyperparameters the user did not set with the defaults
+func (s *FinetuneService) ResolveHyperparameters(overrides *entities.FinetuneHyperparameterOverrides) entities.FinetuneHyperparameters {
+	hyperparameters := entities.FinetuneHyperparameters{
+		Epochs:                    DefaultFinetuneEpochs,
+		LearningRate:              DefaultFinetuneLearningRate,
+		BatchSize:                 DefaultFinetuneBatchSize,
+		GradientAccumulationSteps: DefaultFinetuneGradientAccumulationSteps,
+		LoraRank:                  DefaultFinetuneLoraRank,
+		LoraAlpha:                 DefaultFinetuneLoraAlpha,
+		LoraDropout:               DefaultFinetuneLoraDropout,
+		TargetModules:             append([]string{}, DefaultFinetuneTargetModules...),
+		MaxSeqLength:              DefaultFinetuneMaxSeqLength,
+		WarmupSteps:               DefaultFinetuneWarmupSteps,
+		Seed:                      DefaultFinetuneSeed,
+	}
+	if overrides == nil {
+		return hyperparameters
+	}
+
+	if overrides.Epochs != nil {
+		hyperparameters.Epochs = *overrides.Epochs
+	}
+	if overrides.LearningRate != nil {
+		hyperparameters.LearningRate = *overrides.LearningRate
+	}
+	if overrides.BatchSize != nil {
+		hyperparameters.BatchSize = *overrides.BatchSize
+	}
+	if overrides.GradientAccumulationSteps != nil {
+		hyperparameters.GradientAccumulationSteps = *overrides.GradientAccumulationSteps
+	}
+	if overrides.LoraRank != nil {
+		hyperparameters.LoraRank = *overrides.LoraRank
+	}
+	if overrides.LoraAlpha != nil {
+		hyperparameters.LoraAlpha = *overrides.LoraAlpha
+	}
+	if overrides.LoraDropout != nil {
+		hyperparameters.LoraDropout = *overrides.LoraDropout
+	}
+	if overrides.TargetModules != nil {
+		hyperparameters.TargetModules = overrides.TargetModules
+	}
+	if overrides.MaxSeqLength != nil {
+		hyperparameters.MaxSeqLength = *overrides.MaxSeqLength
+	}
+	if overrides.WarmupSteps != nil {
+		hyperparameters.WarmupSteps = *overrides.WarmupSteps
+	}
+	if overrides.Seed != nil {
+		hyperparameters.Seed = *overrides.Seed
+	}
+
+	return hyperparameters
+}
+
+func (s *FinetuneService) ValidateHyperparameters(hyperparameters entities.FinetuneHyperparameters) error {
+	if hyperparameters.Epochs < 1 || hyperparameters.Epochs > 100 {
+		return errors.New("epochs must be between 1 and 100")
+	}
+	if hyperparameters.LearningRate <= 0 || hyperparameters.LearningRate > 0.01 {
+		return errors.New("learning rate must be greater than 0 and at most 0.01")
+	}
+	if hyperparameters.BatchSize < 1 || hyperparameters.BatchSize > 128 {
+		return errors.New("batch size must be between 1 and 128")
+	}
+	if hyperparameters.GradientAccumulationSteps < 1 || hyperparameters.GradientAccumulationSteps > 128 {
+		return errors.New("gradient accumulation steps must be between 1 and 128")
+	}
+	if hyperparameters.LoraRank < 1 || hyperparameters.LoraRank > 256 {
+		return errors.New("lora rank must be between 1 and 256")
+	}
+	if hyperparameters.LoraAlpha < 1 || hyperparameters.LoraAlpha > 512 {
+		return errors.New("lora alpha must be between 1 and 512")
+	}
+	if hyperparameters.LoraDropout < 0 || hyperparameters.LoraDropout >= 1 {
+		return errors.New("lora dropout must be at least 0 and less than 1")
+	}
+	if len(hyperparameters.TargetModules) == 0 {
+		return errors.New("target modules cannot be empty")
+	}
+	seen := make(map[string]bool, len(hyperparameters.TargetModules))
+	for _, module := range hyperparameters.TargetModules {
+		if !targetModulePattern.MatchString(module) {
+			return fmt.Errorf("invalid target module '%s'", module)
+		}
+		if seen[module] {
+			return fmt.Errorf("duplicate target module '%s'", module)
+		}
+		seen[module] = true
+	}
+	if hyperparameters.MaxSeqLength < 128 || hyperparameters.MaxSeqLength > 32768 {
+		return errors.New("max sequence length must be between 128 and 32768")
+	}
+	if hyperparameters.WarmupSteps < 0 {
+		return errors.New("warmup steps cannot be negative")
+	}
+	if hyperparameters.Seed < 0 {
+		return errors.New("seed cannot be negative")
+	}
+	return nil
+}
+
+func (s *FinetuneService) CreateFinetune(projectID, trainingDatasetID uuid.UUID, version int, modelName, baseModelName string, trainingDatasetNumberExamples *int, trainingDatasetSelectRandom bool, trainingDatasetMinQualityScore *float64, hyperparameters entities.FinetuneHyperparameters, backend entities.FinetuneBackendType, priority entities.FinetunePriority) *entities.Finetune {
 	return &entities.Finetune{
 		ID:                               uuid.New(),
 		ProjectID:                        projectID,
@@ -66,7 +190,87 @@ func (s *FinetuneService) CreateFinetune(projectID, trainingDatasetID uuid.UUID,
 		TrainingDatasetID:                trainingDatasetID,
 		TrainingDatasetNumberExamples:    trainingDatasetNumberExamples,
 		TrainingDatasetSelectRandom:      trainingDatasetSelectRandom,
-		Status:                           entities.FinetuneStatusPlanning,
+		TrainingDatasetMinQualityScore:   trainingDatasetMinQualityScore,
+		Hyperparameters:                  &hyperparameters,
+		Backend:                          backend,
+		Priority:                         priority,
+		Status:                           entities.FinetuneStatusQueued,
 		InferenceSamples:                 []entities.InferenceSample{},
 	}
-}
\ No newline at end of file
+}
+
+// MergeFinetuneResults combines the result sent with the status update and the result manifest of the trainer, the
+// fields of the status update take precedence. Both can be nil.
+func (s *FinetuneService) MergeFinetuneResults(reported, manifest *entities.FinetuneResult) entities.FinetuneResult {
+	var merged entities.FinetuneResult
+	for _, result := range []*entities.FinetuneResult{manifest, reported} {
+		if result == nil {
+			continue
+		}
+		if result.ModelSizeGB != nil {
+			merged.ModelSizeGB = result.ModelSizeGB
+		}
+		if result.ModelSizeParameter != nil {
+			merged.ModelSizeParameter = result.ModelSizeParameter
+		}
+		if result.ModelDtype != nil {
+			merged.ModelDtype = result.ModelDtype
+		}
+		if result.ModelQuantization != nil {
+			merged.ModelQuantization = result.ModelQuantization
+		}
+		if result.TrainingTimeSeconds != nil {
+			merged.TrainingTimeSeconds = result.TrainingTimeSeconds
+		}
+		if result.ModelSHA256 != nil {
+			checksum := strings.ToLower(strings.TrimSpace(*result.ModelSHA256))
+			merged.ModelSHA256 = &checksum
+		}
+	}
+	return merged
+}
+
+// ValidateFinetuneResult checks the result of a DONE finetune. Both the status update and the manifest can report a
+// checksum, they have to agree since the downloads are verified against it.
+func (s *FinetuneService) ValidateFinetuneResult(reported, manifest *entities.FinetuneResult, result entities.FinetuneResult) error {
+	if result.ModelSizeGB != nil && *result.ModelSizeGB < 0 {
+		return errors.New("model size cannot be negative")
+	}
+	if result.ModelSizeParameter != nil && *result.ModelSizeParameter < 0 {
+		return errors.New("model parameters cannot be negative")
+	}
+	if result.TrainingTimeSeconds != nil && *result.TrainingTimeSeconds < 0 {
+		return errors.New("training time cannot be negative")
+	}
+	if result.ModelSHA256 == nil {
+		return nil
+	}
+	if !sha256Pattern.MatchString(*result.ModelSHA256) {
+		return errors.New("model checksum must be a hex encoded SHA-256")
+	}
+	if reported != nil && reported.ModelSHA256 != nil && manifest != nil && manifest.ModelSHA256 != nil &&
+		!strings.EqualFold(strings.TrimSpace(*reported.ModelSHA256), strings.TrimSpace(*manifest.ModelSHA256)) {
+		return errors.New("model checksum does not match the checksum of the result manifest")
+	}
+	return nil
+}
+
+// ApplyFinetuneResult stores the result and the artifact of a done finetune. Without a reported model size, the size
+// of the GGUF is used, rounded up to whole GB.
+func (s *FinetuneService) ApplyFinetuneResult(finetune *entities.Finetune, result entities.FinetuneResult, fileSizeBytes int64, checksum string) {
+	finetune.ModelSizeGB = result.ModelSizeGB
+	if finetune.ModelSizeGB == nil {
+		sizeGB := int((fileSizeBytes + 1<<30 - 1) >> 30)
+		finetune.ModelSizeGB = &sizeGB
+	}
+	finetune.ModelSizeParameter = result.ModelSizeParameter
+	finetune.ModelDtype = result.ModelDtype
+	finetune.ModelQuantization = result.ModelQuantization
+	if result.TrainingTimeSeconds != nil {
+		// Rounded to 2 decimals like the other durations
+		trainingTimeSeconds := math.Round(*result.TrainingTimeSeconds*100) / 100
+		finetune.TrainingTimeSeconds = &trainingTimeSeconds
+	}
+	finetune.ModelFileSizeBytes = &fileSizeBytes
+	finetune.ModelSHA256 = &checksum
+}
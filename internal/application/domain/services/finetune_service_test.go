@@ -0,0 +1,139 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func TestFinetuneService_ResolveHyperparameters(t *testing.T) {
+	service := &FinetuneService{}
+
+	t.Run("Defaults", func(t *testing.T) {
+		hyperparameters := service.ResolveHyperparameters(nil)
+
+		assert.Equal(t, DefaultFinetuneEpochs, hyperparameters.Epochs)
+		assert.Equal(t, DefaultFinetuneLearningRate, hyperparameters.LearningRate)
+		assert.Equal(t, DefaultFinetuneTargetModules, hyperparameters.TargetModules)
+		assert.Equal(t, DefaultFinetuneSeed, hyperparameters.Seed)
+		assert.NoError(t, service.ValidateHyperparameters(hyperparameters))
+	})
+
+	t.Run("Overrides keep the other defaults", func(t *testing.T) {
+		epochs, dropout, warmupSteps := 5, 0.05, 0
+		hyperparameters := service.ResolveHyperparameters(&entities.FinetuneHyperparameterOverrides{
+			Epochs:        &epochs,
+			LoraDropout:   &dropout,
+			WarmupSteps:   &warmupSteps,
+			TargetModules: []string{"q_proj", "v_proj"},
+		})
+
+		assert.Equal(t, 5, hyperparameters.Epochs)
+		assert.Equal(t, 0.05, hyperparameters.LoraDropout)
+		assert.Equal(t, 0, hyperparameters.WarmupSteps)
+		assert.Equal(t, []string{"q_proj", "v_proj"}, hyperparameters.TargetModules)
+		assert.Equal(t, DefaultFinetuneLoraRank, hyperparameters.LoraRank)
+		assert.Equal(t, DefaultFinetuneMaxSeqLength, hyperparameters.MaxSeqLength)
+	})
+
+	t.Run("The default target modules are not shared", func(t *testing.T) {
+		hyperparameters := service.ResolveHyperparameters(nil)
+		hyperparameters.TargetModules[0] = "changed"
+
+		assert.Equal(t, "q_proj", DefaultFinetuneTargetModules[0])
+	})
+}
+
+func TestFinetuneService_ValidateHyperparameters(t *testing.T) {
+	service := &FinetuneService{}
+
+	tests := []struct {
+		name          string
+		modify        func(h *entities.FinetuneHyperparameters)
+		expectedError string
+	}{
+		{"Zero epochs", func(h *entities.FinetuneHyperparameters) { h.Epochs = 0 }, "epochs must be between 1 and 100"},
+		{"Learning rate too high", func(h *entities.FinetuneHyperparameters) { h.LearningRate = 0.1 }, "learning rate must be greater than 0 and at most 0.01"},
+		{"Batch size too high", func(h *entities.FinetuneHyperparameters) { h.BatchSize = 512 }, "batch size must be between 1 and 128"},
+		{"Zero gradient accumulation", func(h *entities.FinetuneHyperparameters) { h.GradientAccumulationSteps = 0 }, "gradient accumulation steps must be between 1 and 128"},
+		{"LoRA rank too high", func(h *entities.FinetuneHyperparameters) { h.LoraRank = 1024 }, "lora rank must be between 1 and 256"},
+		{"Zero LoRA alpha", func(h *entities.FinetuneHyperparameters) { h.LoraAlpha = 0 }, "lora alpha must be between 1 and 512"},
+		{"LoRA dropout of 1", func(h *entities.FinetuneHyperparameters) { h.LoraDropout = 1 }, "lora dropout must be at least 0 and less than 1"},
+		{"No target modules", func(h *entities.FinetuneHyperparameters) { h.TargetModules = []string{} }, "target modules cannot be empty"},
+		{"Invalid target module", func(h *entities.FinetuneHyperparameters) { h.TargetModules = []string{"q proj"} }, "invalid target module 'q proj'"},
+		{"Duplicate target module", func(h *entities.FinetuneHyperparameters) { h.TargetModules = []string{"q_proj", "q_proj"} }, "duplicate target module 'q_proj'"},
+		{"Short max sequence length", func(h *entities.FinetuneHyperparameters) { h.MaxSeqLength = 64 }, "max sequence length must be between 128 and 32768"},
+		{"Negative warmup steps", func(h *entities.FinetuneHyperparameters) { h.WarmupSteps = -1 }, "warmup steps cannot be negative"},
+		{"Negative seed", func(h *entities.FinetuneHyperparameters) { h.Seed = -1 }, "seed cannot be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hyperparameters := service.ResolveHyperparameters(nil)
+			tt.modify(&hyperparameters)
+
+			assert.EqualError(t, service.ValidateHyperparameters(hyperparameters), tt.expectedError)
+		})
+	}
+}
+
+func TestFinetuneService_MergeFinetuneResults(t *testing.T) {
+	service := &FinetuneService{}
+	manifestSize, reportedSize, parameters := 8, 9, 4000000000
+	dtype := "bfloat16"
+	checksum := " ABCDEF" + strings.Repeat("0", 58) + " "
+
+	merged := service.MergeFinetuneResults(
+		&entities.FinetuneResult{ModelSizeGB: &reportedSize, ModelSHA256: &checksum},
+		&entities.FinetuneResult{ModelSizeGB: &manifestSize, ModelSizeParameter: &parameters, ModelDtype: &dtype},
+	)
+
+	// The status update takes precedence, the manifest fills the rest
+	assert.Equal(t, 9, *merged.ModelSizeGB)
+	assert.Equal(t, parameters, *merged.ModelSizeParameter)
+	assert.Equal(t, "bfloat16", *merged.ModelDtype)
+	assert.Nil(t, merged.ModelQuantization)
+	assert.Equal(t, "abcdef"+strings.Repeat("0", 58), *merged.ModelSHA256)
+	assert.NoError(t, service.ValidateFinetuneResult(nil, nil, merged))
+
+	assert.Equal(t, entities.FinetuneResult{}, service.MergeFinetuneResults(nil, nil))
+}
+
+func TestFinetuneService_ValidateFinetuneResult(t *testing.T) {
+	service := &FinetuneService{}
+	negative, negativeTime, shortChecksum := -1, -0.5, "abc123"
+	checksum, otherChecksum := strings.Repeat("a", 64), strings.Repeat("b", 64)
+	upperChecksum := strings.ToUpper(checksum)
+
+	assert.EqualError(t, service.ValidateFinetuneResult(nil, nil, entities.FinetuneResult{ModelSizeGB: &negative}), "model size cannot be negative")
+	assert.EqualError(t, service.ValidateFinetuneResult(nil, nil, entities.FinetuneResult{ModelSizeParameter: &negative}), "model parameters cannot be negative")
+	assert.EqualError(t, service.ValidateFinetuneResult(nil, nil, entities.FinetuneResult{TrainingTimeSeconds: &negativeTime}), "training time cannot be negative")
+	assert.EqualError(t, service.ValidateFinetuneResult(nil, nil, entities.FinetuneResult{ModelSHA256: &shortChecksum}), "model checksum must be a hex encoded SHA-256")
+
+	// Without a checksum the API computes it
+	assert.NoError(t, service.ValidateFinetuneResult(nil, nil, entities.FinetuneResult{}))
+
+	reported := &entities.FinetuneResult{ModelSHA256: &checksum}
+	assert.NoError(t, service.ValidateFinetuneResult(reported, &entities.FinetuneResult{ModelSHA256: &upperChecksum}, entities.FinetuneResult{ModelSHA256: &checksum}))
+	assert.EqualError(t, service.ValidateFinetuneResult(reported, &entities.FinetuneResult{ModelSHA256: &otherChecksum}, entities.FinetuneResult{ModelSHA256: &checksum}),
+		"model checksum does not match the checksum of the result manifest")
+}
+
+func TestFinetuneService_ApplyFinetuneResult(t *testing.T) {
+	service := &FinetuneService{}
+	trainingTime := 1234.5678
+	quantization := "q4_k_m"
+	finetune := &entities.Finetune{}
+
+	service.ApplyFinetuneResult(finetune, entities.FinetuneResult{TrainingTimeSeconds: &trainingTime, ModelQuantization: &quantization}, 5<<30+1, "checksum")
+
+	// Without a reported size the GGUF size is rounded up to whole GB
+	assert.Equal(t, 6, *finetune.ModelSizeGB)
+	assert.Equal(t, 1234.57, *finetune.TrainingTimeSeconds)
+	assert.Equal(t, "q4_k_m", *finetune.ModelQuantization)
+	assert.Equal(t, int64(5<<30+1), *finetune.ModelFileSizeBytes)
+	assert.Equal(t, "checksum", *finetune.ModelSHA256)
+}
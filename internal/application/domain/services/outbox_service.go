@@ -0,0 +1,99 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+const (
+	// OutboxMaxAttempts is the number of deliveries before a job fails permanently, with the backoff below the last
+	// attempt is made about 20 minutes after the first one
+	OutboxMaxAttempts = 8
+	outboxBaseBackoff = 10 * time.Second
+	outboxMaxBackoff  = 10 * time.Minute
+)
+
+type OutboxService struct{}
+
+// NewTrainingDatasetOutboxJob creates the pending job that submits the training dataset job to S3
+func (s *OutboxService) NewTrainingDatasetOutboxJob(trainingDatasetID uuid.UUID, job entities.TrainingDatasetJob) (*entities.OutboxJob, error) {
+	return s.newOutboxJob(entities.OutboxJobTypeTrainingDataset, trainingDatasetID, job)
+}
+
+// NewFinetuneOutboxJob creates the job that uploads the finetune job to S3 and starts it on the backend. The job is
+// held in QUEUED until the scheduler dispatches the finetune.
+func (s *OutboxService) NewFinetuneOutboxJob(finetuneID uuid.UUID, payload entities.FinetuneOutboxPayload) (*entities.OutboxJob, error) {
+	job, err := s.newOutboxJob(entities.OutboxJobTypeFinetune, finetuneID, payload)
+	if err != nil {
+		return nil, err
+	}
+	job.Status = entities.OutboxJobStatusQueued
+	return job, nil
+}
+
+func (s *OutboxService) newOutboxJob(jobType entities.OutboxJobType, entityID uuid.UUID, payload interface{}) (*entities.OutboxJob, error) {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal outbox job payload: %w", err)
+	}
+
+	return &entities.OutboxJob{
+		ID:       uuid.New(),
+		Type:     jobType,
+		EntityID: entityID,
+		Payload:  payloadJSON,
+		Status:   entities.OutboxJobStatusPending,
+	}, nil
+}
+
+// Backoff is the delay after the given number of failed attempts, it doubles with every attempt up to a maximum
+func (s *OutboxService) Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+	backoff := outboxBaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= outboxMaxBackoff {
+			return outboxMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// RecordDelivered marks the job as delivered
+func (s *OutboxService) RecordDelivered(job *entities.OutboxJob, now time.Time) {
+	job.Attempts++
+	job.Status = entities.OutboxJobStatusDelivered
+	job.DeliveredAt = &now
+}
+
+// RecordFailedAttempt counts the failed attempt and schedules the next one. It returns true when the job failed
+// permanently and will not be attempted again.
+func (s *OutboxService) RecordFailedAttempt(job *entities.OutboxJob, deliveryErr error, now time.Time) bool {
+	job.Attempts++
+	lastError := deliveryErr.Error()
+	job.LastError = &lastError
+
+	if job.Attempts >= OutboxMaxAttempts {
+		job.Status = entities.OutboxJobStatusFailed
+		return true
+	}
+
+	job.NextAttemptAt = now.Add(s.Backoff(job.Attempts))
+	return false
+}
+
+// FailureReason is the reason shown on the training dataset or finetune when its job failed permanently
+func (s *OutboxService) FailureReason(job *entities.OutboxJob) string {
+	lastError := ""
+	if job.LastError != nil {
+		lastError = *job.LastError
+	}
+	return fmt.Sprintf("The job could not be submitted after %d attempts: %s", job.Attempts, lastError)
+}
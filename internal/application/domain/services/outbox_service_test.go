@@ -0,0 +1,91 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func TestOutboxService_NewTrainingDatasetOutboxJob(t *testing.T) {
+	service := &OutboxService{}
+	trainingDatasetID := uuid.New()
+
+	job, err := service.NewTrainingDatasetOutboxJob(trainingDatasetID, entities.TrainingDatasetJob{
+		TrainingDatasetID:      trainingDatasetID.String(),
+		GenerateExamplesNumber: 10,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, entities.OutboxJobTypeTrainingDataset, job.Type)
+	assert.Equal(t, trainingDatasetID, job.EntityID)
+	assert.Equal(t, entities.OutboxJobStatusPending, job.Status)
+	assert.NotEqual(t, uuid.Nil, job.ID)
+
+	var payload entities.TrainingDatasetJob
+	require.NoError(t, json.Unmarshal(job.Payload, &payload))
+	assert.Equal(t, 10, payload.GenerateExamplesNumber)
+}
+
+func TestOutboxService_NewFinetuneOutboxJob(t *testing.T) {
+	service := &OutboxService{}
+	finetuneID := uuid.New()
+
+	job, err := service.NewFinetuneOutboxJob(finetuneID, entities.FinetuneOutboxPayload{ModelName: "qwen3_4b_test_v1"})
+	require.NoError(t, err)
+
+	// The job waits for the scheduler
+	assert.Equal(t, entities.OutboxJobTypeFinetune, job.Type)
+	assert.Equal(t, finetuneID, job.EntityID)
+	assert.Equal(t, entities.OutboxJobStatusQueued, job.Status)
+}
+
+func TestOutboxService_Backoff(t *testing.T) {
+	service := &OutboxService{}
+
+	assert.Equal(t, time.Duration(0), service.Backoff(0))
+	assert.Equal(t, 10*time.Second, service.Backoff(1))
+	assert.Equal(t, 20*time.Second, service.Backoff(2))
+	assert.Equal(t, 80*time.Second, service.Backoff(4))
+	assert.Equal(t, 10*time.Minute, service.Backoff(7))
+	assert.Equal(t, 10*time.Minute, service.Backoff(100))
+}
+
+func TestOutboxService_RecordFailedAttempt(t *testing.T) {
+	service := &OutboxService{}
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	job := &entities.OutboxJob{Status: entities.OutboxJobStatusPending}
+
+	permanent := service.RecordFailedAttempt(job, errors.New("connection refused"), now)
+
+	assert.False(t, permanent)
+	assert.Equal(t, 1, job.Attempts)
+	assert.Equal(t, entities.OutboxJobStatusPending, job.Status)
+	assert.Equal(t, now.Add(10*time.Second), job.NextAttemptAt)
+	assert.Equal(t, "connection refused", *job.LastError)
+
+	job.Attempts = OutboxMaxAttempts - 1
+	permanent = service.RecordFailedAttempt(job, errors.New("access denied"), now)
+
+	assert.True(t, permanent)
+	assert.Equal(t, entities.OutboxJobStatusFailed, job.Status)
+	assert.Equal(t, "The job could not be submitted after 8 attempts: access denied", service.FailureReason(job))
+}
+
+func TestOutboxService_RecordDelivered(t *testing.T) {
+	service := &OutboxService{}
+	now := time.Now()
+	job := &entities.OutboxJob{Status: entities.OutboxJobStatusPending, Attempts: 2}
+
+	service.RecordDelivered(job, now)
+
+	assert.Equal(t, entities.OutboxJobStatusDelivered, job.Status)
+	assert.Equal(t, 3, job.Attempts)
+	assert.Equal(t, now, *job.DeliveredAt)
+}
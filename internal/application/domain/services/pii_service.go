@@ -0,0 +1,311 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+// PIIDetector finds one type of personal data in a text
+type PIIDetector interface {
+	Type() entities.PIIType
+	Detect(text string) []entities.PIIMatch
+}
+
+// RegexPIIDetector detects personal data with a regular expression.
+// If Group is set only that capture group is reported, Validate can reject false positives.
+type RegexPIIDetector struct {
+	PIIType  entities.PIIType
+	Pattern  *regexp.Regexp
+	Group    int
+	Validate func(value string) bool
+}
+
+func (d *RegexPIIDetector) Type() entities.PIIType {
+	return d.PIIType
+}
+
+func (d *RegexPIIDetector) Detect(text string) []entities.PIIMatch {
+	var matches []entities.PIIMatch
+	for _, indexes := range d.Pattern.FindAllStringSubmatchIndex(text, -1) {
+		start, end := indexes[2*d.Group], indexes[2*d.Group+1]
+		if start < 0 {
+			continue
+		}
+		value := text[start:end]
+		if d.Validate != nil && !d.Validate(value) {
+			continue
+		}
+		matches = append(matches, entities.PIIMatch{Type: d.PIIType, Start: start, End: end, Value: value})
+	}
+	return matches
+}
+
+// DefaultPIIDetectors returns the built-in detectors. The order is the priority when two matches overlap,
+// e.g. the digits of an IBAN are never reported as a phone number.
+func DefaultPIIDetectors() []PIIDetector {
+	return []PIIDetector{
+		&RegexPIIDetector{
+			PIIType: entities.PIITypeEmail,
+			Pattern: regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`),
+		},
+		&RegexPIIDetector{
+			PIIType:  entities.PIITypeIBAN,
+			Pattern:  regexp.MustCompile(`\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]){11,30}\b`),
+			Validate: isValidIBAN,
+		},
+		// US social security numbers
+		&RegexPIIDetector{
+			PIIType:  entities.PIITypeNationalID,
+			Pattern:  regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`),
+			Validate: isValidSSN,
+		},
+		// UK national insurance numbers
+		&RegexPIIDetector{
+			PIIType: entities.PIITypeNationalID,
+			Pattern: regexp.MustCompile(`\b[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z] ?\d{2} ?\d{2} ?\d{2} ?[A-D]\b`),
+		},
+		&RegexPIIDetector{
+			PIIType:  entities.PIITypePhone,
+			Pattern:  regexp.MustCompile(`(?:\+|\()?\d[\d ()/.\-]{6,18}\d`),
+			Validate: isPhoneNumber,
+		},
+		// Names are only detected after a salutation or title, capitalized words alone are too ambiguous
+		&RegexPIIDetector{
+			PIIType: entities.PIITypePersonName,
+			Pattern: regexp.MustCompile(`\b(?:Mr|Mrs|Ms|Miss|Dr|Prof|Herr|Frau)\.? +(\p{Lu}\p{Ll}+(?: +\p{Lu}\p{Ll}+){0,2})`),
+			Group:   1,
+		},
+	}
+}
+
+type PIIService struct {
+	Detectors []PIIDetector
+}
+
+// Detect returns the non-overlapping matches of all detectors ordered by position
+func (s *PIIService) Detect(text string) []entities.PIIMatch {
+	type candidate struct {
+		match    entities.PIIMatch
+		priority int
+	}
+
+	var candidates []candidate
+	for priority, detector := range s.Detectors {
+		for _, match := range detector.Detect(text) {
+			candidates = append(candidates, candidate{match: match, priority: priority})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].priority != candidates[j].priority {
+			return candidates[i].priority < candidates[j].priority
+		}
+		return candidates[i].match.Start < candidates[j].match.Start
+	})
+
+	// Higher priority matches claim their range first
+	var matches []entities.PIIMatch
+	for _, c := range candidates {
+		overlaps := false
+		for _, match := range matches {
+			if c.match.Start < match.End && match.Start < c.match.End {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			matches = append(matches, c.match)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Start < matches[j].Start
+	})
+	return matches
+}
+
+// Redact replaces every match with a placeholder like [EMAIL]
+func (s *PIIService) Redact(text string) (string, []entities.PIIMatch) {
+	matches := s.Detect(text)
+	if len(matches) == 0 {
+		return text, nil
+	}
+
+	var builder strings.Builder
+	last := 0
+	for _, match := range matches {
+		builder.WriteString(text[last:match.Start])
+		builder.WriteString(PIIPlaceholder(match.Type))
+		last = match.End
+	}
+	builder.WriteString(text[last:])
+
+	return builder.String(), matches
+}
+
+// RedactDeploymentLog removes personal data from the input and output of a deployment log before it is saved
+func (s *PIIService) RedactDeploymentLog(log *entities.DeploymentLogs) {
+	log.Input, _ = s.Redact(log.Input)
+	log.Output, _ = s.Redact(log.Output)
+}
+
+// PIIPlaceholder returns the text that replaces a redacted value
+func PIIPlaceholder(piiType entities.PIIType) string {
+	return "[" + strings.ToUpper(string(piiType)) + "]"
+}
+
+// ScanTrainingDataItems detects personal data in all values of the items. If redact is set the returned items
+// have their values redacted, the given items are never modified.
+func (s *PIIService) ScanTrainingDataItems(
+	trainingDatasetID uuid.UUID,
+	items []entities.TrainingDataItem,
+	fieldNames []string,
+	redact bool,
+) ([]entities.TrainingDataItem, *entities.PIIReport) {
+	report := &entities.PIIReport{
+		ID:                uuid.New(),
+		TrainingDatasetID: trainingDatasetID,
+		Redacted:          redact,
+		ItemsScanned:      len(items),
+		Counts:            make(map[entities.PIIType]int),
+		Findings:          []entities.PIIFinding{},
+		CreatedAt:         time.Now(),
+	}
+
+	result := make([]entities.TrainingDataItem, len(items))
+	for i, item := range items {
+		values := make([]string, len(item.Values))
+		itemHasPII := false
+
+		for j, value := range item.Values {
+			redacted, matches := s.Redact(value)
+			if redact {
+				values[j] = redacted
+			} else {
+				values[j] = value
+			}
+			if len(matches) == 0 {
+				continue
+			}
+			itemHasPII = true
+
+			fieldName := ""
+			if j < len(fieldNames) {
+				fieldName = fieldNames[j]
+			}
+			for _, finding := range countPIIMatches(item.ID, fieldName, matches) {
+				report.Counts[finding.Type] += finding.Count
+				report.Findings = append(report.Findings, finding)
+			}
+		}
+
+		// The messages of a conversation are reported by their position in the conversation
+		var messages []entities.ConversationMessage
+		if item.Messages != nil {
+			messages = make([]entities.ConversationMessage, len(item.Messages))
+		}
+		for j, message := range item.Messages {
+			redacted, matches := s.Redact(message.Content)
+			messages[j] = message
+			if redact {
+				messages[j].Content = redacted
+			}
+			if len(matches) == 0 {
+				continue
+			}
+			itemHasPII = true
+
+			for _, finding := range countPIIMatches(item.ID, fmt.Sprintf("messages[%d]", j), matches) {
+				report.Counts[finding.Type] += finding.Count
+				report.Findings = append(report.Findings, finding)
+			}
+		}
+
+		if itemHasPII {
+			report.ItemsWithPII++
+		}
+		item.Values = values
+		item.Messages = messages
+		result[i] = item
+	}
+
+	return result, report
+}
+
+// countPIIMatches groups the matches of one value by type in the order the types first appear
+func countPIIMatches(itemID uuid.UUID, fieldName string, matches []entities.PIIMatch) []entities.PIIFinding {
+	var findings []entities.PIIFinding
+	indexByType := make(map[entities.PIIType]int)
+	for _, match := range matches {
+		index, exists := indexByType[match.Type]
+		if !exists {
+			index = len(findings)
+			indexByType[match.Type] = index
+			findings = append(findings, entities.PIIFinding{ItemID: itemID, FieldName: fieldName, Type: match.Type})
+		}
+		findings[index].Count++
+	}
+	return findings
+}
+
+// isValidIBAN checks the length and the ISO 13616 mod 97 checksum
+func isValidIBAN(value string) bool {
+	iban := strings.ReplaceAll(value, " ", "")
+	if len(iban) < 15 || len(iban) > 34 {
+		return false
+	}
+
+	var digits strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		switch {
+		case unicode.IsDigit(r):
+			digits.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			digits.WriteString(big.NewInt(int64(r - 'A' + 10)).String())
+		default:
+			return false
+		}
+	}
+
+	number, ok := new(big.Int).SetString(digits.String(), 10)
+	if !ok {
+		return false
+	}
+	return new(big.Int).Mod(number, big.NewInt(97)).Int64() == 1
+}
+
+func isValidSSN(value string) bool {
+	area := value[:3]
+	return area != "000" && area != "666" && area[0] != '9' && value[4:6] != "00" && value[7:] != "0000"
+}
+
+var (
+	usPhonePattern = regexp.MustCompile(`^\(?\d{3}\)?[ .\-]\d{3}[ .\-]\d{4}$`)
+	datePattern    = regexp.MustCompile(`^\d{1,4}[./\-]\d{1,2}[./\-]\d{1,4}$`)
+)
+
+// isPhoneNumber keeps international and national numbers with 8 to 15 digits.
+// Numbers without a leading + or 0 are only accepted in the US format, so amounts and dates are not matched.
+func isPhoneNumber(value string) bool {
+	digits := 0
+	for _, r := range value {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+	if digits < 8 || digits > 15 || datePattern.MatchString(value) {
+		return false
+	}
+
+	trimmed := strings.TrimLeft(value, "(")
+	return strings.HasPrefix(trimmed, "+") || strings.HasPrefix(trimmed, "0") || usPhonePattern.MatchString(value)
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func TestPIIService_Redact(t *testing.T) {
+	service := &PIIService{Detectors: DefaultPIIDetectors()}
+
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "email",
+			text:     "Contact me at jane.doe@example.com please",
+			expected: "Contact me at [EMAIL] please",
+		},
+		{
+			name:     "phone number",
+			text:     "Call +49 30 1234567 tomorrow",
+			expected: "Call [PHONE] tomorrow",
+		},
+		{
+			name:     "valid IBAN",
+			text:     "Transfer to DE89 3704 0044 0532 0130 00 today",
+			expected: "Transfer to [IBAN] today",
+		},
+		{
+			name:     "social security number",
+			text:     "SSN 123-45-6789",
+			expected: "SSN [NATIONAL_ID]",
+		},
+		{
+			name:     "person name after salutation",
+			text:     "Dear Mrs. Anna Schmidt, thank you",
+			expected: "Dear Mrs. [PERSON_NAME], thank you",
+		},
+		{
+			name:     "no personal data",
+			text:     "The meeting has 12 participants in 2024",
+			expected: "The meeting has 12 participants in 2024",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redacted, _ := service.Redact(tt.text)
+			assert.Equal(t, tt.expected, redacted)
+		})
+	}
+}
+
+func TestPIIService_Detect_InvalidChecksumsAreIgnored(t *testing.T) {
+	service := &PIIService{Detectors: DefaultPIIDetectors()}
+
+	for _, match := range service.Detect("DE00 3704 0044 0532 0130 00") {
+		assert.NotEqual(t, entities.PIITypeIBAN, match.Type)
+	}
+	for _, match := range service.Detect("000-12-3456") {
+		assert.NotEqual(t, entities.PIITypeNationalID, match.Type)
+	}
+}
+
+func TestPIIService_ScanTrainingDataItems(t *testing.T) {
+	service := &PIIService{Detectors: DefaultPIIDetectors()}
+	datasetID := uuid.New()
+	items := []entities.TrainingDataItem{
+		{ID: uuid.New(), Values: []string{"Write to bob@example.com or alice@example.com", "Done"}},
+		{ID: uuid.New(), Values: []string{"What is AI?", "Artificial Intelligence"}},
+	}
+
+	t.Run("report only", func(t *testing.T) {
+		result, report := service.ScanTrainingDataItems(datasetID, items, []string{"input", "output"}, false)
+
+		assert.Equal(t, items, result)
+		assert.Equal(t, datasetID, report.TrainingDatasetID)
+		assert.False(t, report.Redacted)
+		assert.Equal(t, 2, report.ItemsScanned)
+		assert.Equal(t, 1, report.ItemsWithPII)
+		assert.Equal(t, map[entities.PIIType]int{entities.PIITypeEmail: 2}, report.Counts)
+		assert.Equal(t, []entities.PIIFinding{
+			{ItemID: items[0].ID, FieldName: "input", Type: entities.PIITypeEmail, Count: 2},
+		}, report.Findings)
+	})
+
+	t.Run("redact", func(t *testing.T) {
+		result, report := service.ScanTrainingDataItems(datasetID, items, []string{"input", "output"}, true)
+
+		assert.True(t, report.Redacted)
+		assert.Equal(t, "Write to [EMAIL] or [EMAIL]", result[0].Values[0])
+		assert.Equal(t, items[1].Values, result[1].Values)
+		// The original items are not modified
+		assert.Equal(t, "Write to bob@example.com or alice@example.com", items[0].Values[0])
+	})
+	t.Run("conversation", func(t *testing.T) {
+		conversation := []entities.TrainingDataItem{{
+			ID:     uuid.New(),
+			Values: []string{},
+			Messages: []entities.ConversationMessage{
+				{Role: entities.ConversationMessageRoleUser, Content: "My mail is bob@example.com"},
+				{Role: entities.ConversationMessageRoleAssistant, Content: "Thanks"},
+			},
+		}}
+
+		result, report := service.ScanTrainingDataItems(datasetID, conversation, nil, true)
+
+		assert.Equal(t, 1, report.ItemsWithPII)
+		assert.Equal(t, []entities.PIIFinding{
+			{ItemID: conversation[0].ID, FieldName: "messages[0]", Type: entities.PIITypeEmail, Count: 1},
+		}, report.Findings)
+		assert.Equal(t, "My mail is [EMAIL]", result[0].Messages[0].Content)
+		assert.Equal(t, entities.ConversationMessageRoleUser, result[0].Messages[0].Role)
+		assert.Equal(t, "My mail is bob@example.com", conversation[0].Messages[0].Content)
+	})
+}
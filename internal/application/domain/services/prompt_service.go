@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type PromptService struct {
+	PromptRepository persistence.PromptRepository
+}
+
+// SavePromptVersion returns the prompt version of the text in the project, a new version is stored when the text
+// differs from the latest version, which is true when it was created
+func (s *PromptService) SavePromptVersion(ctx context.Context, projectID uuid.UUID, text string) (*entities.Prompt, bool, error) {
+	prompt, created, err := s.PreparePromptVersion(ctx, projectID, text)
+	if err != nil {
+		return nil, false, err
+	}
+	if created {
+		if err := s.PromptRepository.Create(ctx, prompt); err != nil {
+			return nil, false, fmt.Errorf("failed to create prompt: %w", err)
+		}
+	}
+	return prompt, created, nil
+}
+
+// PreparePromptVersion returns the prompt version of the text in the project like SavePromptVersion, but leaves a new
+// version to the caller to store, so it can be stored with the training dataset that uses it
+func (s *PromptService) PreparePromptVersion(ctx context.Context, projectID uuid.UUID, text string) (*entities.Prompt, bool, error) {
+	latest, err := s.PromptRepository.GetLatestByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get latest prompt: %w", err)
+	}
+
+	return s.NextPromptVersion(projectID, latest, text)
+}
+
+// NextPromptVersion returns the prompt version for a prompt text. The latest version is reused when the text is
+// unchanged, otherwise a new version following it is returned, which is true when the caller has to store it.
+func (s *PromptService) NextPromptVersion(projectID uuid.UUID, latest *entities.Prompt, text string) (*entities.Prompt, bool, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, false, errors.New("generate_prompt is required")
+	}
+	if latest != nil && latest.Text == text {
+		return latest, false, nil
+	}
+
+	prompt := &entities.Prompt{
+		ID:        uuid.New(),
+		ProjectID: projectID,
+		Version:   1,
+		Text:      text,
+	}
+	if latest != nil {
+		prompt.Version = latest.Version + 1
+		prompt.PreviousPromptID = &latest.ID
+	}
+	return prompt, true, nil
+}
+
+// PromptHistoryIDs returns the IDs of the versions before the current prompt, the oldest first
+func (s *PromptService) PromptHistoryIDs(prompts []*entities.Prompt, current *entities.Prompt) []uuid.UUID {
+	var earlier []*entities.Prompt
+	for _, prompt := range prompts {
+		if prompt.Version < current.Version {
+			earlier = append(earlier, prompt)
+		}
+	}
+	sort.Slice(earlier, func(i, j int) bool { return earlier[i].Version < earlier[j].Version })
+
+	ids := make([]uuid.UUID, len(earlier))
+	for i, prompt := range earlier {
+		ids[i] = prompt.ID
+	}
+	return ids
+}
+
+// DiffPrompts compares two prompt versions line by line. The lines are aligned by their longest common
+// subsequence, the removed lines of a change come before the inserted lines.
+func (s *PromptService) DiffPrompts(from *entities.Prompt, to *entities.Prompt) *entities.PromptDiff {
+	fromLines := strings.Split(from.Text, "\n")
+	toLines := strings.Split(to.Text, "\n")
+
+	// common[i][j] is the length of the longest common subsequence of fromLines[i:] and toLines[j:]
+	common := make([][]int, len(fromLines)+1)
+	for i := range common {
+		common[i] = make([]int, len(toLines)+1)
+	}
+	for i := len(fromLines) - 1; i >= 0; i-- {
+		for j := len(toLines) - 1; j >= 0; j-- {
+			if fromLines[i] == toLines[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	diff := &entities.PromptDiff{From: from, To: to, Lines: []entities.PromptDiffLine{}}
+	i, j := 0, 0
+	for i < len(fromLines) || j < len(toLines) {
+		switch {
+		case i < len(fromLines) && j < len(toLines) && fromLines[i] == toLines[j]:
+			diff.Lines = append(diff.Lines, entities.PromptDiffLine{Operation: entities.PromptDiffOperationEqual, Text: fromLines[i]})
+			i++
+			j++
+		case i < len(fromLines) && (j == len(toLines) || common[i+1][j] >= common[i][j+1]):
+			diff.Lines = append(diff.Lines, entities.PromptDiffLine{Operation: entities.PromptDiffOperationDelete, Text: fromLines[i]})
+			i++
+		default:
+			diff.Lines = append(diff.Lines, entities.PromptDiffLine{Operation: entities.PromptDiffOperationInsert, Text: toLines[j]})
+			j++
+		}
+	}
+	return diff
+}
+
+// BuildPromptHistory lists the prompt versions with the training datasets they were used in. A dataset is listed
+// for the prompt it was generated with and for every prompt that generated some of its items.
+func (s *PromptService) BuildPromptHistory(prompts []*entities.Prompt, trainingDatasets []entities.TrainingDatasetPromptItems) []entities.PromptHistoryEntry {
+	history := make([]entities.PromptHistoryEntry, len(prompts))
+	for i, prompt := range prompts {
+		history[i] = entities.PromptHistoryEntry{Prompt: prompt, TrainingDatasets: []entities.PromptTrainingDatasetUsage{}}
+		for _, trainingDataset := range trainingDatasets {
+			items, hasItems := trainingDataset.ItemsByPromptID[prompt.ID]
+			generated := trainingDataset.GeneratePromptID == prompt.ID
+			if !generated && !hasItems {
+				continue
+			}
+			history[i].TrainingDatasets = append(history[i].TrainingDatasets, entities.PromptTrainingDatasetUsage{
+				TrainingDatasetID:      trainingDataset.TrainingDatasetID,
+				TrainingDatasetVersion: trainingDataset.TrainingDatasetVersion,
+				Status:                 trainingDataset.Status,
+				Generated:              generated,
+				Items:                  items,
+			})
+		}
+	}
+	return history
+}
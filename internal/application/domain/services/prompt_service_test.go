@@ -0,0 +1,129 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func TestPromptService_NextPromptVersion(t *testing.T) {
+	service := &PromptService{}
+	projectID := uuid.New()
+
+	t.Run("The first prompt of a project is version 1", func(t *testing.T) {
+		prompt, created, err := service.NextPromptVersion(projectID, nil, "Write questions.")
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, 1, prompt.Version)
+		assert.Equal(t, projectID, prompt.ProjectID)
+		assert.Nil(t, prompt.PreviousPromptID)
+	})
+
+	t.Run("An edited prompt follows the latest version", func(t *testing.T) {
+		latest := &entities.Prompt{ID: uuid.New(), ProjectID: projectID, Version: 3, Text: "Write questions."}
+		prompt, created, err := service.NextPromptVersion(projectID, latest, "Write short questions.")
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, 4, prompt.Version)
+		assert.Equal(t, &latest.ID, prompt.PreviousPromptID)
+		assert.NotEqual(t, latest.ID, prompt.ID)
+	})
+
+	t.Run("An unchanged prompt reuses the latest version", func(t *testing.T) {
+		latest := &entities.Prompt{ID: uuid.New(), ProjectID: projectID, Version: 3, Text: "Write questions."}
+		prompt, created, err := service.NextPromptVersion(projectID, latest, "Write questions.")
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Same(t, latest, prompt)
+	})
+
+	t.Run("An empty prompt is rejected", func(t *testing.T) {
+		_, _, err := service.NextPromptVersion(projectID, nil, "  ")
+		assert.EqualError(t, err, "generate_prompt is required")
+	})
+}
+
+func TestPromptService_PromptHistoryIDs(t *testing.T) {
+	service := &PromptService{}
+	v1 := &entities.Prompt{ID: uuid.New(), Version: 1}
+	v2 := &entities.Prompt{ID: uuid.New(), Version: 2}
+	v3 := &entities.Prompt{ID: uuid.New(), Version: 3}
+
+	assert.Equal(t, []uuid.UUID{v1.ID, v2.ID}, service.PromptHistoryIDs([]*entities.Prompt{v3, v2, v1}, v3))
+	assert.Equal(t, []uuid.UUID{v1.ID}, service.PromptHistoryIDs([]*entities.Prompt{v3, v2, v1}, v2))
+	assert.Empty(t, service.PromptHistoryIDs([]*entities.Prompt{v1}, v1))
+}
+
+func TestPromptService_DiffPrompts(t *testing.T) {
+	service := &PromptService{}
+
+	t.Run("Changed lines are removed and inserted, the other lines are kept", func(t *testing.T) {
+		from := &entities.Prompt{Version: 1, Text: "Write questions.\nUse the text.\nAnswer briefly."}
+		to := &entities.Prompt{Version: 2, Text: "Write questions.\nUse only the text.\nAnswer briefly.\nAvoid yes/no questions."}
+
+		diff := service.DiffPrompts(from, to)
+
+		assert.Same(t, from, diff.From)
+		assert.Same(t, to, diff.To)
+		assert.Equal(t, []entities.PromptDiffLine{
+			{Operation: entities.PromptDiffOperationEqual, Text: "Write questions."},
+			{Operation: entities.PromptDiffOperationDelete, Text: "Use the text."},
+			{Operation: entities.PromptDiffOperationInsert, Text: "Use only the text."},
+			{Operation: entities.PromptDiffOperationEqual, Text: "Answer briefly."},
+			{Operation: entities.PromptDiffOperationInsert, Text: "Avoid yes/no questions."},
+		}, diff.Lines)
+	})
+
+	t.Run("Identical prompts have only equal lines", func(t *testing.T) {
+		prompt := &entities.Prompt{Text: "Write questions.\nUse the text."}
+
+		diff := service.DiffPrompts(prompt, prompt)
+
+		require.Len(t, diff.Lines, 2)
+		for _, line := range diff.Lines {
+			assert.Equal(t, entities.PromptDiffOperationEqual, line.Operation)
+		}
+	})
+}
+
+func TestPromptService_BuildPromptHistory(t *testing.T) {
+	service := &PromptService{}
+	v1 := &entities.Prompt{ID: uuid.New(), Version: 1}
+	v2 := &entities.Prompt{ID: uuid.New(), Version: 2}
+	v3 := &entities.Prompt{ID: uuid.New(), Version: 3}
+
+	generated := entities.TrainingDatasetPromptItems{
+		TrainingDatasetID:      uuid.New(),
+		TrainingDatasetVersion: 1,
+		Status:                 entities.TrainingDatasetStatusDone,
+		GeneratePromptID:       v1.ID,
+		ItemsByPromptID:        map[uuid.UUID]int{v1.ID: 20},
+	}
+	// Extended with an edited prompt, the copied items still belong to the first prompt
+	extended := entities.TrainingDatasetPromptItems{
+		TrainingDatasetID:      uuid.New(),
+		TrainingDatasetVersion: 2,
+		Status:                 entities.TrainingDatasetStatusRunning,
+		GeneratePromptID:       v2.ID,
+		ItemsByPromptID:        map[uuid.UUID]int{v1.ID: 20},
+	}
+
+	history := service.BuildPromptHistory([]*entities.Prompt{v3, v2, v1}, []entities.TrainingDatasetPromptItems{extended, generated})
+
+	require.Len(t, history, 3)
+	assert.Same(t, v3, history[0].Prompt)
+	assert.Empty(t, history[0].TrainingDatasets)
+
+	assert.Equal(t, []entities.PromptTrainingDatasetUsage{
+		{TrainingDatasetID: extended.TrainingDatasetID, TrainingDatasetVersion: 2, Status: entities.TrainingDatasetStatusRunning, Generated: true, Items: 0},
+	}, history[1].TrainingDatasets)
+
+	assert.Equal(t, []entities.PromptTrainingDatasetUsage{
+		{TrainingDatasetID: extended.TrainingDatasetID, TrainingDatasetVersion: 2, Status: entities.TrainingDatasetStatusRunning, Generated: false, Items: 20},
+		{TrainingDatasetID: generated.TrainingDatasetID, TrainingDatasetVersion: 1, Status: entities.TrainingDatasetStatusDone, Generated: true, Items: 20},
+	}, history[2].TrainingDatasets)
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+// trainingDatasetStatusTransitions lists the statuses a training dataset can move to from each status. ABORTED and
+// FAILED go back to PLANNING when the generation is resumed, DONE and DELETED are final.
+var trainingDatasetStatusTransitions = map[entities.TrainingDatasetStatus][]entities.TrainingDatasetStatus{
+	entities.TrainingDatasetStatusPlanning: {
+		entities.TrainingDatasetStatusRunning,
+		entities.TrainingDatasetStatusDone,
+		entities.TrainingDatasetStatusFailed,
+		entities.TrainingDatasetStatusAborted,
+	},
+	entities.TrainingDatasetStatusRunning: {
+		entities.TrainingDatasetStatusDone,
+		entities.TrainingDatasetStatusFailed,
+		entities.TrainingDatasetStatusAborted,
+	},
+	entities.TrainingDatasetStatusAborted: {
+		entities.TrainingDatasetStatusPlanning,
+	},
+	entities.TrainingDatasetStatusFailed: {
+		entities.TrainingDatasetStatusPlanning,
+	},
+}
+
+// finetuneStatusTransitions lists the statuses a finetune can move to from each status, a finetune can not be resumed
+var finetuneStatusTransitions = map[entities.FinetuneStatus][]entities.FinetuneStatus{
+	// Only the scheduler dispatches a queued finetune, it can be aborted while it waits
+	entities.FinetuneStatusQueued: {
+		entities.FinetuneStatusPlanning,
+		entities.FinetuneStatusAborted,
+	},
+	entities.FinetuneStatusPlanning: {
+		entities.FinetuneStatusRunning,
+		entities.FinetuneStatusDone,
+		entities.FinetuneStatusFailed,
+		entities.FinetuneStatusAborted,
+	},
+	entities.FinetuneStatusRunning: {
+		entities.FinetuneStatusDone,
+		entities.FinetuneStatusFailed,
+		entities.FinetuneStatusAborted,
+	},
+}
+
+// externalTrainingDatasetStatuses and externalFinetuneStatuses are the statuses the generator and the trainer can
+// report through the external API. PLANNING is only entered when a generation is resumed or the scheduler dispatches a
+// finetune, ABORTED only by the user.
+var externalTrainingDatasetStatuses = []entities.TrainingDatasetStatus{
+	entities.TrainingDatasetStatusRunning,
+	entities.TrainingDatasetStatusDone,
+	entities.TrainingDatasetStatusFailed,
+}
+
+var externalFinetuneStatuses = []entities.FinetuneStatus{
+	entities.FinetuneStatusRunning,
+	entities.FinetuneStatusDone,
+	entities.FinetuneStatusFailed,
+}
+
+type StatusTransitionService struct{}
+
+// ValidateTrainingDatasetStatusTransition returns an error when a training dataset can not move from the current to
+// the next status
+func (s *StatusTransitionService) ValidateTrainingDatasetStatusTransition(current, next entities.TrainingDatasetStatus) error {
+	for _, allowed := range trainingDatasetStatusTransitions[current] {
+		if allowed == next {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid status transition from %s to %s", current, next)
+}
+
+// ValidateFinetuneStatusTransition returns an error when a finetune can not move from the current to the next status
+func (s *StatusTransitionService) ValidateFinetuneStatusTransition(current, next entities.FinetuneStatus) error {
+	for _, allowed := range finetuneStatusTransitions[current] {
+		if allowed == next {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid status transition from %s to %s", current, next)
+}
+
+// ValidateExternalTrainingDatasetStatusTransition is ValidateTrainingDatasetStatusTransition for the status updates of
+// the generator, which can only move a training dataset to RUNNING, DONE or FAILED
+func (s *StatusTransitionService) ValidateExternalTrainingDatasetStatusTransition(current, next entities.TrainingDatasetStatus) error {
+	if !slices.Contains(externalTrainingDatasetStatuses, next) {
+		return fmt.Errorf("invalid status transition from %s to %s", current, next)
+	}
+	return s.ValidateTrainingDatasetStatusTransition(current, next)
+}
+
+// ValidateExternalFinetuneStatusTransition is ValidateFinetuneStatusTransition for the status updates of the trainer,
+// which can only move a finetune to RUNNING, DONE or FAILED
+func (s *StatusTransitionService) ValidateExternalFinetuneStatusTransition(current, next entities.FinetuneStatus) error {
+	if !slices.Contains(externalFinetuneStatuses, next) {
+		return fmt.Errorf("invalid status transition from %s to %s", current, next)
+	}
+	return s.ValidateFinetuneStatusTransition(current, next)
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func TestStatusTransitionService_ValidateTrainingDatasetStatusTransition(t *testing.T) {
+	service := &StatusTransitionService{}
+
+	tests := []struct {
+		current entities.TrainingDatasetStatus
+		next    entities.TrainingDatasetStatus
+		valid   bool
+	}{
+		{entities.TrainingDatasetStatusPlanning, entities.TrainingDatasetStatusRunning, true},
+		{entities.TrainingDatasetStatusPlanning, entities.TrainingDatasetStatusAborted, true},
+		{entities.TrainingDatasetStatusRunning, entities.TrainingDatasetStatusDone, true},
+		{entities.TrainingDatasetStatusRunning, entities.TrainingDatasetStatusFailed, true},
+		{entities.TrainingDatasetStatusRunning, entities.TrainingDatasetStatusAborted, true},
+		{entities.TrainingDatasetStatusAborted, entities.TrainingDatasetStatusPlanning, true},
+		{entities.TrainingDatasetStatusFailed, entities.TrainingDatasetStatusPlanning, true},
+		{entities.TrainingDatasetStatusRunning, entities.TrainingDatasetStatusRunning, false},
+		{entities.TrainingDatasetStatusRunning, entities.TrainingDatasetStatusPlanning, false},
+		{entities.TrainingDatasetStatusDone, entities.TrainingDatasetStatusRunning, false},
+		{entities.TrainingDatasetStatusDone, entities.TrainingDatasetStatusAborted, false},
+		{entities.TrainingDatasetStatusAborted, entities.TrainingDatasetStatusRunning, false},
+		{entities.TrainingDatasetStatusAborted, entities.TrainingDatasetStatusDone, false},
+		{entities.TrainingDatasetStatusDeleted, entities.TrainingDatasetStatusPlanning, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.current)+" to "+string(tt.next), func(t *testing.T) {
+			err := service.ValidateTrainingDatasetStatusTransition(tt.current, tt.next)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, "invalid status transition from "+string(tt.current)+" to "+string(tt.next))
+			}
+		})
+	}
+}
+
+func TestStatusTransitionService_ValidateFinetuneStatusTransition(t *testing.T) {
+	service := &StatusTransitionService{}
+
+	assert.NoError(t, service.ValidateFinetuneStatusTransition(entities.FinetuneStatusQueued, entities.FinetuneStatusPlanning))
+	assert.NoError(t, service.ValidateFinetuneStatusTransition(entities.FinetuneStatusQueued, entities.FinetuneStatusAborted))
+	assert.Error(t, service.ValidateFinetuneStatusTransition(entities.FinetuneStatusQueued, entities.FinetuneStatusRunning))
+	assert.NoError(t, service.ValidateFinetuneStatusTransition(entities.FinetuneStatusPlanning, entities.FinetuneStatusRunning))
+	assert.NoError(t, service.ValidateFinetuneStatusTransition(entities.FinetuneStatusRunning, entities.FinetuneStatusAborted))
+	assert.NoError(t, service.ValidateFinetuneStatusTransition(entities.FinetuneStatusRunning, entities.FinetuneStatusDone))
+	assert.Error(t, service.ValidateFinetuneStatusTransition(entities.FinetuneStatusDone, entities.FinetuneStatusRunning))
+	assert.Error(t, service.ValidateFinetuneStatusTransition(entities.FinetuneStatusAborted, entities.FinetuneStatusPlanning))
+	assert.Error(t, service.ValidateFinetuneStatusTransition(entities.FinetuneStatusFailed, entities.FinetuneStatusDone))
+}
+
+func TestStatusTransitionService_ValidateExternalTrainingDatasetStatusTransition(t *testing.T) {
+	service := &StatusTransitionService{}
+
+	assert.NoError(t, service.ValidateExternalTrainingDatasetStatusTransition(entities.TrainingDatasetStatusPlanning, entities.TrainingDatasetStatusRunning))
+	assert.NoError(t, service.ValidateExternalTrainingDatasetStatusTransition(entities.TrainingDatasetStatusRunning, entities.TrainingDatasetStatusDone))
+	assert.NoError(t, service.ValidateExternalTrainingDatasetStatusTransition(entities.TrainingDatasetStatusRunning, entities.TrainingDatasetStatusFailed))
+	// Only a resume brings an aborted or failed training dataset back to PLANNING and only the user aborts
+	assert.EqualError(t, service.ValidateExternalTrainingDatasetStatusTransition(entities.TrainingDatasetStatusAborted, entities.TrainingDatasetStatusPlanning),
+		"invalid status transition from ABORTED to PLANNING")
+	assert.Error(t, service.ValidateExternalTrainingDatasetStatusTransition(entities.TrainingDatasetStatusFailed, entities.TrainingDatasetStatusPlanning))
+	assert.Error(t, service.ValidateExternalTrainingDatasetStatusTransition(entities.TrainingDatasetStatusRunning, entities.TrainingDatasetStatusAborted))
+	assert.Error(t, service.ValidateExternalTrainingDatasetStatusTransition(entities.TrainingDatasetStatusDone, entities.TrainingDatasetStatusFailed))
+}
+
+func TestStatusTransitionService_ValidateExternalFinetuneStatusTransition(t *testing.T) {
+	service := &StatusTransitionService{}
+
+	assert.NoError(t, service.ValidateExternalFinetuneStatusTransition(entities.FinetuneStatusPlanning, entities.FinetuneStatusRunning))
+	assert.NoError(t, service.ValidateExternalFinetuneStatusTransition(entities.FinetuneStatusRunning, entities.FinetuneStatusDone))
+	assert.NoError(t, service.ValidateExternalFinetuneStatusTransition(entities.FinetuneStatusRunning, entities.FinetuneStatusFailed))
+	// Only the scheduler dispatches a queued finetune
+	assert.EqualError(t, service.ValidateExternalFinetuneStatusTransition(entities.FinetuneStatusQueued, entities.FinetuneStatusPlanning),
+		"invalid status transition from QUEUED to PLANNING")
+	assert.Error(t, service.ValidateExternalFinetuneStatusTransition(entities.FinetuneStatusQueued, entities.FinetuneStatusDone))
+	assert.Error(t, service.ValidateExternalFinetuneStatusTransition(entities.FinetuneStatusRunning, entities.FinetuneStatusAborted))
+}
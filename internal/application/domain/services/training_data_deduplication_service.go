@@ -0,0 +1,249 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+const (
+	DefaultDuplicateSimilarityThreshold = 0.85
+
+	// 128 hash functions split into 32 bands of 4 rows, so candidate pairs are found
+	// down to a similarity of roughly 0.42 before they are verified against the threshold
+	minHashNumHashes = 128
+	minHashBands     = 32
+	minHashRows      = minHashNumHashes / minHashBands
+	shingleWords     = 3
+	shingleChars     = 4
+)
+
+type TrainingDataDeduplicationService struct{}
+
+func (s *TrainingDataDeduplicationService) ValidateSimilarityThreshold(threshold float64) error {
+	if threshold <= 0 || threshold > 1 {
+		return errors.New("similarity threshold must be greater than 0 and at most 1")
+	}
+	return nil
+}
+
+// FindDuplicates clusters items with the same or a similar value in the input field,
+// or with the same or similar user messages for conversations.
+// Items are compared by exact value, by normalized text and by MinHash similarity of their shingles.
+// The first item of every cluster is kept, so the order of the items decides which one survives.
+func (s *TrainingDataDeduplicationService) FindDuplicates(
+	trainingDataItems []entities.TrainingDataItem,
+	fieldNames []string,
+	inputField string,
+	threshold float64,
+) []entities.DuplicateCluster {
+	inputIndex := -1
+	for i, fieldName := range fieldNames {
+		if fieldName == inputField {
+			inputIndex = i
+		}
+	}
+
+	texts := make([]string, len(trainingDataItems))
+	normalized := make([]string, len(trainingDataItems))
+	signatures := make([][]uint64, len(trainingDataItems))
+	for i, item := range trainingDataItems {
+		// Conversations are compared by what the user says, without a known input field the whole item is compared
+		if len(item.Messages) > 0 {
+			texts[i] = conversationRoleText(item.Messages, entities.ConversationMessageRoleUser)
+		} else if inputIndex >= 0 && inputIndex < len(item.Values) {
+			texts[i] = item.Values[inputIndex]
+		} else {
+			texts[i] = strings.Join(item.Values, "\n")
+		}
+		normalized[i] = normalizeText(texts[i])
+		signatures[i] = minHashSignature(shingles(normalized[i]))
+	}
+
+	parent := make([]int, len(trainingDataItems))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	union := func(a, b int) {
+		rootA, rootB := find(a), find(b)
+		// The lower index becomes the root so the earliest item is kept
+		if rootA < rootB {
+			parent[rootB] = rootA
+		} else if rootB < rootA {
+			parent[rootA] = rootB
+		}
+	}
+
+	// Exact and normalized matches
+	firstByText := make(map[string]int)
+	for i, text := range normalized {
+		if text == "" {
+			continue
+		}
+		if first, exists := firstByText[text]; exists {
+			union(first, i)
+		} else {
+			firstByText[text] = i
+		}
+	}
+
+	// Similar items share at least one band of their signature
+	for band := 0; band < minHashBands; band++ {
+		buckets := make(map[string][]int)
+		for i, signature := range signatures {
+			if normalized[i] == "" {
+				continue
+			}
+			key := fmt.Sprint(signature[band*minHashRows : (band+1)*minHashRows])
+			buckets[key] = append(buckets[key], i)
+		}
+		for _, bucket := range buckets {
+			for a := 0; a < len(bucket); a++ {
+				for b := a + 1; b < len(bucket); b++ {
+					if find(bucket[a]) == find(bucket[b]) {
+						continue
+					}
+					if signatureSimilarity(signatures[bucket[a]], signatures[bucket[b]]) >= threshold {
+						union(bucket[a], bucket[b])
+					}
+				}
+			}
+		}
+	}
+
+	var clusters []entities.DuplicateCluster
+	clusterIndex := make(map[int]int)
+	for i, item := range trainingDataItems {
+		root := find(i)
+		if root == i {
+			continue
+		}
+
+		index, exists := clusterIndex[root]
+		if !exists {
+			clusters = append(clusters, entities.DuplicateCluster{KeepID: trainingDataItems[root].ID})
+			index = len(clusters) - 1
+			clusterIndex[root] = index
+		}
+
+		match := entities.DuplicateMatch{ItemID: item.ID}
+		switch {
+		case texts[i] == texts[root]:
+			match.Method = entities.DuplicateMatchMethodExact
+			match.Similarity = 1
+		case normalized[i] == normalized[root]:
+			match.Method = entities.DuplicateMatchMethodNormalized
+			match.Similarity = 1
+		default:
+			match.Method = entities.DuplicateMatchMethodMinHash
+			match.Similarity = signatureSimilarity(signatures[i], signatures[root])
+		}
+		clusters[index].Duplicates = append(clusters[index].Duplicates, match)
+	}
+
+	return clusters
+}
+
+// DuplicateReasons returns the deleted reason for every duplicate item of the clusters
+func (s *TrainingDataDeduplicationService) DuplicateReasons(clusters []entities.DuplicateCluster) map[uuid.UUID]string {
+	reasons := make(map[uuid.UUID]string)
+	for _, cluster := range clusters {
+		for _, duplicate := range cluster.Duplicates {
+			reasons[duplicate.ItemID] = fmt.Sprintf("duplicate of %s (%s, similarity %.2f)", cluster.KeepID, duplicate.Method, duplicate.Similarity)
+		}
+	}
+	return reasons
+}
+
+// normalizeText lowercases the text, removes punctuation and collapses whitespace
+func normalizeText(text string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			builder.WriteRune(r)
+		case unicode.IsSpace(r):
+			builder.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(builder.String()), " ")
+}
+
+// shingles returns word shingles, or character shingles for texts that are too short
+func shingles(text string) []string {
+	words := strings.Fields(text)
+	if len(words) >= shingleWords {
+		result := make([]string, 0, len(words)-shingleWords+1)
+		for i := 0; i+shingleWords <= len(words); i++ {
+			result = append(result, strings.Join(words[i:i+shingleWords], " "))
+		}
+		return result
+	}
+
+	runes := []rune(text)
+	if len(runes) <= shingleChars {
+		return []string{text}
+	}
+	result := make([]string, 0, len(runes)-shingleChars+1)
+	for i := 0; i+shingleChars <= len(runes); i++ {
+		result = append(result, string(runes[i:i+shingleChars]))
+	}
+	return result
+}
+
+// The hash functions are derived from a fixed seed so signatures are stable between runs
+var minHashCoefficients = func() [][2]uint64 {
+	rng := rand.New(rand.NewSource(1))
+	coefficients := make([][2]uint64, minHashNumHashes)
+	for i := range coefficients {
+		coefficients[i] = [2]uint64{rng.Uint64() | 1, rng.Uint64()}
+	}
+	return coefficients
+}()
+
+func minHashSignature(shingles []string) []uint64 {
+	signature := make([]uint64, minHashNumHashes)
+	for i := range signature {
+		signature[i] = ^uint64(0)
+	}
+
+	for _, shingle := range shingles {
+		hasher := fnv.New64a()
+		hasher.Write([]byte(shingle))
+		base := hasher.Sum64()
+
+		for i, coefficient := range minHashCoefficients {
+			value := base*coefficient[0] + coefficient[1]
+			if value < signature[i] {
+				signature[i] = value
+			}
+		}
+	}
+
+	return signature
+}
+
+// signatureSimilarity estimates the Jaccard similarity from two MinHash signatures
+func signatureSimilarity(a []uint64, b []uint64) float64 {
+	equal := 0
+	for i := range a {
+		if a[i] == b[i] {
+			equal++
+		}
+	}
+	return float64(equal) / float64(len(a))
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func newDeduplicationTestItem(question string) entities.TrainingDataItem {
+	return entities.TrainingDataItem{ID: uuid.New(), Values: []string{question, "answer"}}
+}
+
+func TestTrainingDataDeduplicationService_FindDuplicates(t *testing.T) {
+	service := &TrainingDataDeduplicationService{}
+	fieldNames := []string{"question", "answer"}
+
+	original := newDeduplicationTestItem("What are the main causes of the French Revolution in the late eighteenth century?")
+	exact := newDeduplicationTestItem("What are the main causes of the French Revolution in the late eighteenth century?")
+	normalized := newDeduplicationTestItem("what are the MAIN causes of the French Revolution, in the late eighteenth century")
+	similar := newDeduplicationTestItem("What are the main causes of the French Revolution in the late eighteenth century and its aftermath?")
+	different := newDeduplicationTestItem("How does photosynthesis convert light energy into chemical energy in plants?")
+
+	items := []entities.TrainingDataItem{original, exact, normalized, similar, different}
+	clusters := service.FindDuplicates(items, fieldNames, "question", 0.7)
+
+	if assert.Len(t, clusters, 1) {
+		cluster := clusters[0]
+		assert.Equal(t, original.ID, cluster.KeepID)
+		if assert.Len(t, cluster.Duplicates, 3) {
+			assert.Equal(t, exact.ID, cluster.Duplicates[0].ItemID)
+			assert.Equal(t, entities.DuplicateMatchMethodExact, cluster.Duplicates[0].Method)
+			assert.Equal(t, normalized.ID, cluster.Duplicates[1].ItemID)
+			assert.Equal(t, entities.DuplicateMatchMethodNormalized, cluster.Duplicates[1].Method)
+			assert.Equal(t, similar.ID, cluster.Duplicates[2].ItemID)
+			assert.Equal(t, entities.DuplicateMatchMethodMinHash, cluster.Duplicates[2].Method)
+			assert.GreaterOrEqual(t, cluster.Duplicates[2].Similarity, 0.7)
+		}
+	}
+
+	// A strict threshold only keeps the exact and normalized matches
+	clusters = service.FindDuplicates(items, fieldNames, "question", 0.99)
+	if assert.Len(t, clusters, 1) {
+		assert.Len(t, clusters[0].Duplicates, 2)
+	}
+}
+
+func TestTrainingDataDeduplicationService_FindDuplicates_NoDuplicates(t *testing.T) {
+	service := &TrainingDataDeduplicationService{}
+
+	items := []entities.TrainingDataItem{
+		newDeduplicationTestItem("What is machine learning?"),
+		newDeduplicationTestItem("How do vaccines train the immune system?"),
+		newDeduplicationTestItem(""),
+		newDeduplicationTestItem(""),
+	}
+
+	clusters := service.FindDuplicates(items, []string{"question", "answer"}, "question", 0.85)
+	assert.Empty(t, clusters)
+}
+
+func TestTrainingDataDeduplicationService_FindDuplicates_Conversation(t *testing.T) {
+	service := &TrainingDataDeduplicationService{}
+	conversation := func(question string, answer string) entities.TrainingDataItem {
+		return entities.TrainingDataItem{ID: uuid.New(), Values: []string{}, Messages: []entities.ConversationMessage{
+			{Role: entities.ConversationMessageRoleUser, Content: question},
+			{Role: entities.ConversationMessageRoleAssistant, Content: answer},
+		}}
+	}
+
+	original := conversation("How do I reset my password?", "Click on 'Forgot password'.")
+	duplicate := conversation("How do I reset my password?", "Use the link on the login page.")
+	different := conversation("How do I delete my account?", "Open the account settings.")
+
+	clusters := service.FindDuplicates([]entities.TrainingDataItem{original, duplicate, different}, []string{}, "", 0.85)
+
+	if assert.Len(t, clusters, 1) {
+		assert.Equal(t, original.ID, clusters[0].KeepID)
+		if assert.Len(t, clusters[0].Duplicates, 1) {
+			assert.Equal(t, duplicate.ID, clusters[0].Duplicates[0].ItemID)
+			assert.Equal(t, entities.DuplicateMatchMethodExact, clusters[0].Duplicates[0].Method)
+		}
+	}
+}
+
+func TestTrainingDataDeduplicationService_DuplicateReasons(t *testing.T) {
+	service := &TrainingDataDeduplicationService{}
+
+	keepID, duplicateID := uuid.New(), uuid.New()
+	clusters := []entities.DuplicateCluster{
+		{
+			KeepID: keepID,
+			Duplicates: []entities.DuplicateMatch{
+				{ItemID: duplicateID, Method: entities.DuplicateMatchMethodMinHash, Similarity: 0.914},
+			},
+		},
+	}
+
+	reasons := service.DuplicateReasons(clusters)
+	assert.Equal(t, map[uuid.UUID]string{
+		duplicateID: "duplicate of " + keepID.String() + " (minhash, similarity 0.91)",
+	}, reasons)
+}
+
+func TestTrainingDataDeduplicationService_ValidateSimilarityThreshold(t *testing.T) {
+	service := &TrainingDataDeduplicationService{}
+
+	assert.NoError(t, service.ValidateSimilarityThreshold(0.85))
+	assert.NoError(t, service.ValidateSimilarityThreshold(1))
+	assert.Error(t, service.ValidateSimilarityThreshold(0))
+	assert.Error(t, service.ValidateSimilarityThreshold(1.5))
+}
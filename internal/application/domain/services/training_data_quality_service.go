@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/out/clients"
+)
+
+const (
+	MinQualityScore = 1
+	MaxQualityScore = 10
+
+	DefaultQualityJudgeModel = "qwen3:30b-a3b-instruct-2507-q4_K_M"
+
+	DefaultQualityRubric = `Rate how useful the example is for fine-tuning an LLM.
+A good example has an input that is a clear, self-contained request and an output that answers it correctly, completely and without filler.
+Give low scores to outputs that are wrong, off-topic, truncated, repetitive or that do not match the language of the input.`
+)
+
+type TrainingDataQualityService struct {
+	OllamaLLMClient clients.OllamaLLMClient
+}
+
+// ScoreTrainingDataItem asks the judge model to rate an input/output pair using the given rubric
+func (s *TrainingDataQualityService) ScoreTrainingDataItem(ctx context.Context, model string, rubric string, input string, output string) (*entities.TrainingDataItemQualityScore, error) {
+	promptTemplate := `You are judging the quality of a training example for LLM fine-tuning.
+
+RUBRIC:
+%s
+
+INPUT:
+%s
+
+OUTPUT:
+%s
+
+Score the example from %d (unusable) to %d (excellent) according to the rubric. Answer only with JSON in the following format:
+{"score": a_number_between_%d_and_%d, "rationale": "One or two sentences explaining the score"}
+
+JSON:`
+
+	llmPrompt := fmt.Sprintf(promptTemplate, rubric, input, output, MinQualityScore, MaxQualityScore, MinQualityScore, MaxQualityScore)
+
+	maxTokens := 300
+	result, err := s.OllamaLLMClient.GenerateCompletion(ctx, nil, llmPrompt, model, &maxTokens, 0.0, 0.9)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	return s.ParseQualityJudgement(result.Response)
+}
+
+// ParseQualityJudgement extracts the score and rationale from the response of the judge model
+func (s *TrainingDataQualityService) ParseQualityJudgement(response string) (*entities.TrainingDataItemQualityScore, error) {
+	start := strings.Index(response, "{")
+	end := strings.LastIndex(response, "}")
+	if start == -1 || end <= start {
+		return nil, errors.New("could not find valid JSON in response")
+	}
+
+	var judgement struct {
+		Score     *float64 `json:"score"`
+		Rationale string   `json:"rationale"`
+	}
+	if err := json.Unmarshal([]byte(response[start:end+1]), &judgement); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+	}
+	if judgement.Score == nil {
+		return nil, errors.New("response does not contain a score")
+	}
+
+	score := *judgement.Score
+	if math.IsNaN(score) || score < MinQualityScore || score > MaxQualityScore {
+		return nil, fmt.Errorf("score %v is outside of %d to %d", score, MinQualityScore, MaxQualityScore)
+	}
+
+	return &entities.TrainingDataItemQualityScore{
+		Score:     score,
+		Rationale: strings.TrimSpace(judgement.Rationale),
+	}, nil
+}
+
+// QualityScoreHistogram counts the items per whole score, there is a bucket for every possible score
+func (s *TrainingDataQualityService) QualityScoreHistogram(items []entities.TrainingDataItem) *entities.QualityScoreHistogram {
+	histogram := &entities.QualityScoreHistogram{}
+	for score := MinQualityScore; score <= MaxQualityScore; score++ {
+		histogram.Buckets = append(histogram.Buckets, entities.QualityScoreBucket{Score: score})
+	}
+
+	total := 0.0
+	for _, item := range items {
+		if item.QualityScore == nil {
+			histogram.UnscoredItems++
+			continue
+		}
+
+		bucket := int(math.Floor(*item.QualityScore)) - MinQualityScore
+		bucket = max(0, min(bucket, len(histogram.Buckets)-1))
+		histogram.Buckets[bucket].Count++
+		histogram.ScoredItems++
+		total += *item.QualityScore
+	}
+
+	if histogram.ScoredItems > 0 {
+		average := total / float64(histogram.ScoredItems)
+		histogram.AverageScore = &average
+	}
+
+	return histogram
+}
+
+// ItemsToScore returns the items a scoring job judges, items that already have a score are only judged again on rescore
+func (s *TrainingDataQualityService) ItemsToScore(items []entities.TrainingDataItem, rescore bool) []entities.TrainingDataItem {
+	var toScore []entities.TrainingDataItem
+	for _, item := range items {
+		if rescore || item.QualityScore == nil {
+			toScore = append(toScore, item)
+		}
+	}
+	return toScore
+}
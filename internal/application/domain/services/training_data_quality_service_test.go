@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/out/clients"
+)
+
+func TestTrainingDataQualityService_ScoreTrainingDataItem(t *testing.T) {
+	var sentPrompt string
+	service := &TrainingDataQualityService{
+		OllamaLLMClient: &MockOllamaLLMClient{
+			GenerateCompletionFunc: func(ctx context.Context, finetuneID *string, prompt string, model string, maxTokens *int, temperature float64, topP float64) (*clients.OllamaLLMClientResult, error) {
+				sentPrompt = prompt
+				return &clients.OllamaLLMClientResult{Response: "```json\n{\"score\": 8, \"rationale\": \"Correct and concise.\"}\n```"}, nil
+			},
+		},
+	}
+
+	score, err := service.ScoreTrainingDataItem(context.Background(), DefaultQualityJudgeModel, "Answers must be correct.", "What is AI?", "Artificial Intelligence")
+	assert.NoError(t, err)
+	assert.Equal(t, &entities.TrainingDataItemQualityScore{Score: 8, Rationale: "Correct and concise."}, score)
+	assert.True(t, strings.Contains(sentPrompt, "Answers must be correct."))
+	assert.True(t, strings.Contains(sentPrompt, "What is AI?"))
+	assert.True(t, strings.Contains(sentPrompt, "Artificial Intelligence"))
+}
+
+func TestTrainingDataQualityService_ParseQualityJudgement(t *testing.T) {
+	service := &TrainingDataQualityService{}
+
+	tests := []struct {
+		name     string
+		response string
+		wantErr  string
+	}{
+		{name: "Plain JSON", response: `{"score": 6.5, "rationale": "ok"}`},
+		{name: "JSON with surrounding text", response: "Here you go: {\"score\": 2, \"rationale\": \"wrong\"} Hope it helps"},
+		{name: "Missing score", response: `{"rationale": "no score"}`, wantErr: "response does not contain a score"},
+		{name: "Score out of range", response: `{"score": 42, "rationale": "too high"}`, wantErr: "score 42 is outside of 1 to 10"},
+		{name: "No JSON", response: "I cannot rate this", wantErr: "could not find valid JSON in response"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ParseQualityJudgement(tt.response)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTrainingDataQualityService_QualityScoreHistogram(t *testing.T) {
+	service := &TrainingDataQualityService{}
+
+	scores := []float64{1, 7.5, 7, 10}
+	var items []entities.TrainingDataItem
+	for i := range scores {
+		items = append(items, entities.TrainingDataItem{QualityScore: &scores[i]})
+	}
+	items = append(items, entities.TrainingDataItem{})
+
+	histogram := service.QualityScoreHistogram(items)
+
+	assert.Len(t, histogram.Buckets, 10)
+	assert.Equal(t, 1, histogram.Buckets[0].Count)
+	assert.Equal(t, entities.QualityScoreBucket{Score: 7, Count: 2}, histogram.Buckets[6])
+	assert.Equal(t, 1, histogram.Buckets[9].Count)
+	assert.Equal(t, 4, histogram.ScoredItems)
+	assert.Equal(t, 1, histogram.UnscoredItems)
+	if assert.NotNil(t, histogram.AverageScore) {
+		assert.InDelta(t, 6.375, *histogram.AverageScore, 0.0001)
+	}
+}
+
+func TestTrainingDataQualityService_ItemsToScore(t *testing.T) {
+	service := &TrainingDataQualityService{}
+
+	score := 8.0
+	items := []entities.TrainingDataItem{{QualityScore: &score}, {}}
+
+	assert.Len(t, service.ItemsToScore(items, false), 1)
+	assert.Nil(t, service.ItemsToScore(items, false)[0].QualityScore)
+	assert.Len(t, service.ItemsToScore(items, true), 2)
+}
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type TrainingDatasetDiffService struct{}
+
+// DiffTrainingDatasets compares the active items of two training dataset versions.
+// Items are paired in this order: by ID, by a CorrectsID chain from the newer to the older item,
+// by identical content and finally by the same value in the input field, which counts as modified.
+// Values are compared by field name, so a reordered or renamed column does not mark every item as changed.
+func (s *TrainingDatasetDiffService) DiffTrainingDatasets(
+	from *entities.TrainingDataset,
+	to *entities.TrainingDataset,
+	fromItems []entities.TrainingDataItem,
+	toItems []entities.TrainingDataItem,
+) *entities.TrainingDatasetDiff {
+	diff := &entities.TrainingDatasetDiff{
+		From:              from,
+		To:                to,
+		FieldNamesAdded:   missingFieldNames(to.FieldNames, from.FieldNames),
+		FieldNamesRemoved: missingFieldNames(from.FieldNames, to.FieldNames),
+	}
+	diff.FieldOrderChanged = len(diff.FieldNamesAdded) == 0 && len(diff.FieldNamesRemoved) == 0 &&
+		strings.Join(from.FieldNames, "\x00") != strings.Join(to.FieldNames, "\x00")
+
+	fromRecords := make([]map[string]string, len(fromItems))
+	for i, item := range fromItems {
+		fromRecords[i] = itemRecord(item, from.FieldNames)
+	}
+	toRecords := make([]map[string]string, len(toItems))
+	for i, item := range toItems {
+		toRecords[i] = itemRecord(item, to.FieldNames)
+	}
+
+	fromMatched := make([]bool, len(fromItems))
+	toMatched := make([]bool, len(toItems))
+	match := func(fromIndex int, toIndex int, corrected bool) {
+		fromMatched[fromIndex] = true
+		toMatched[toIndex] = true
+
+		changedFields := changedFieldNames(fromRecords[fromIndex], toRecords[toIndex])
+		change := entities.TrainingDataItemChange{From: fromItems[fromIndex], To: toItems[toIndex], ChangedFields: changedFields}
+		switch {
+		case corrected:
+			diff.Corrected = append(diff.Corrected, change)
+		case len(changedFields) > 0:
+			diff.Modified = append(diff.Modified, change)
+		default:
+			diff.Unchanged++
+		}
+	}
+
+	// Same item in both versions, e.g. when a dataset is compared with itself
+	fromIndexByID := make(map[uuid.UUID]int, len(fromItems))
+	for i, item := range fromItems {
+		fromIndexByID[item.ID] = i
+	}
+	for toIndex, item := range toItems {
+		if fromIndex, exists := fromIndexByID[item.ID]; exists && !fromMatched[fromIndex] {
+			match(fromIndex, toIndex, false)
+		}
+	}
+
+	// Follow the corrections of the newer version back to an item of the older version
+	correctsByID := make(map[uuid.UUID]*uuid.UUID)
+	for _, items := range [][]entities.TrainingDataItem{from.Data, to.Data, fromItems, toItems} {
+		for _, item := range items {
+			correctsByID[item.ID] = item.CorrectsID
+		}
+	}
+	for toIndex, item := range toItems {
+		if toMatched[toIndex] {
+			continue
+		}
+		correctsID := item.CorrectsID
+		for steps := 0; correctsID != nil && steps < len(correctsByID); steps++ {
+			if fromIndex, exists := fromIndexByID[*correctsID]; exists {
+				if !fromMatched[fromIndex] {
+					match(fromIndex, toIndex, true)
+				}
+				break
+			}
+			correctsID = correctsByID[*correctsID]
+		}
+	}
+
+	// Identical content
+	fromIndexesByContent := make(map[string][]int)
+	for i, record := range fromRecords {
+		if !fromMatched[i] {
+			key := recordKey(record)
+			fromIndexesByContent[key] = append(fromIndexesByContent[key], i)
+		}
+	}
+	for toIndex, record := range toRecords {
+		if toMatched[toIndex] {
+			continue
+		}
+		key := recordKey(record)
+		if candidates := fromIndexesByContent[key]; len(candidates) > 0 {
+			fromIndexesByContent[key] = candidates[1:]
+			match(candidates[0], toIndex, false)
+		}
+	}
+
+	// Same input with a different output
+	fromIndexesByInput := make(map[string][]int)
+	for i, record := range fromRecords {
+		if input, ok := record[to.InputField]; ok && !fromMatched[i] && strings.TrimSpace(input) != "" {
+			key := strings.TrimSpace(input)
+			fromIndexesByInput[key] = append(fromIndexesByInput[key], i)
+		}
+	}
+	for toIndex, record := range toRecords {
+		input, ok := record[to.InputField]
+		if toMatched[toIndex] || !ok {
+			continue
+		}
+		key := strings.TrimSpace(input)
+		if candidates := fromIndexesByInput[key]; len(candidates) > 0 {
+			fromIndexesByInput[key] = candidates[1:]
+			match(candidates[0], toIndex, false)
+		}
+	}
+
+	for i, item := range fromItems {
+		if !fromMatched[i] {
+			diff.Removed = append(diff.Removed, item)
+		}
+	}
+	for i, item := range toItems {
+		if !toMatched[i] {
+			diff.Added = append(diff.Added, item)
+		}
+	}
+
+	return diff
+}
+
+// missingFieldNames returns the field names of a that are not in b
+func missingFieldNames(a []string, b []string) []string {
+	existing := make(map[string]bool, len(b))
+	for _, fieldName := range b {
+		existing[fieldName] = true
+	}
+
+	var missing []string
+	for _, fieldName := range a {
+		if !existing[fieldName] {
+			missing = append(missing, fieldName)
+		}
+	}
+	return missing
+}
+
+// itemRecord maps the values of an item to their field names, the messages of a conversation count as one field
+func itemRecord(item entities.TrainingDataItem, fieldNames []string) map[string]string {
+	record := make(map[string]string, len(fieldNames))
+	if len(item.Messages) > 0 {
+		messages, _ := json.Marshal(item.Messages)
+		record["messages"] = string(messages)
+	}
+	for i, fieldName := range fieldNames {
+		if i < len(item.Values) {
+			record[fieldName] = item.Values[i]
+		}
+	}
+	return record
+}
+
+// recordKey serializes a record with sorted keys, so records with the same values get the same key
+func recordKey(record map[string]string) string {
+	key, _ := json.Marshal(record)
+	return string(key)
+}
+
+// changedFieldNames returns the fields whose value differs, fields missing on one side count as changed
+func changedFieldNames(from map[string]string, to map[string]string) []string {
+	var changed []string
+	for fieldName, fromValue := range from {
+		if toValue, exists := to[fieldName]; !exists || toValue != fromValue {
+			changed = append(changed, fieldName)
+		}
+	}
+	for fieldName := range to {
+		if _, exists := from[fieldName]; !exists {
+			changed = append(changed, fieldName)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
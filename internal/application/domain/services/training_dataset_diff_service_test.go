@@ -0,0 +1,125 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func diffTestItem(values ...string) entities.TrainingDataItem {
+	return entities.TrainingDataItem{ID: uuid.New(), Values: values}
+}
+
+func TestTrainingDatasetDiffService_DiffTrainingDatasets(t *testing.T) {
+	service := &TrainingDatasetDiffService{}
+
+	unchanged := diffTestItem("What is AI?", "Artificial Intelligence")
+	modified := diffTestItem("What is ML?", "Machine Learning")
+	corrected := diffTestItem("What is DL?", "Deep Learnin")
+	removed := diffTestItem("What is NLP?", "Natural Language Processing")
+
+	correction := diffTestItem("What is DL?", "Deep Learning")
+	correction.CorrectsID = &corrected.ID
+	added := diffTestItem("What is RL?", "Reinforcement Learning")
+
+	from := &entities.TrainingDataset{
+		Version:    1,
+		InputField: "question",
+		FieldNames: []string{"question", "answer"},
+		Data:       []entities.TrainingDataItem{unchanged, modified, corrected, removed},
+	}
+	// The second version swaps the columns, values are still compared by field name
+	to := &entities.TrainingDataset{
+		Version:    2,
+		InputField: "question",
+		FieldNames: []string{"answer", "question"},
+		Data: []entities.TrainingDataItem{
+			diffTestItem("Artificial Intelligence", "What is AI?"),
+			diffTestItem("Machine learning is a subset of AI", "What is ML?"),
+			{ID: correction.ID, Values: []string{"Deep Learning", "What is DL?"}, CorrectsID: correction.CorrectsID},
+			diffTestItem(added.Values[1], added.Values[0]),
+		},
+	}
+
+	diff := service.DiffTrainingDatasets(from, to, from.Data, to.Data)
+
+	assert.True(t, diff.FieldOrderChanged)
+	assert.Empty(t, diff.FieldNamesAdded)
+	assert.Empty(t, diff.FieldNamesRemoved)
+	assert.Equal(t, 1, diff.Unchanged)
+
+	if assert.Len(t, diff.Corrected, 1) {
+		assert.Equal(t, corrected.ID, diff.Corrected[0].From.ID)
+		assert.Equal(t, correction.ID, diff.Corrected[0].To.ID)
+		assert.Equal(t, []string{"answer"}, diff.Corrected[0].ChangedFields)
+	}
+	if assert.Len(t, diff.Modified, 1) {
+		assert.Equal(t, modified.ID, diff.Modified[0].From.ID)
+		assert.Equal(t, []string{"answer"}, diff.Modified[0].ChangedFields)
+	}
+	if assert.Len(t, diff.Removed, 1) {
+		assert.Equal(t, removed.ID, diff.Removed[0].ID)
+	}
+	if assert.Len(t, diff.Added, 1) {
+		assert.Equal(t, []string{"Reinforcement Learning", "What is RL?"}, diff.Added[0].Values)
+	}
+}
+
+func TestTrainingDatasetDiffService_DiffTrainingDatasets_FieldNameChanges(t *testing.T) {
+	service := &TrainingDatasetDiffService{}
+
+	from := &entities.TrainingDataset{
+		InputField: "question",
+		FieldNames: []string{"question", "answer"},
+		Data:       []entities.TrainingDataItem{diffTestItem("What is AI?", "Artificial Intelligence")},
+	}
+	to := &entities.TrainingDataset{
+		InputField: "question",
+		FieldNames: []string{"question", "answer", "category"},
+		Data:       []entities.TrainingDataItem{diffTestItem("What is AI?", "Artificial Intelligence", "basics")},
+	}
+
+	diff := service.DiffTrainingDatasets(from, to, from.Data, to.Data)
+
+	assert.Equal(t, []string{"category"}, diff.FieldNamesAdded)
+	assert.Empty(t, diff.FieldNamesRemoved)
+	assert.False(t, diff.FieldOrderChanged)
+	if assert.Len(t, diff.Modified, 1) {
+		assert.Equal(t, []string{"category"}, diff.Modified[0].ChangedFields)
+	}
+	assert.Empty(t, diff.Added)
+	assert.Empty(t, diff.Removed)
+}
+
+func TestTrainingDatasetDiffService_DiffTrainingDatasets_Conversation(t *testing.T) {
+	service := &TrainingDatasetDiffService{}
+	conversation := func(question string, answer string) entities.TrainingDataItem {
+		return entities.TrainingDataItem{ID: uuid.New(), Values: []string{}, Messages: []entities.ConversationMessage{
+			{Role: entities.ConversationMessageRoleUser, Content: question},
+			{Role: entities.ConversationMessageRoleAssistant, Content: answer},
+		}}
+	}
+
+	unchanged := conversation("Hi", "Hello")
+	removed := conversation("How do I reset my password?", "Click on 'Forgot password'.")
+	added := conversation("How do I delete my account?", "Open the account settings.")
+
+	from := &entities.TrainingDataset{Type: entities.TrainingDatasetTypeConversation, FieldNames: []string{}}
+	to := &entities.TrainingDataset{Type: entities.TrainingDatasetTypeConversation, FieldNames: []string{}}
+	fromItems := []entities.TrainingDataItem{unchanged, removed}
+	toItems := []entities.TrainingDataItem{conversation("Hi", "Hello"), added}
+
+	diff := service.DiffTrainingDatasets(from, to, fromItems, toItems)
+
+	assert.Equal(t, 1, diff.Unchanged)
+	if assert.Len(t, diff.Removed, 1) {
+		assert.Equal(t, removed.ID, diff.Removed[0].ID)
+	}
+	if assert.Len(t, diff.Added, 1) {
+		assert.Equal(t, added.ID, diff.Added[0].ID)
+	}
+	assert.Empty(t, diff.Modified)
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"errors"
+	"math"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+const (
+	// DefaultGenerationGPUPricePerHour is the GPU price when GENERATION_GPU_PRICE_PER_HOUR is not set
+	DefaultGenerationGPUPricePerHour = 0.79
+
+	// generationInstructionTokens covers the instructions BuildGenerationMessages adds around the prompt and schema
+	generationInstructionTokens = 60
+	// generationOutputOverhead accounts for the JSON syntax and the fields next to the output field of an example
+	generationOutputOverhead = 1.5
+	// defaultGenerationOutputTokensPerSecond is the throughput of all concurrent generation requests together, it is
+	// used until the project has finished generations to measure it
+	defaultGenerationOutputTokensPerSecond = 100.0
+)
+
+type TrainingDatasetEstimateService struct {
+	// DefaultGPUPricePerHour is used when the estimate is requested without a price
+	DefaultGPUPricePerHour float64
+}
+
+// EstimateTrainingDataset predicts the tokens, time and cost of a generation. The prediction starts from the
+// prompt, the expected output size and the corpus size, and is pulled towards the measured usage of earlier
+// generations, the more of them there are the stronger.
+func (s *TrainingDatasetEstimateService) EstimateTrainingDataset(input entities.TrainingDatasetEstimateInput, history []entities.TrainingDatasetGenerationHistory, gpuPricePerHour *float64) (*entities.TrainingDatasetEstimate, error) {
+	if input.GenerateExamplesNumber < 1 {
+		return nil, errors.New("generate examples number must be at least 1")
+	}
+	if input.ExpectedOutputSizeChars < 1 {
+		return nil, errors.New("expected output size must be at least 1")
+	}
+	price := s.DefaultGPUPricePerHour
+	if gpuPricePerHour != nil {
+		price = *gpuPricePerHour
+	}
+	if price < 0 {
+		return nil, errors.New("GPU price per hour cannot be negative")
+	}
+
+	chunks := 0
+	chunkTokens := 0
+	if input.CorpusSizeChars > 0 {
+		corpusTokens := int((input.CorpusSizeChars + ApproximateCharsPerToken - 1) / ApproximateCharsPerToken)
+		stride := max(input.Chunking.ChunkSizeTokens-input.Chunking.OverlapTokens, 1)
+		chunks = max((corpusTokens+stride-1)/stride, 1)
+		chunkTokens = corpusTokens
+		if input.Chunking.ChunkSizeTokens > 0 {
+			chunkTokens = min(input.Chunking.ChunkSizeTokens, corpusTokens)
+		}
+	}
+
+	promptChars := len(input.GeneratePrompt)
+	for fieldName, description := range input.JSONObjectFields {
+		promptChars += len(fieldName) + len(description)
+	}
+	requests := s.generationRequestsNumber(input.GenerateExamplesNumber, chunks)
+	requestTokensIn := promptChars/ApproximateCharsPerToken + generationInstructionTokens + chunkTokens
+
+	examples := float64(input.GenerateExamplesNumber)
+	exampleTokensOut := float64(input.ExpectedOutputSizeChars) / ApproximateCharsPerToken
+	tokensIn := float64(requests * requestTokensIn)
+	tokensOut := examples * exampleTokensOut * generationOutputOverhead
+	outputTokensPerSecond := defaultGenerationOutputTokensPerSecond
+
+	var historyExamples, historyTokensIn, historyTokensOut, historyExpectedTokensOut, historySeconds float64
+	historical := 0
+	for _, generation := range history {
+		if generation.ExamplesNumber <= 0 || generation.ExpectedOutputSizeChars <= 0 || generation.TokensOut <= 0 || generation.GenerationTimeSeconds <= 0 {
+			continue
+		}
+		historical++
+		historyExamples += float64(generation.ExamplesNumber)
+		historyTokensIn += float64(generation.TokensIn)
+		historyTokensOut += float64(generation.TokensOut)
+		historyExpectedTokensOut += float64(generation.ExamplesNumber*generation.ExpectedOutputSizeChars) / ApproximateCharsPerToken
+		historySeconds += generation.GenerationTimeSeconds
+	}
+	if historical > 0 {
+		weight := float64(historical) / float64(historical+1)
+		tokensIn = blend(tokensIn, historyTokensIn/historyExamples*examples, weight)
+		tokensOut = blend(tokensOut, examples*exampleTokensOut*historyTokensOut/historyExpectedTokensOut, weight)
+		outputTokensPerSecond = blend(outputTokensPerSecond, historyTokensOut/historySeconds, weight)
+	}
+
+	seconds := tokensOut / outputTokensPerSecond
+	return &entities.TrainingDatasetEstimate{
+		GenerationRequests:    requests,
+		TokensIn:              int(math.Round(tokensIn)),
+		TokensOut:             int(math.Round(tokensOut)),
+		GenerationTimeSeconds: seconds,
+		GPUPricePerHour:       price,
+		CostUSD:               seconds / 3600 * price,
+		HistoricalDatasets:    historical,
+	}, nil
+}
+
+// generationRequestsNumber counts the requests PlanGenerationRequests makes for the number of examples and chunks
+// without building the chunks
+func (s *TrainingDatasetEstimateService) generationRequestsNumber(examplesNumber, chunks int) int {
+	if chunks == 0 {
+		return (examplesNumber + MaxExamplesPerGenerationRequest - 1) / MaxExamplesPerGenerationRequest
+	}
+	selected := min(examplesNumber, chunks)
+	perChunk := min((examplesNumber+selected-1)/selected, MaxExamplesPerGenerationRequest)
+	return (examplesNumber + perChunk - 1) / perChunk
+}
+
+// blend moves the value towards the target by the weight between 0 and 1
+func blend(value, target, weight float64) float64 {
+	return value + (target-value)*weight
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func TestTrainingDatasetEstimateService_EstimateTrainingDataset(t *testing.T) {
+	service := &TrainingDatasetEstimateService{DefaultGPUPricePerHour: 2}
+
+	input := entities.TrainingDatasetEstimateInput{
+		GeneratePrompt:          "Write questions and answers about the text.",
+		JSONObjectFields:        map[string]string{"question": "A question", "answer": "The answer"},
+		ExpectedOutputSizeChars: 400,
+		GenerateExamplesNumber:  100,
+	}
+
+	t.Run("Without a corpus or history the defaults are used", func(t *testing.T) {
+		estimate, err := service.EstimateTrainingDataset(input, nil, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, 20, estimate.GenerationRequests)
+		// 100 examples of 400 characters with the output overhead
+		assert.Equal(t, 15000, estimate.TokensOut)
+		assert.InDelta(t, 150, estimate.GenerationTimeSeconds, 0.001)
+		assert.Equal(t, 2.0, estimate.GPUPricePerHour)
+		assert.InDelta(t, 150.0/3600*2, estimate.CostUSD, 0.0001)
+		assert.Equal(t, 0, estimate.HistoricalDatasets)
+	})
+
+	t.Run("A corpus adds a chunk to every request", func(t *testing.T) {
+		withCorpus := input
+		withCorpus.CorpusSizeChars = 4 * 448 * 200
+		withCorpus.Chunking = entities.CorpusChunkingConfig{ChunkSizeTokens: 512, OverlapTokens: 64}
+
+		withoutCorpus, err := service.EstimateTrainingDataset(input, nil, nil)
+		require.NoError(t, err)
+		estimate, err := service.EstimateTrainingDataset(withCorpus, nil, nil)
+		require.NoError(t, err)
+
+		// 200 chunks and 100 examples sample one example from 100 chunks
+		assert.Equal(t, 100, estimate.GenerationRequests)
+		assert.Greater(t, estimate.TokensIn, withoutCorpus.TokensIn+100*500)
+		assert.Equal(t, withoutCorpus.TokensOut, estimate.TokensOut)
+	})
+
+	t.Run("History pulls the estimate towards the measured usage", func(t *testing.T) {
+		history := []entities.TrainingDatasetGenerationHistory{
+			{ExamplesNumber: 50, ExpectedOutputSizeChars: 400, TokensIn: 10000, TokensOut: 10000, GenerationTimeSeconds: 500},
+			{ExamplesNumber: 50, ExpectedOutputSizeChars: 400, TokensIn: 10000, TokensOut: 10000, GenerationTimeSeconds: 500},
+			{ExamplesNumber: 0, TokensOut: 100, GenerationTimeSeconds: 1},
+		}
+
+		estimate, err := service.EstimateTrainingDataset(input, history, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, estimate.HistoricalDatasets)
+		// The history measured 200 output tokens per example instead of 150, weighted 2/3
+		assert.Equal(t, 18333, estimate.TokensOut)
+		// 20 requests of 79 tokens pulled towards the measured 200 input tokens per example
+		assert.Equal(t, 13860, estimate.TokensIn)
+		// The history measured 20 tokens per second instead of 100, weighted 2/3
+		assert.InDelta(t, 18333.33/(100+(20-100)*2.0/3), estimate.GenerationTimeSeconds, 0.5)
+	})
+
+	t.Run("The price of the request replaces the default", func(t *testing.T) {
+		price := 0.5
+		estimate, err := service.EstimateTrainingDataset(input, nil, &price)
+		require.NoError(t, err)
+		assert.Equal(t, 0.5, estimate.GPUPricePerHour)
+		assert.InDelta(t, 150.0/3600*0.5, estimate.CostUSD, 0.0001)
+	})
+
+	t.Run("Invalid input is rejected", func(t *testing.T) {
+		invalid := input
+		invalid.GenerateExamplesNumber = 0
+		_, err := service.EstimateTrainingDataset(invalid, nil, nil)
+		assert.EqualError(t, err, "generate examples number must be at least 1")
+
+		invalid = input
+		invalid.ExpectedOutputSizeChars = 0
+		_, err = service.EstimateTrainingDataset(invalid, nil, nil)
+		assert.EqualError(t, err, "expected output size must be at least 1")
+
+		price := -1.0
+		_, err = service.EstimateTrainingDataset(input, nil, &price)
+		assert.EqualError(t, err, "GPU price per hour cannot be negative")
+	})
+}
+
+func TestTrainingDatasetEstimateService_generationRequestsNumber(t *testing.T) {
+	service := &TrainingDatasetEstimateService{}
+	generationService := &TrainingDatasetGenerationService{}
+
+	for _, chunks := range []int{0, 1, 4, 30} {
+		for _, examples := range []int{1, 3, 7, 30, 101} {
+			t.Run(strconv.Itoa(chunks)+" chunks "+strconv.Itoa(examples)+" examples", func(t *testing.T) {
+				planned := generationService.PlanGenerationRequests(make([]entities.CorpusChunk, chunks), examples)
+				assert.Equal(t, len(planned), service.generationRequestsNumber(examples, chunks))
+			})
+		}
+	}
+}
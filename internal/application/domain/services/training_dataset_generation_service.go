@@ -0,0 +1,463 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/out/clients"
+)
+
+const (
+	// MaxExamplesPerGenerationRequest limits how many examples one LLM call is asked for
+	MaxExamplesPerGenerationRequest = 5
+	// GenerationBatchSize is the number of LLM calls the worker runs concurrently
+	GenerationBatchSize = 4
+
+	generationTemperature = 0.7
+	generationTopP        = 0.9
+)
+
+// TrainingDatasetGenerationRequest is one LLM call, Chunk is nil when the dataset is generated without a corpus
+type TrainingDatasetGenerationRequest struct {
+	Chunk          *entities.CorpusChunk
+	ExamplesNumber int
+}
+
+type TrainingDatasetGenerationService struct {
+	OllamaLLMClient clients.OllamaLLMClient
+}
+
+// PlanGenerationRequests spreads the requested number of examples over the chunks. With fewer examples than
+// chunks the chunks are sampled evenly, with more the chunks are visited again until the number is reached.
+func (s *TrainingDatasetGenerationService) PlanGenerationRequests(chunks []entities.CorpusChunk, examplesNumber int) []TrainingDatasetGenerationRequest {
+	requests := []TrainingDatasetGenerationRequest{}
+	if examplesNumber <= 0 {
+		return requests
+	}
+
+	if len(chunks) == 0 {
+		for remaining := examplesNumber; remaining > 0; remaining -= MaxExamplesPerGenerationRequest {
+			requests = append(requests, TrainingDatasetGenerationRequest{ExamplesNumber: min(remaining, MaxExamplesPerGenerationRequest)})
+		}
+		return requests
+	}
+
+	selected := chunks
+	if examplesNumber < len(chunks) {
+		selected = make([]entities.CorpusChunk, examplesNumber)
+		for i := range selected {
+			selected[i] = chunks[i*len(chunks)/examplesNumber]
+		}
+	}
+
+	perChunk := min((examplesNumber+len(selected)-1)/len(selected), MaxExamplesPerGenerationRequest)
+	remaining := examplesNumber
+	for remaining > 0 {
+		for i := range selected {
+			if remaining == 0 {
+				break
+			}
+			n := min(perChunk, remaining)
+			requests = append(requests, TrainingDatasetGenerationRequest{
+				Chunk:          &selected[i],
+				ExamplesNumber: n,
+			})
+			remaining -= n
+		}
+	}
+
+	return requests
+}
+
+// CompletedChunks returns the chunks the items were generated from, items without source offsets are left out
+func (s *TrainingDatasetGenerationService) CompletedChunks(items []entities.TrainingDataItem) []entities.TrainingDatasetJobChunk {
+	seen := map[entities.TrainingDatasetJobChunk]bool{}
+	completed := []entities.TrainingDatasetJobChunk{}
+	for _, item := range items {
+		if item.SourceDocument == nil || *item.SourceDocument == "" || item.SourceDocumentStart == nil || item.SourceDocumentEnd == nil {
+			continue
+		}
+		start, err := strconv.Atoi(*item.SourceDocumentStart)
+		if err != nil {
+			continue
+		}
+		end, err := strconv.Atoi(*item.SourceDocumentEnd)
+		if err != nil {
+			continue
+		}
+
+		chunk := entities.TrainingDatasetJobChunk{FileName: *item.SourceDocument, Start: start, End: end}
+		if !seen[chunk] {
+			seen[chunk] = true
+			completed = append(completed, chunk)
+		}
+	}
+	return completed
+}
+
+// RemainingChunks removes the chunks that already have results. When every chunk has results, all chunks are
+// returned, so the missing examples are still generated from the corpus.
+func (s *TrainingDatasetGenerationService) RemainingChunks(chunks []entities.CorpusChunk, skipChunks []entities.TrainingDatasetJobChunk) []entities.CorpusChunk {
+	skip := make(map[entities.TrainingDatasetJobChunk]bool, len(skipChunks))
+	for _, chunk := range skipChunks {
+		skip[chunk] = true
+	}
+
+	remaining := []entities.CorpusChunk{}
+	for _, chunk := range chunks {
+		if !skip[entities.TrainingDatasetJobChunk{FileName: chunk.FileName, Start: chunk.Start, End: chunk.End}] {
+			remaining = append(remaining, chunk)
+		}
+	}
+	if len(remaining) == 0 {
+		return chunks
+	}
+	return remaining
+}
+
+// OrderedFieldNames returns the field names of the dataset, or the sorted keys of the JSON object fields for jobs
+// without field names
+func (s *TrainingDatasetGenerationService) OrderedFieldNames(fieldNames []string, jsonObjectFields map[string]string) []string {
+	if len(fieldNames) > 0 {
+		return fieldNames
+	}
+
+	ordered := make([]string, 0, len(jsonObjectFields))
+	for fieldName := range jsonObjectFields {
+		ordered = append(ordered, fieldName)
+	}
+	sort.Strings(ordered)
+	return ordered
+}
+
+// BuildGenerationMessages creates the chat messages that ask for the examples of one request
+func (s *TrainingDatasetGenerationService) BuildGenerationMessages(job entities.TrainingDatasetJob, fieldNames []string, request TrainingDatasetGenerationRequest) []clients.ChatMessage {
+	var schema strings.Builder
+	schema.WriteString("{")
+	for i, fieldName := range fieldNames {
+		if i > 0 {
+			schema.WriteString(", ")
+		}
+		fieldNameJSON, _ := json.Marshal(fieldName)
+		descriptionJSON, _ := json.Marshal(job.JSONObjectFields[fieldName])
+		schema.Write(fieldNameJSON)
+		schema.WriteString(": ")
+		schema.Write(descriptionJSON)
+	}
+	schema.WriteString("}")
+
+	systemPrompt := job.GeneratePrompt
+	if job.LanguageISO != "" {
+		systemPrompt += fmt.Sprintf("\n\nWrite all examples in the language with the ISO 639-3 code %s.", job.LanguageISO)
+	}
+
+	var userPrompt strings.Builder
+	if request.Chunk != nil {
+		userPrompt.WriteString("Use the following text as the source of the examples.\n\nTEXT:\n")
+		userPrompt.WriteString(request.Chunk.Text)
+		userPrompt.WriteString("\n\n")
+	}
+	fmt.Fprintf(&userPrompt, "Generate %d examples. Answer only with a JSON array of %d objects in the following format, the values of the format describe the content of each field:\n%s", request.ExamplesNumber, request.ExamplesNumber, schema.String())
+	if job.ExpectedOutputSizeChars > 0 && job.OutputField != "" {
+		fmt.Fprintf(&userPrompt, "\n\nThe %s field should have about %d characters.", job.OutputField, job.ExpectedOutputSizeChars)
+	}
+	userPrompt.WriteString("\n\nJSON:")
+
+	return []clients.ChatMessage{
+		{Role: "system", Content: systemPrompt},
+		{Role: "user", Content: userPrompt.String()},
+	}
+}
+
+// ParseGeneratedExamples extracts the examples from the response of the LLM. The response may be an array of
+// objects, a single object or an object wrapping the array, optionally inside a code fence. Objects without all
+// field names are skipped.
+func (s *TrainingDatasetGenerationService) ParseGeneratedExamples(response string, fieldNames []string) ([]map[string]string, error) {
+	objects, err := generatedJSONObjects(response)
+	if err != nil {
+		return nil, err
+	}
+
+	examples := []map[string]string{}
+	for _, object := range objects {
+		fields, ok := object.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		example := make(map[string]string, len(fieldNames))
+		for _, fieldName := range fieldNames {
+			value, exists := fields[fieldName]
+			if !exists || value == nil {
+				example = nil
+				break
+			}
+			example[fieldName] = generatedFieldValue(value)
+		}
+		if example != nil {
+			examples = append(examples, example)
+		}
+	}
+
+	if len(examples) == 0 {
+		return nil, errors.New("response does not contain any example with all fields")
+	}
+
+	return examples, nil
+}
+
+// BuildConversationGenerationMessages creates the chat messages that ask for the conversations of one request. The
+// system prompt of the dataset describes the assistant, the generate prompt what the conversations are about.
+func (s *TrainingDatasetGenerationService) BuildConversationGenerationMessages(job entities.TrainingDatasetJob, request TrainingDatasetGenerationRequest) []clients.ChatMessage {
+	systemPrompt := job.GeneratePrompt
+	if job.LanguageISO != "" {
+		systemPrompt += fmt.Sprintf("\n\nWrite all conversations in the language with the ISO 639-3 code %s.", job.LanguageISO)
+	}
+
+	var userPrompt strings.Builder
+	if job.Conversation.SystemPrompt != "" {
+		userPrompt.WriteString("The assistant in the conversations follows this system prompt.\n\nSYSTEM PROMPT:\n")
+		userPrompt.WriteString(job.Conversation.SystemPrompt)
+		userPrompt.WriteString("\n\n")
+	}
+	if request.Chunk != nil {
+		userPrompt.WriteString("Use the following text as the source of the conversations.\n\nTEXT:\n")
+		userPrompt.WriteString(request.Chunk.Text)
+		userPrompt.WriteString("\n\n")
+	}
+	fmt.Fprintf(&userPrompt, "Generate %d conversations between a user and an assistant. Each conversation has %d turns, a turn is a user message followed by an assistant message. Answer only with a JSON array of %d objects in the following format:\n", request.ExamplesNumber, job.Conversation.Turns, request.ExamplesNumber)
+	userPrompt.WriteString(`{"messages": [{"role": "user", "content": "..."}, {"role": "assistant", "content": "..."}]}`)
+	if job.ExpectedOutputSizeChars > 0 {
+		fmt.Fprintf(&userPrompt, "\n\nEach assistant message should have about %d characters.", job.ExpectedOutputSizeChars)
+	}
+	userPrompt.WriteString("\n\nJSON:")
+
+	return []clients.ChatMessage{
+		{Role: "system", Content: systemPrompt},
+		{Role: "user", Content: userPrompt.String()},
+	}
+}
+
+// ParseGeneratedConversations extracts the conversations from the response of the LLM, in the same layouts as
+// ParseGeneratedExamples. System messages are dropped, conversations are cut after the requested number of turns and
+// conversations that do not alternate between user and assistant are skipped.
+func (s *TrainingDatasetGenerationService) ParseGeneratedConversations(response string, turns int) ([][]entities.ConversationMessage, error) {
+	objects, err := generatedJSONObjects(response)
+	if err != nil {
+		return nil, err
+	}
+
+	// A single conversation object is unwrapped to its messages, they are wrapped again
+	if len(objects) > 0 {
+		if first, ok := objects[0].(map[string]interface{}); ok && first["role"] != nil {
+			objects = []interface{}{map[string]interface{}{"messages": objects}}
+		}
+	}
+
+	conversations := [][]entities.ConversationMessage{}
+	for _, object := range objects {
+		fields, ok := object.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rawMessages, ok := fields["messages"].([]interface{})
+		if !ok {
+			continue
+		}
+
+		var messages []entities.ConversationMessage
+		for _, rawMessage := range rawMessages {
+			message, ok := rawMessage.(map[string]interface{})
+			if !ok {
+				messages = nil
+				break
+			}
+			role, _ := message["role"].(string)
+			if entities.ConversationMessageRole(role) == entities.ConversationMessageRoleSystem {
+				continue
+			}
+			expected := entities.ConversationMessageRoleUser
+			if len(messages)%2 == 1 {
+				expected = entities.ConversationMessageRoleAssistant
+			}
+			content := generatedFieldValue(message["content"])
+			if entities.ConversationMessageRole(role) != expected || message["content"] == nil || content == "" {
+				messages = nil
+				break
+			}
+			messages = append(messages, entities.ConversationMessage{Role: expected, Content: content})
+		}
+
+		// An unanswered user message at the end is dropped
+		messages = messages[:len(messages)-len(messages)%2]
+		if turns > 0 && len(messages) > 2*turns {
+			messages = messages[:2*turns]
+		}
+		if len(messages) > 0 {
+			conversations = append(conversations, messages)
+		}
+	}
+
+	if len(conversations) == 0 {
+		return nil, errors.New("response does not contain any conversation")
+	}
+
+	return conversations, nil
+}
+
+// GenerateExamples runs one request against the LLM and returns the examples as annotations of the chunk
+func (s *TrainingDatasetGenerationService) GenerateExamples(ctx context.Context, job entities.TrainingDatasetJob, fieldNames []string, request TrainingDatasetGenerationRequest) (*clients.TrainingDatasetResultsPart, error) {
+	if job.Conversation != nil {
+		return s.generateConversations(ctx, job, request)
+	}
+
+	messages := s.BuildGenerationMessages(job, fieldNames, request)
+
+	var maxTokens *int
+	if job.ExpectedOutputSizeChars > 0 {
+		// Leave room for the other fields and the JSON syntax
+		tokens := ApproximateTokenCount(2*job.ExpectedOutputSizeChars*request.ExamplesNumber) + 256
+		maxTokens = &tokens
+	}
+
+	startTime := time.Now()
+	result, err := s.OllamaLLMClient.GenerateChatCompletion(ctx, nil, messages, job.GenerateModel, maxTokens, generationTemperature, generationTopP)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+	generationTime := time.Since(startTime).Seconds()
+
+	examples, err := s.ParseGeneratedExamples(result.Response, fieldNames)
+	if err != nil {
+		return nil, err
+	}
+	if len(examples) > request.ExamplesNumber {
+		examples = examples[:request.ExamplesNumber]
+	}
+
+	part := &clients.TrainingDatasetResultsPart{
+		TotalGenerationTimeSeconds: generationTime,
+		TokensIn:                   result.TokensIn,
+		TokensOut:                  result.TokensOut,
+	}
+	for _, example := range examples {
+		annotation := clients.TrainingDatasetAnnotation{
+			InferenceTimeSeconds: generationTime / float64(len(examples)),
+			Fields:               example,
+		}
+		if request.Chunk != nil {
+			annotation.DocumentID = request.Chunk.FileName
+			annotation.WithinStart = request.Chunk.Start
+			annotation.WithinEnd = request.Chunk.End
+		}
+		part.Annotations = append(part.Annotations, annotation)
+	}
+
+	return part, nil
+}
+
+// generateConversations runs one request of a conversation dataset against the LLM
+func (s *TrainingDatasetGenerationService) generateConversations(ctx context.Context, job entities.TrainingDatasetJob, request TrainingDatasetGenerationRequest) (*clients.TrainingDatasetResultsPart, error) {
+	messages := s.BuildConversationGenerationMessages(job, request)
+
+	var maxTokens *int
+	if job.ExpectedOutputSizeChars > 0 {
+		// Every turn has an assistant message of the expected size and a user message, plus the JSON syntax
+		tokens := ApproximateTokenCount(2*job.ExpectedOutputSizeChars*job.Conversation.Turns*request.ExamplesNumber) + 256
+		maxTokens = &tokens
+	}
+
+	startTime := time.Now()
+	result, err := s.OllamaLLMClient.GenerateChatCompletion(ctx, nil, messages, job.GenerateModel, maxTokens, generationTemperature, generationTopP)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+	generationTime := time.Since(startTime).Seconds()
+
+	conversations, err := s.ParseGeneratedConversations(result.Response, job.Conversation.Turns)
+	if err != nil {
+		return nil, err
+	}
+	if len(conversations) > request.ExamplesNumber {
+		conversations = conversations[:request.ExamplesNumber]
+	}
+
+	part := &clients.TrainingDatasetResultsPart{
+		TotalGenerationTimeSeconds: generationTime,
+		TokensIn:                   result.TokensIn,
+		TokensOut:                  result.TokensOut,
+	}
+	for _, conversation := range conversations {
+		annotation := clients.TrainingDatasetAnnotation{
+			InferenceTimeSeconds: generationTime / float64(len(conversations)),
+			Fields:               map[string]string{},
+			Messages:             conversation,
+		}
+		if request.Chunk != nil {
+			annotation.DocumentID = request.Chunk.FileName
+			annotation.WithinStart = request.Chunk.Start
+			annotation.WithinEnd = request.Chunk.End
+		}
+		part.Annotations = append(part.Annotations, annotation)
+	}
+
+	return part, nil
+}
+
+// generatedJSONObjects finds the JSON in the response of the LLM and returns its objects. The response may be an
+// array, a single object or an object wrapping the array, optionally inside a code fence.
+func generatedJSONObjects(response string) ([]interface{}, error) {
+	start := strings.IndexAny(response, "[{")
+	if start == -1 {
+		return nil, errors.New("could not find valid JSON in response")
+	}
+	closing := "]"
+	if response[start] == '{' {
+		closing = "}"
+	}
+	end := strings.LastIndex(response, closing)
+	if end <= start {
+		return nil, errors.New("could not find valid JSON in response")
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(response[start:end+1]), &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+	}
+
+	var objects []interface{}
+	switch value := parsed.(type) {
+	case []interface{}:
+		objects = value
+	case map[string]interface{}:
+		objects = []interface{}{value}
+		// A single wrapping key like "examples" holds the array
+		if len(value) == 1 {
+			for _, wrapped := range value {
+				if array, ok := wrapped.([]interface{}); ok {
+					objects = array
+				}
+			}
+		}
+	}
+	return objects, nil
+}
+
+func generatedFieldValue(value interface{}) string {
+	switch typed := value.(type) {
+	case string:
+		return strings.TrimSpace(typed)
+	case float64, bool:
+		return fmt.Sprintf("%v", typed)
+	default:
+		encoded, _ := json.Marshal(typed)
+		return string(encoded)
+	}
+}
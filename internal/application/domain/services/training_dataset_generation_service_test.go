@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/port/out/clients"
+)
+
+func TestTrainingDatasetGenerationService_PlanGenerationRequests(t *testing.T) {
+	service := &TrainingDatasetGenerationService{}
+
+	chunks := make([]entities.CorpusChunk, 4)
+	for i := range chunks {
+		chunks[i] = entities.CorpusChunk{FileName: "doc.md", Index: i}
+	}
+
+	countExamples := func(requests []TrainingDatasetGenerationRequest) int {
+		total := 0
+		for _, request := range requests {
+			assert.LessOrEqual(t, request.ExamplesNumber, MaxExamplesPerGenerationRequest)
+			total += request.ExamplesNumber
+		}
+		return total
+	}
+
+	t.Run("Fewer examples than chunks samples the chunks evenly", func(t *testing.T) {
+		requests := service.PlanGenerationRequests(chunks, 2)
+		assert.Len(t, requests, 2)
+		assert.Equal(t, 0, requests[0].Chunk.Index)
+		assert.Equal(t, 2, requests[1].Chunk.Index)
+		assert.Equal(t, 2, countExamples(requests))
+	})
+
+	t.Run("More examples than chunks visits the chunks again", func(t *testing.T) {
+		requests := service.PlanGenerationRequests(chunks, 30)
+		assert.Equal(t, 30, countExamples(requests))
+		assert.Equal(t, 0, requests[4].Chunk.Index)
+	})
+
+	t.Run("No chunks generates without context", func(t *testing.T) {
+		requests := service.PlanGenerationRequests(nil, 12)
+		assert.Len(t, requests, 3)
+		assert.Nil(t, requests[0].Chunk)
+		assert.Equal(t, 12, countExamples(requests))
+	})
+
+	t.Run("No examples", func(t *testing.T) {
+		assert.Empty(t, service.PlanGenerationRequests(chunks, 0))
+	})
+}
+
+func TestTrainingDatasetGenerationService_CompletedChunks(t *testing.T) {
+	service := &TrainingDatasetGenerationService{}
+	document := "doc.md"
+	start, end := "0", "120"
+	invalid := "start"
+
+	items := []entities.TrainingDataItem{
+		{SourceDocument: &document, SourceDocumentStart: &start, SourceDocumentEnd: &end},
+		{SourceDocument: &document, SourceDocumentStart: &start, SourceDocumentEnd: &end},
+		{SourceDocument: &document, SourceDocumentStart: &invalid, SourceDocumentEnd: &end},
+		{},
+	}
+
+	assert.Equal(t, []entities.TrainingDatasetJobChunk{{FileName: "doc.md", Start: 0, End: 120}}, service.CompletedChunks(items))
+}
+
+func TestTrainingDatasetGenerationService_RemainingChunks(t *testing.T) {
+	service := &TrainingDatasetGenerationService{}
+	chunks := []entities.CorpusChunk{
+		{FileName: "doc.md", Index: 0, Start: 0, End: 120},
+		{FileName: "doc.md", Index: 1, Start: 100, End: 220},
+	}
+
+	remaining := service.RemainingChunks(chunks, []entities.TrainingDatasetJobChunk{{FileName: "doc.md", Start: 0, End: 120}})
+	if assert.Len(t, remaining, 1) {
+		assert.Equal(t, 1, remaining[0].Index)
+	}
+
+	assert.Len(t, service.RemainingChunks(chunks, nil), 2)
+
+	all := []entities.TrainingDatasetJobChunk{{FileName: "doc.md", Start: 0, End: 120}, {FileName: "doc.md", Start: 100, End: 220}}
+	assert.Len(t, service.RemainingChunks(chunks, all), 2)
+}
+
+func TestTrainingDatasetGenerationService_ParseGeneratedExamples(t *testing.T) {
+	service := &TrainingDatasetGenerationService{}
+	fieldNames := []string{"question", "answer"}
+
+	tests := []struct {
+		name     string
+		response string
+		want     []map[string]string
+		wantErr  string
+	}{
+		{
+			name:     "Array in code fence",
+			response: "```json\n[{\"question\": \"What is AI?\", \"answer\": \" Artificial Intelligence \"}]\n```",
+			want:     []map[string]string{{"question": "What is AI?", "answer": "Artificial Intelligence"}},
+		},
+		{
+			name:     "Single object",
+			response: `{"question": "Q", "answer": 42}`,
+			want:     []map[string]string{{"question": "Q", "answer": "42"}},
+		},
+		{
+			name:     "Wrapped array",
+			response: `{"examples": [{"question": "Q1", "answer": "A1"}, {"question": "Q2", "answer": "A2"}]}`,
+			want:     []map[string]string{{"question": "Q1", "answer": "A1"}, {"question": "Q2", "answer": "A2"}},
+		},
+		{
+			name:     "Incomplete objects are skipped",
+			response: `[{"question": "Q1"}, {"question": "Q2", "answer": "A2"}]`,
+			want:     []map[string]string{{"question": "Q2", "answer": "A2"}},
+		},
+		{name: "No complete object", response: `[{"question": "Q1"}]`, wantErr: "response does not contain any example with all fields"},
+		{name: "No JSON", response: "I cannot do that", wantErr: "could not find valid JSON in response"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			examples, err := service.ParseGeneratedExamples(tt.response, fieldNames)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, examples)
+			}
+		})
+	}
+}
+
+func TestTrainingDatasetGenerationService_GenerateExamples(t *testing.T) {
+	var sentMessages []clients.ChatMessage
+	service := &TrainingDatasetGenerationService{
+		OllamaLLMClient: &MockOllamaLLMClient{
+			GenerateChatCompletionFunc: func(ctx context.Context, finetuneID *string, messages []clients.ChatMessage, model string, maxTokens *int, temperature float64, topP float64) (*clients.OllamaLLMClientResult, error) {
+				sentMessages = messages
+				return &clients.OllamaLLMClientResult{
+					Response:  `[{"question": "Q1", "answer": "A1"}, {"question": "Q2", "answer": "A2"}, {"question": "Q3", "answer": "A3"}]`,
+					TokensIn:  100,
+					TokensOut: 40,
+				}, nil
+			},
+		},
+	}
+
+	job := entities.TrainingDatasetJob{
+		GeneratePrompt:   "Generate questions about the text.",
+		LanguageISO:      "deu",
+		GenerateModel:    "llama3",
+		JSONObjectFields: map[string]string{"question": "A question about the text", "answer": "The answer"},
+	}
+	chunk := &entities.CorpusChunk{FileName: "doc.md", Start: 10, End: 50, Text: "The source text."}
+
+	part, err := service.GenerateExamples(context.Background(), job, []string{"question", "answer"}, TrainingDatasetGenerationRequest{Chunk: chunk, ExamplesNumber: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 100, part.TokensIn)
+	assert.Equal(t, 40, part.TokensOut)
+	if assert.Len(t, part.Annotations, 2) {
+		assert.Equal(t, "doc.md", part.Annotations[0].DocumentID)
+		assert.Equal(t, 10, part.Annotations[0].WithinStart)
+		assert.Equal(t, 50, part.Annotations[0].WithinEnd)
+		assert.Equal(t, map[string]string{"question": "Q1", "answer": "A1"}, part.Annotations[0].Fields)
+	}
+
+	if assert.Len(t, sentMessages, 2) {
+		assert.True(t, strings.Contains(sentMessages[0].Content, "Generate questions about the text."))
+		assert.True(t, strings.Contains(sentMessages[0].Content, "deu"))
+		assert.True(t, strings.Contains(sentMessages[1].Content, "The source text."))
+		assert.True(t, strings.Contains(sentMessages[1].Content, `{"question": "A question about the text", "answer": "The answer"}`))
+	}
+}
+
+func TestTrainingDatasetGenerationService_ParseGeneratedConversations(t *testing.T) {
+	service := &TrainingDatasetGenerationService{}
+	user := func(content string) entities.ConversationMessage {
+		return entities.ConversationMessage{Role: entities.ConversationMessageRoleUser, Content: content}
+	}
+	assistant := func(content string) entities.ConversationMessage {
+		return entities.ConversationMessage{Role: entities.ConversationMessageRoleAssistant, Content: content}
+	}
+
+	tests := []struct {
+		name     string
+		response string
+		want     [][]entities.ConversationMessage
+		wantErr  string
+	}{
+		{
+			name:     "Array of conversations",
+			response: `[{"messages": [{"role": "user", "content": "Hi"}, {"role": "assistant", "content": " Hello "}]}, {"messages": [{"role": "user", "content": "Q"}, {"role": "assistant", "content": "A"}]}]`,
+			want:     [][]entities.ConversationMessage{{user("Hi"), assistant("Hello")}, {user("Q"), assistant("A")}},
+		},
+		{
+			name:     "Single conversation",
+			response: "```json\n{\"messages\": [{\"role\": \"user\", \"content\": \"Hi\"}, {\"role\": \"assistant\", \"content\": \"Hello\"}]}\n```",
+			want:     [][]entities.ConversationMessage{{user("Hi"), assistant("Hello")}},
+		},
+		{
+			name:     "System messages are dropped and extra turns are cut",
+			response: `[{"messages": [{"role": "system", "content": "S"}, {"role": "user", "content": "Q1"}, {"role": "assistant", "content": "A1"}, {"role": "user", "content": "Q2"}, {"role": "assistant", "content": "A2"}, {"role": "user", "content": "Q3"}, {"role": "assistant", "content": "A3"}]}]`,
+			want:     [][]entities.ConversationMessage{{user("Q1"), assistant("A1"), user("Q2"), assistant("A2")}},
+		},
+		{
+			name:     "An unanswered user message is dropped",
+			response: `[{"messages": [{"role": "user", "content": "Q1"}, {"role": "assistant", "content": "A1"}, {"role": "user", "content": "Q2"}]}]`,
+			want:     [][]entities.ConversationMessage{{user("Q1"), assistant("A1")}},
+		},
+		{
+			name:     "Conversations that do not alternate are skipped",
+			response: `[{"messages": [{"role": "user", "content": "Q1"}, {"role": "user", "content": "Q2"}]}, {"messages": [{"role": "user", "content": "Q"}, {"role": "assistant", "content": "A"}]}]`,
+			want:     [][]entities.ConversationMessage{{user("Q"), assistant("A")}},
+		},
+		{name: "No conversation", response: `[{"question": "Q1"}]`, wantErr: "response does not contain any conversation"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conversations, err := service.ParseGeneratedConversations(tt.response, 2)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, conversations)
+			}
+		})
+	}
+}
+
+func TestTrainingDatasetGenerationService_GenerateExamples_Conversation(t *testing.T) {
+	var sentMessages []clients.ChatMessage
+	service := &TrainingDatasetGenerationService{
+		OllamaLLMClient: &MockOllamaLLMClient{
+			GenerateChatCompletionFunc: func(ctx context.Context, finetuneID *string, messages []clients.ChatMessage, model string, maxTokens *int, temperature float64, topP float64) (*clients.OllamaLLMClientResult, error) {
+				sentMessages = messages
+				return &clients.OllamaLLMClientResult{
+					Response: `[{"messages": [{"role": "user", "content": "Q"}, {"role": "assistant", "content": "A"}]}]`,
+				}, nil
+			},
+		},
+	}
+
+	job := entities.TrainingDatasetJob{
+		GeneratePrompt: "Generate support conversations.",
+		GenerateModel:  "llama3",
+		Conversation:   &entities.ConversationConfig{SystemPrompt: "You are a support agent.", Turns: 3},
+	}
+	chunk := &entities.CorpusChunk{FileName: "doc.md", Start: 10, End: 50, Text: "The source text."}
+
+	part, err := service.GenerateExamples(context.Background(), job, nil, TrainingDatasetGenerationRequest{Chunk: chunk, ExamplesNumber: 2})
+	assert.NoError(t, err)
+	if assert.Len(t, part.Annotations, 1) {
+		assert.Equal(t, "doc.md", part.Annotations[0].DocumentID)
+		assert.Equal(t, []entities.ConversationMessage{
+			{Role: entities.ConversationMessageRoleUser, Content: "Q"},
+			{Role: entities.ConversationMessageRoleAssistant, Content: "A"},
+		}, part.Annotations[0].Messages)
+	}
+
+	if assert.Len(t, sentMessages, 2) {
+		assert.True(t, strings.Contains(sentMessages[0].Content, "Generate support conversations."))
+		assert.True(t, strings.Contains(sentMessages[1].Content, "You are a support agent."))
+		assert.True(t, strings.Contains(sentMessages[1].Content, "The source text."))
+		assert.True(t, strings.Contains(sentMessages[1].Content, "Each conversation has 3 turns"))
+	}
+}
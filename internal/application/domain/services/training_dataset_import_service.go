@@ -0,0 +1,451 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+// maxReportedImportErrors limits how many row errors are listed in a single error message
+const maxReportedImportErrors = 20
+
+// Optional keys that fill the source document fields of an item instead of its values
+var sourceDocumentImportKeys = map[string]bool{
+	"source_document":       true,
+	"source_document_start": true,
+	"source_document_end":   true,
+}
+
+type TrainingDatasetImportService struct{}
+
+// importRow is a single parsed row of an uploaded file, keyed by field name
+type importRow struct {
+	line   int
+	keys   []string
+	values map[string]string
+}
+
+type importRowError struct {
+	line    int
+	message string
+}
+
+// ParseTrainingDataItems parses an uploaded file into training data items.
+// If fieldNames is empty the field names are taken from the first row, otherwise every row is
+// validated against them. All row errors are collected and returned together with their line numbers.
+func (s *TrainingDatasetImportService) ParseTrainingDataItems(
+	format entities.TrainingDatasetImportFormat,
+	data []byte,
+	fieldNames []string,
+) ([]string, []entities.TrainingDataItem, error) {
+	var header []string
+	var rows []importRow
+	var rowErrors []importRowError
+	var err error
+
+	switch format {
+	case entities.TrainingDatasetImportFormatCSV:
+		header, rows, rowErrors, err = parseCSVRows(data)
+	case entities.TrainingDatasetImportFormatJSONL:
+		rows, rowErrors, err = parseJSONLRows(data)
+	case entities.TrainingDatasetImportFormatJSON:
+		rows, rowErrors, err = parseJSONArrayRows(data)
+	default:
+		return nil, nil, fmt.Errorf("unsupported import format: %s", format)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(fieldNames) == 0 {
+		keys := header
+		if keys == nil && len(rows) > 0 {
+			keys = rows[0].keys
+		}
+		for _, key := range keys {
+			if !sourceDocumentImportKeys[key] {
+				fieldNames = append(fieldNames, key)
+			}
+		}
+		if len(fieldNames) == 0 {
+			return nil, nil, errors.New("file does not contain any fields")
+		}
+	} else if header != nil {
+		// All CSV rows share the header, so it is validated once instead of for every row
+		if fieldErrors := validateImportKeys(header, fieldNames); len(fieldErrors) > 0 {
+			return nil, nil, fmt.Errorf("CSV header: %s", strings.Join(fieldErrors, ", "))
+		}
+	}
+
+	var items []entities.TrainingDataItem
+	for _, row := range rows {
+		if fieldErrors := validateImportKeys(row.keys, fieldNames); len(fieldErrors) > 0 {
+			rowErrors = append(rowErrors, importRowError{line: row.line, message: strings.Join(fieldErrors, ", ")})
+			continue
+		}
+
+		values := make([]string, len(fieldNames))
+		for i, fieldName := range fieldNames {
+			values[i] = row.values[fieldName]
+		}
+
+		now := time.Now()
+		items = append(items, entities.TrainingDataItem{
+			ID:                  uuid.New(),
+			Values:              values,
+			SourceDocument:      optionalImportValue(row.values, "source_document"),
+			SourceDocumentStart: optionalImportValue(row.values, "source_document_start"),
+			SourceDocumentEnd:   optionalImportValue(row.values, "source_document_end"),
+			Deleted:             false,
+			CreatedAt:           now,
+			UpdatedAt:           now,
+		})
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, nil, importRowsError(rowErrors)
+	}
+
+	return fieldNames, items, nil
+}
+
+// ParseConversationItems parses an uploaded JSONL or JSON file into the items of a conversation dataset. Every row
+// has a "messages" list and optionally the source document keys, directly or in a "metadata" object like the
+// conversation export writes them. All row errors are collected and returned together with their line numbers.
+func (s *TrainingDatasetImportService) ParseConversationItems(
+	format entities.TrainingDatasetImportFormat,
+	data []byte,
+) ([]entities.TrainingDataItem, error) {
+	var rows []importRow
+	var rowErrors []importRowError
+	var err error
+
+	switch format {
+	case entities.TrainingDatasetImportFormatJSONL:
+		rows, rowErrors, err = parseJSONLRows(data)
+	case entities.TrainingDatasetImportFormatJSON:
+		rows, rowErrors, err = parseJSONArrayRows(data)
+	default:
+		return nil, fmt.Errorf("unsupported import format for conversation datasets: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var items []entities.TrainingDataItem
+	for _, row := range rows {
+		messages, source, err := parseConversationRow(row)
+		if err != nil {
+			rowErrors = append(rowErrors, importRowError{line: row.line, message: err.Error()})
+			continue
+		}
+
+		now := time.Now()
+		items = append(items, entities.TrainingDataItem{
+			ID:                  uuid.New(),
+			Values:              []string{},
+			Messages:            messages,
+			SourceDocument:      optionalImportValue(source, "source_document"),
+			SourceDocumentStart: optionalImportValue(source, "source_document_start"),
+			SourceDocumentEnd:   optionalImportValue(source, "source_document_end"),
+			Deleted:             false,
+			CreatedAt:           now,
+			UpdatedAt:           now,
+		})
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, importRowsError(rowErrors)
+	}
+
+	return items, nil
+}
+
+// parseConversationRow returns the validated messages of a row and the keys that describe its source document
+func parseConversationRow(row importRow) ([]entities.ConversationMessage, map[string]string, error) {
+	source := make(map[string]string)
+	var fieldErrors []string
+	for _, key := range row.keys {
+		switch {
+		case key == "messages":
+		case key == "metadata":
+			var metadata map[string]interface{}
+			if err := json.Unmarshal([]byte(row.values[key]), &metadata); err != nil {
+				fieldErrors = append(fieldErrors, "field 'metadata' must be an object")
+				continue
+			}
+			for metadataKey, value := range metadata {
+				if sourceDocumentImportKeys[metadataKey] && value != nil {
+					source[metadataKey] = fmt.Sprintf("%v", value)
+				}
+			}
+		case sourceDocumentImportKeys[key]:
+			source[key] = row.values[key]
+		default:
+			fieldErrors = append(fieldErrors, fmt.Sprintf("unexpected field '%s'", key))
+		}
+	}
+
+	rawMessages, exists := row.values["messages"]
+	if !exists {
+		fieldErrors = append(fieldErrors, "missing field 'messages'")
+	}
+	if len(fieldErrors) > 0 {
+		return nil, nil, errors.New(strings.Join(fieldErrors, ", "))
+	}
+
+	var messages []entities.ConversationMessage
+	if err := json.Unmarshal([]byte(rawMessages), &messages); err != nil {
+		return nil, nil, errors.New("field 'messages' must be a list of objects with a role and a content")
+	}
+	if err := validateConversationMessages(messages); err != nil {
+		return nil, nil, err
+	}
+
+	return messages, source, nil
+}
+
+// validateImportKeys compares the keys of a row with the expected field names.
+// The optional source document keys are always allowed.
+func validateImportKeys(keys []string, fieldNames []string) []string {
+	expected := make(map[string]bool, len(fieldNames))
+	for _, fieldName := range fieldNames {
+		expected[fieldName] = true
+	}
+	present := make(map[string]bool, len(keys))
+
+	var fieldErrors []string
+	for _, key := range keys {
+		present[key] = true
+		if !expected[key] && !sourceDocumentImportKeys[key] {
+			fieldErrors = append(fieldErrors, fmt.Sprintf("unexpected field '%s'", key))
+		}
+	}
+	for _, fieldName := range fieldNames {
+		if !present[fieldName] {
+			fieldErrors = append(fieldErrors, fmt.Sprintf("missing field '%s'", fieldName))
+		}
+	}
+	return fieldErrors
+}
+
+func parseCSVRows(data []byte) ([]string, []importRow, []importRowError, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	// Column counts are validated per row so that all bad rows can be reported
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, nil, errors.New("CSV file is empty")
+	}
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+
+	var rows []importRow
+	var rowErrors []importRowError
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to parse CSV: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(record) != len(header) {
+			rowErrors = append(rowErrors, importRowError{line: line, message: fmt.Sprintf("has %d columns but expected %d columns", len(record), len(header))})
+			continue
+		}
+
+		values := make(map[string]string, len(header))
+		for i, key := range header {
+			values[key] = record[i]
+		}
+		rows = append(rows, importRow{line: line, keys: header, values: values})
+	}
+
+	return header, rows, rowErrors, nil
+}
+
+func parseJSONLRows(data []byte) ([]importRow, []importRowError, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	var rows []importRow
+	var rowErrors []importRowError
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		keys, values, err := parseImportObject(text)
+		if err != nil {
+			rowErrors = append(rowErrors, importRowError{line: line, message: err.Error()})
+			continue
+		}
+		rows = append(rows, importRow{line: line, keys: keys, values: values})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read JSONL: %w", err)
+	}
+
+	if len(rows) == 0 && len(rowErrors) == 0 {
+		return nil, nil, errors.New("JSONL file is empty")
+	}
+
+	return rows, rowErrors, nil
+}
+
+func parseJSONArrayRows(data []byte) ([]importRow, []importRowError, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := decoder.Token()
+	if err == io.EOF {
+		return nil, nil, errors.New("JSON file is empty")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, nil, errors.New("JSON file must contain an array of objects")
+	}
+
+	var rows []importRow
+	var rowErrors []importRowError
+	for decoder.More() {
+		var raw json.RawMessage
+		offset := decoder.InputOffset()
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse JSON: %w", err)
+		}
+		line := lineAtOffset(data, offset)
+
+		keys, values, err := parseImportObject(raw)
+		if err != nil {
+			rowErrors = append(rowErrors, importRowError{line: line, message: err.Error()})
+			continue
+		}
+		rows = append(rows, importRow{line: line, keys: keys, values: values})
+	}
+
+	return rows, rowErrors, nil
+}
+
+// parseImportObject decodes a single JSON object while keeping the order of its keys.
+// Nested values are kept as compact JSON so they survive the round trip into a string value.
+func parseImportObject(data []byte) ([]string, map[string]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, nil, errors.New("expected a JSON object")
+	}
+
+	var keys []string
+	values := make(map[string]string)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+		key := token.(string)
+
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+
+		value, err := importValueToString(raw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid value for field '%s': %w", key, err)
+		}
+
+		if _, exists := values[key]; !exists {
+			keys = append(keys, key)
+		}
+		values[key] = value
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	return keys, values, nil
+}
+
+func importValueToString(raw json.RawMessage) (string, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if bytes.Equal(trimmed, []byte("null")) {
+		return "", nil
+	}
+
+	if len(trimmed) > 0 && trimmed[0] == '"' {
+		var value string
+		if err := json.Unmarshal(trimmed, &value); err != nil {
+			return "", err
+		}
+		return value, nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, trimmed); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func optionalImportValue(values map[string]string, key string) *string {
+	value, ok := values[key]
+	if !ok || value == "" {
+		return nil
+	}
+	return &value
+}
+
+// lineAtOffset returns the line number of the first non-whitespace character at or after offset
+func lineAtOffset(data []byte, offset int64) int {
+	for int(offset) < len(data) && strings.ContainsRune(" \t\r\n,", rune(data[offset])) {
+		offset++
+	}
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+func importRowsError(rowErrors []importRowError) error {
+	sort.SliceStable(rowErrors, func(i, j int) bool {
+		return rowErrors[i].line < rowErrors[j].line
+	})
+
+	var reported []string
+	for _, rowError := range rowErrors {
+		if len(reported) == maxReportedImportErrors {
+			break
+		}
+		reported = append(reported, fmt.Sprintf("line %d: %s", rowError.line, rowError.message))
+	}
+
+	message := fmt.Sprintf("found %d invalid rows: %s", len(rowErrors), strings.Join(reported, "; "))
+	if len(rowErrors) > len(reported) {
+		message += fmt.Sprintf("; and %d more", len(rowErrors)-len(reported))
+	}
+	return errors.New(message)
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func TestTrainingDatasetImportService_ParseTrainingDataItems_CSV(t *testing.T) {
+	service := &TrainingDatasetImportService{}
+
+	data := []byte("question,answer\nWhat is AI?,\"Artificial\nIntelligence\"\n")
+
+	fieldNames, items, err := service.ParseTrainingDataItems(entities.TrainingDatasetImportFormatCSV, data, []string{"question", "answer"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"question", "answer"}, fieldNames)
+	if assert.Len(t, items, 1) {
+		assert.Equal(t, []string{"What is AI?", "Artificial\nIntelligence"}, items[0].Values)
+	}
+}
+
+func TestTrainingDatasetImportService_ParseTrainingDataItems_CSVHeaderMismatch(t *testing.T) {
+	service := &TrainingDatasetImportService{}
+
+	data := []byte("question,reply\nWhat is AI?,Artificial Intelligence\n")
+
+	_, _, err := service.ParseTrainingDataItems(entities.TrainingDatasetImportFormatCSV, data, []string{"question", "answer"})
+	assert.EqualError(t, err, "CSV header: unexpected field 'reply', missing field 'answer'")
+}
+
+func TestTrainingDatasetImportService_ParseTrainingDataItems_JSONL(t *testing.T) {
+	service := &TrainingDatasetImportService{}
+
+	data := []byte(`{"answer": {"text": "Artificial Intelligence"}, "question": "What is AI?", "source_document": "doc.txt", "source_document_start": 10}
+
+{"question": "What is ML?", "answer": "Machine\nLearning"}
+`)
+
+	fieldNames, items, err := service.ParseTrainingDataItems(entities.TrainingDatasetImportFormatJSONL, data, []string{"question", "answer"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"question", "answer"}, fieldNames)
+	if assert.Len(t, items, 2) {
+		assert.Equal(t, []string{"What is AI?", `{"text":"Artificial Intelligence"}`}, items[0].Values)
+		if assert.NotNil(t, items[0].SourceDocument) && assert.NotNil(t, items[0].SourceDocumentStart) {
+			assert.Equal(t, "doc.txt", *items[0].SourceDocument)
+			assert.Equal(t, "10", *items[0].SourceDocumentStart)
+		}
+		assert.Nil(t, items[0].SourceDocumentEnd)
+		assert.Equal(t, []string{"What is ML?", "Machine\nLearning"}, items[1].Values)
+	}
+}
+
+func TestTrainingDatasetImportService_ParseTrainingDataItems_JSONFieldNamesFromKeys(t *testing.T) {
+	service := &TrainingDatasetImportService{}
+
+	data := []byte(`[
+  {"question": "What is AI?", "answer": "Artificial Intelligence"},
+  {"question": "What is ML?", "answer": "Machine Learning"}
+]`)
+
+	fieldNames, items, err := service.ParseTrainingDataItems(entities.TrainingDatasetImportFormatJSON, data, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"question", "answer"}, fieldNames)
+	assert.Len(t, items, 2)
+}
+
+func TestTrainingDatasetImportService_ParseTrainingDataItems_ReportsAllRowErrors(t *testing.T) {
+	service := &TrainingDatasetImportService{}
+
+	data := []byte(`[
+  {"question": "What is AI?", "answer": "Artificial Intelligence"},
+  {"question": "What is ML?"},
+  "not an object",
+  {"question": "What is DL?", "answer": "Deep Learning", "category": "AI"}
+]`)
+
+	_, _, err := service.ParseTrainingDataItems(entities.TrainingDatasetImportFormatJSON, data, []string{"question", "answer"})
+	assert.EqualError(t, err, "found 3 invalid rows: line 3: missing field 'answer'; line 4: expected a JSON object; line 5: unexpected field 'category'")
+}
+
+func TestTrainingDatasetImportService_ParseTrainingDataItems_UnsupportedFormat(t *testing.T) {
+	service := &TrainingDatasetImportService{}
+
+	_, _, err := service.ParseTrainingDataItems(entities.TrainingDatasetImportFormat("xml"), []byte("<data/>"), nil)
+	assert.EqualError(t, err, "unsupported import format: xml")
+}
+
+func TestTrainingDatasetImportService_ParseConversationItems(t *testing.T) {
+	service := &TrainingDatasetImportService{}
+
+	data := []byte(`{"messages": [{"role": "system", "content": "You are helpful."}, {"role": "user", "content": "Hi"}, {"role": "assistant", "content": "Hello"}], "metadata": {"source_document": "doc.txt", "source_document_start": "10"}}
+{"messages": [{"role": "user", "content": "Q"}, {"role": "assistant", "content": "A"}], "source_document": "other.txt"}
+`)
+
+	items, err := service.ParseConversationItems(entities.TrainingDatasetImportFormatJSONL, data)
+	assert.NoError(t, err)
+	if assert.Len(t, items, 2) {
+		assert.Equal(t, []entities.ConversationMessage{
+			{Role: entities.ConversationMessageRoleSystem, Content: "You are helpful."},
+			{Role: entities.ConversationMessageRoleUser, Content: "Hi"},
+			{Role: entities.ConversationMessageRoleAssistant, Content: "Hello"},
+		}, items[0].Messages)
+		assert.Empty(t, items[0].Values)
+		assert.Equal(t, "doc.txt", *items[0].SourceDocument)
+		assert.Equal(t, "10", *items[0].SourceDocumentStart)
+		assert.Equal(t, "other.txt", *items[1].SourceDocument)
+	}
+}
+
+func TestTrainingDatasetImportService_ParseConversationItems_ReportsAllRowErrors(t *testing.T) {
+	service := &TrainingDatasetImportService{}
+
+	data := []byte(`[
+  {"messages": [{"role": "user", "content": "Q"}, {"role": "assistant", "content": "A"}]},
+  {"question": "What is ML?"},
+  {"messages": [{"role": "user", "content": "Q"}]},
+  {"messages": "Q"}
+]`)
+
+	_, err := service.ParseConversationItems(entities.TrainingDatasetImportFormatJSON, data)
+	assert.EqualError(t, err, "found 3 invalid rows: line 3: unexpected field 'question', missing field 'messages'; line 4: messages must contain at least one user and one assistant message; line 5: field 'messages' must be a list of objects with a role and a content")
+
+	_, err = service.ParseConversationItems(entities.TrainingDatasetImportFormatCSV, []byte("messages\n"))
+	assert.EqualError(t, err, "unsupported import format for conversation datasets: csv")
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type TrainingDatasetProgressService struct{}
+
+// ValidateProgress checks the values reported by the runner
+func (s *TrainingDatasetProgressService) ValidateProgress(progress *entities.TrainingDatasetProgress) error {
+	if progress.ItemsGenerated < 0 || progress.ChunksProcessed < 0 || progress.TokensIn < 0 || progress.TokensOut < 0 {
+		return errors.New("progress values must not be negative")
+	}
+	if progress.ChunksTotal != nil {
+		if *progress.ChunksTotal < 0 {
+			return errors.New("progress values must not be negative")
+		}
+		if progress.ChunksProcessed > *progress.ChunksTotal {
+			return errors.New("chunks processed must not exceed chunks total")
+		}
+	}
+	if progress.EtaSeconds != nil && *progress.EtaSeconds < 0 {
+		return errors.New("progress values must not be negative")
+	}
+	return nil
+}
+
+// IsProgressReportable tells if the generation of a dataset with the given status can still report progress
+func (s *TrainingDatasetProgressService) IsProgressReportable(status entities.TrainingDatasetStatus) bool {
+	return status == entities.TrainingDatasetStatusPlanning || status == entities.TrainingDatasetStatusRunning
+}
+
+// CompletedFraction is the share of the requested examples that are generated, between 0 and 1. Without a number
+// of requested examples the processed chunks are used.
+func (s *TrainingDatasetProgressService) CompletedFraction(progress *entities.TrainingDatasetProgress, examplesNumber int) float64 {
+	if progress == nil {
+		return 0
+	}
+
+	fraction := 0.0
+	if examplesNumber > 0 {
+		fraction = float64(progress.ItemsGenerated) / float64(examplesNumber)
+	} else if progress.ChunksTotal != nil && *progress.ChunksTotal > 0 {
+		fraction = float64(progress.ChunksProcessed) / float64(*progress.ChunksTotal)
+	}
+
+	return min(max(fraction, 0), 1)
+}
+
+// EstimateRemainingSeconds extrapolates the time since the start of the run to the remaining examples, it returns
+// nil until there is something to extrapolate from
+func (s *TrainingDatasetProgressService) EstimateRemainingSeconds(progress *entities.TrainingDatasetProgress, examplesNumber int, now time.Time) *float64 {
+	fraction := s.CompletedFraction(progress, examplesNumber)
+	if fraction == 0 || progress.StartedAt.IsZero() {
+		return nil
+	}
+
+	elapsed := now.Sub(progress.StartedAt).Seconds()
+	if elapsed <= 0 {
+		return nil
+	}
+
+	remaining := elapsed * (1 - fraction) / fraction
+	return &remaining
+}
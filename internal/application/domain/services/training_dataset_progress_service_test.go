@@ -0,0 +1,61 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func TestTrainingDatasetProgressService_ValidateProgress(t *testing.T) {
+	service := &TrainingDatasetProgressService{}
+	chunksTotal := 10
+	negativeEta := -1.0
+
+	tests := []struct {
+		name     string
+		progress entities.TrainingDatasetProgress
+		wantErr  string
+	}{
+		{name: "Valid", progress: entities.TrainingDatasetProgress{ItemsGenerated: 5, ChunksProcessed: 3, ChunksTotal: &chunksTotal}},
+		{name: "Negative items", progress: entities.TrainingDatasetProgress{ItemsGenerated: -1}, wantErr: "progress values must not be negative"},
+		{name: "Negative ETA", progress: entities.TrainingDatasetProgress{EtaSeconds: &negativeEta}, wantErr: "progress values must not be negative"},
+		{name: "Too many chunks", progress: entities.TrainingDatasetProgress{ChunksProcessed: 11, ChunksTotal: &chunksTotal}, wantErr: "chunks processed must not exceed chunks total"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.ValidateProgress(&tt.progress)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTrainingDatasetProgressService_CompletedFraction(t *testing.T) {
+	service := &TrainingDatasetProgressService{}
+	chunksTotal := 8
+
+	assert.Equal(t, 0.0, service.CompletedFraction(nil, 100))
+	assert.Equal(t, 0.25, service.CompletedFraction(&entities.TrainingDatasetProgress{ItemsGenerated: 25}, 100))
+	assert.Equal(t, 1.0, service.CompletedFraction(&entities.TrainingDatasetProgress{ItemsGenerated: 120}, 100))
+	assert.Equal(t, 0.5, service.CompletedFraction(&entities.TrainingDatasetProgress{ChunksProcessed: 4, ChunksTotal: &chunksTotal}, 0))
+}
+
+func TestTrainingDatasetProgressService_EstimateRemainingSeconds(t *testing.T) {
+	service := &TrainingDatasetProgressService{}
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	progress := &entities.TrainingDatasetProgress{ItemsGenerated: 25, StartedAt: now.Add(-time.Minute)}
+	eta := service.EstimateRemainingSeconds(progress, 100, now)
+	if assert.NotNil(t, eta) {
+		assert.InDelta(t, 180, *eta, 0.001)
+	}
+
+	assert.Nil(t, service.EstimateRemainingSeconds(&entities.TrainingDatasetProgress{StartedAt: now.Add(-time.Minute)}, 100, now))
+}
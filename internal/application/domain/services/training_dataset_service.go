@@ -1,10 +1,15 @@
 package services
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,6 +18,9 @@ import (
 	"ai-platform/internal/application/domain/entities"
 )
 
+// MaxConversationTurns limits the user and assistant message pairs of a generated conversation
+const MaxConversationTurns = 10
+
 type TrainingDatasetService struct{}
 
 
@@ -36,6 +44,7 @@ func (s *TrainingDatasetService) CreateTrainingDataset(
 		ID:                       uuid.New(),
 		ProjectID:                projectID,
 		Version:                  1,
+		Type:                     entities.TrainingDatasetTypeRecords,
 		InputField:               inputField,
 		OutputField:              outputField,
 		JSONObjectFields:         jsonObjectFields,
@@ -53,6 +62,110 @@ func (s *TrainingDatasetService) CreateTrainingDataset(
 	return trainingDataset, nil
 }
 
+// CreateConversationTrainingDataset creates a dataset whose items are conversations, it has no field names and
+// its items have messages instead of values
+func (s *TrainingDatasetService) CreateConversationTrainingDataset(
+	projectID uuid.UUID,
+	corpusID *uuid.UUID,
+	promptID uuid.UUID,
+	conversation entities.ConversationConfig,
+	expectedOutputSizeChars int,
+	languageISO string,
+	generateExamplesNumber int,
+) (*entities.TrainingDataset, error) {
+	if err := s.ValidateConversationConfig(conversation); err != nil {
+		return nil, err
+	}
+	if languageISO == "" {
+		return nil, errors.New("language_iso is required")
+	}
+	if len(languageISO) != 3 {
+		return nil, errors.New("language_iso must be a 3-letter ISO code")
+	}
+
+	return &entities.TrainingDataset{
+		ID:                       uuid.New(),
+		ProjectID:                projectID,
+		Version:                  1,
+		Type:                     entities.TrainingDatasetTypeConversation,
+		Conversation:             &conversation,
+		JSONObjectFields:         map[string]string{},
+		ExpectedOutputSizeChars:  expectedOutputSizeChars,
+		GeneratePromptHistoryIDs: []uuid.UUID{},
+		GeneratePromptID:         promptID,
+		CorpusID:                 corpusID,
+		LanguageISO:              languageISO,
+		Status:                   entities.TrainingDatasetStatusPlanning,
+		FieldNames:               []string{},
+		GenerateExamplesNumber:   generateExamplesNumber,
+		Data:                     []entities.TrainingDataItem{},
+	}, nil
+}
+
+func (s *TrainingDatasetService) ValidateConversationConfig(conversation entities.ConversationConfig) error {
+	if conversation.Turns < 1 {
+		return errors.New("conversation turns must be at least 1")
+	}
+	if conversation.Turns > MaxConversationTurns {
+		return fmt.Errorf("conversation turns must be at most %d", MaxConversationTurns)
+	}
+	return nil
+}
+
+// ValidateConversationMessages checks that the messages form a conversation: an optional system message first,
+// then user and assistant messages taking turns, starting with the user and ending with the assistant
+func (s *TrainingDatasetService) ValidateConversationMessages(messages []entities.ConversationMessage) error {
+	return validateConversationMessages(messages)
+}
+
+// ValidateTrainingDataItemEdit checks the values of a record or the messages of a conversation, depending on the
+// type of the dataset
+func (s *TrainingDatasetService) ValidateTrainingDataItemEdit(trainingDataset *entities.TrainingDataset, values []string, messages []entities.ConversationMessage) error {
+	if trainingDataset.Type == entities.TrainingDatasetTypeConversation {
+		if len(values) > 0 {
+			return errors.New("values are not supported for conversation datasets, edit the messages")
+		}
+		return s.ValidateConversationMessages(messages)
+	}
+	if len(messages) > 0 {
+		return errors.New("messages are only supported for conversation datasets")
+	}
+	return s.ValidateTrainingDataItemValues(values, trainingDataset.FieldNames)
+}
+
+// ConversationMessages returns the messages of an item with the system prompt of the dataset in front, unless the
+// item has its own system message
+func (s *TrainingDatasetService) ConversationMessages(trainingDataset *entities.TrainingDataset, item entities.TrainingDataItem) []entities.ConversationMessage {
+	if len(item.Messages) > 0 && item.Messages[0].Role == entities.ConversationMessageRoleSystem {
+		return item.Messages
+	}
+	if trainingDataset.Conversation == nil || trainingDataset.Conversation.SystemPrompt == "" {
+		return item.Messages
+	}
+
+	messages := make([]entities.ConversationMessage, 0, len(item.Messages)+1)
+	messages = append(messages, entities.ConversationMessage{Role: entities.ConversationMessageRoleSystem, Content: trainingDataset.Conversation.SystemPrompt})
+	return append(messages, item.Messages...)
+}
+
+// ConversationInputOutput splits a conversation into the transcript before its last assistant message and that
+// message, so a conversation can be judged like an input and an output
+func (s *TrainingDatasetService) ConversationInputOutput(messages []entities.ConversationMessage) (string, string) {
+	last := len(messages) - 1
+	for last >= 0 && messages[last].Role != entities.ConversationMessageRoleAssistant {
+		last--
+	}
+	if last < 0 {
+		return "", ""
+	}
+
+	transcript := make([]string, 0, last)
+	for _, message := range messages[:last] {
+		transcript = append(transcript, fmt.Sprintf("%s: %s", message.Role, message.Content))
+	}
+	return strings.Join(transcript, "\n"), messages[last].Content
+}
+
 func (s *TrainingDatasetService) ValidateCreateTrainingDatasetRequest(
 	inputField string,
 	outputField string,
@@ -114,17 +227,32 @@ func (s *TrainingDatasetService) GetNextVersion(projectID uuid.UUID, getLatest f
 	return latest.Version + 1, nil
 }
 
+// ValidateMinimumQualityScore checks that a minimum score filter is within the range of the judge scores
+func (s *TrainingDatasetService) ValidateMinimumQualityScore(minimumScore *float64) error {
+	if minimumScore != nil && (*minimumScore < MinQualityScore || *minimumScore > MaxQualityScore) {
+		return fmt.Errorf("minimum quality score must be between %d and %d", MinQualityScore, MaxQualityScore)
+	}
+	return nil
+}
+
 func (s *TrainingDatasetService) SelectTrainingDataSubset(
 	trainingData []entities.TrainingDataItem,
 	numberExamples *int,
 	selectRandom bool,
+	minimumQualityScore *float64,
 ) []entities.TrainingDataItem {
-	// Filter out deleted items
-	var availableData []entities.TrainingDataItem
-	for _, item := range trainingData {
-		if !item.Deleted {
-			availableData = append(availableData, item)
+	// Filter out deleted and corrected items
+	availableData := s.ActiveTrainingDataItems(trainingData)
+
+	// Unscored items are left out as soon as a minimum score is requested
+	if minimumQualityScore != nil {
+		var scoredData []entities.TrainingDataItem
+		for _, item := range availableData {
+			if item.QualityScore != nil && *item.QualityScore >= *minimumQualityScore {
+				scoredData = append(scoredData, item)
+			}
 		}
+		availableData = scoredData
 	}
 
 	// If no number specified or number is greater than available, return all
@@ -156,6 +284,271 @@ func (s *TrainingDatasetService) SelectTrainingDataSubset(
 	}
 }
 
+// ActiveTrainingDataItems returns the items that are not deleted and not replaced by a correction.
+// A correction only replaces the original item as long as the correction itself is not deleted.
+func (s *TrainingDatasetService) ActiveTrainingDataItems(trainingData []entities.TrainingDataItem) []entities.TrainingDataItem {
+	correctedIDs := make(map[uuid.UUID]bool)
+	for _, item := range trainingData {
+		if !item.Deleted && item.CorrectsID != nil {
+			correctedIDs[*item.CorrectsID] = true
+		}
+	}
+
+	var activeData []entities.TrainingDataItem
+	for _, item := range trainingData {
+		if !item.Deleted && !correctedIDs[item.ID] {
+			activeData = append(activeData, item)
+		}
+	}
+
+	return activeData
+}
+
+// EncodeTrainingDataItemCursor returns an opaque cursor that points after the given item
+func (s *TrainingDatasetService) EncodeTrainingDataItemCursor(item *entities.TrainingDataItem) string {
+	value := item.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + item.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+func (s *TrainingDatasetService) DecodeTrainingDataItemCursor(cursor string) (*entities.TrainingDataItemCursor, error) {
+	value, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	createdAtStr, idStr, found := strings.Cut(string(value), "|")
+	if !found {
+		return nil, errors.New("invalid cursor")
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, createdAtStr)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	return &entities.TrainingDataItemCursor{CreatedAt: createdAt, ID: id}, nil
+}
+
+func (s *TrainingDatasetService) ValidateTrainingDataItemValues(values []string, fieldNames []string) error {
+	if len(values) != len(fieldNames) {
+		return fmt.Errorf("values has %d entries but expected %d entries", len(values), len(fieldNames))
+	}
+	return nil
+}
+
+// CreateCorrection creates a new item that replaces the original item with the given values, or the given messages
+// for an item of a conversation dataset. The source document information is kept so that the correction can still
+// be traced back.
+func (s *TrainingDatasetService) CreateCorrection(original *entities.TrainingDataItem, values []string, messages []entities.ConversationMessage) *entities.TrainingDataItem {
+	now := time.Now()
+	originalID := original.ID
+	if values == nil {
+		values = []string{}
+	}
+	return &entities.TrainingDataItem{
+		ID:                    uuid.New(),
+		Values:                values,
+		Messages:              messages,
+		CorrectsID:            &originalID,
+		SourceDocument:        original.SourceDocument,
+		SourceDocumentStart:   original.SourceDocumentStart,
+		SourceDocumentEnd:     original.SourceDocumentEnd,
+		GenerationTimeSeconds: original.GenerationTimeSeconds,
+		Deleted:               false,
+		Split:                 original.Split,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+}
+
+// CreateExtendedTrainingDataset creates the next version of a DONE training dataset for "generate more". The
+// new version generates the given number of examples with the configuration of the source and starts with copies
+// of all its items, including deleted items and corrections.
+func (s *TrainingDatasetService) CreateExtendedTrainingDataset(source *entities.TrainingDataset, items []entities.TrainingDataItem, generateExamplesNumber int) (*entities.TrainingDataset, error) {
+	if source.Status != entities.TrainingDatasetStatusDone {
+		return nil, errors.New("only DONE training datasets can be extended")
+	}
+	if generateExamplesNumber < 1 {
+		return nil, errors.New("generate examples number must be at least 1")
+	}
+
+	sourceID := source.ID
+	return &entities.TrainingDataset{
+		ID:                       uuid.New(),
+		ProjectID:                source.ProjectID,
+		Version:                  1,
+		Type:                     source.Type,
+		Conversation:             source.Conversation,
+		GenerateModel:            source.GenerateModel,
+		GenerateModelRunner:      source.GenerateModelRunner,
+		InputField:               source.InputField,
+		OutputField:              source.OutputField,
+		JSONObjectFields:         source.JSONObjectFields,
+		ExpectedOutputSizeChars:  source.ExpectedOutputSizeChars,
+		GeneratePromptHistoryIDs: source.GeneratePromptHistoryIDs,
+		GeneratePromptID:         source.GeneratePromptID,
+		CorpusID:                 source.CorpusID,
+		LanguageISO:              source.LanguageISO,
+		Status:                   entities.TrainingDatasetStatusPlanning,
+		FieldNames:               source.FieldNames,
+		GenerateExamplesNumber:   generateExamplesNumber,
+		ChunkingConfig:           source.ChunkingConfig,
+		ExtendsTrainingDatasetID: &sourceID,
+		Data:                     s.CopyTrainingDataItems(items),
+	}, nil
+}
+
+// CopyTrainingDataItems gives the items new IDs and points the corrections to the copies of their originals. The
+// copies are ordered so that every original comes before its corrections.
+func (s *TrainingDatasetService) CopyTrainingDataItems(items []entities.TrainingDataItem) []entities.TrainingDataItem {
+	copiedIDs := make(map[uuid.UUID]uuid.UUID, len(items))
+	for _, item := range items {
+		copiedIDs[item.ID] = uuid.New()
+	}
+
+	copies := make([]entities.TrainingDataItem, 0, len(items))
+	emitted := make(map[uuid.UUID]bool, len(items))
+	pending := items
+	for len(pending) > 0 {
+		remaining := []entities.TrainingDataItem{}
+		for _, item := range pending {
+			if item.CorrectsID != nil {
+				if _, exists := copiedIDs[*item.CorrectsID]; exists && !emitted[*item.CorrectsID] {
+					remaining = append(remaining, item)
+					continue
+				}
+			}
+			copies = append(copies, copyTrainingDataItem(item, copiedIDs))
+			emitted[item.ID] = true
+		}
+
+		// A correction cycle can not be ordered, its items are copied without the references
+		if len(remaining) == len(pending) {
+			for _, item := range remaining {
+				item.CorrectsID = nil
+				copies = append(copies, copyTrainingDataItem(item, copiedIDs))
+			}
+			break
+		}
+		pending = remaining
+	}
+
+	return copies
+}
+
+// copyTrainingDataItem copies the item with the new IDs, corrections of items that were not copied lose the reference
+func copyTrainingDataItem(item entities.TrainingDataItem, copiedIDs map[uuid.UUID]uuid.UUID) entities.TrainingDataItem {
+	copied := item
+	copied.ID = copiedIDs[item.ID]
+	copied.CorrectsID = nil
+	if item.CorrectsID != nil {
+		if copiedOriginalID, exists := copiedIDs[*item.CorrectsID]; exists {
+			copied.CorrectsID = &copiedOriginalID
+		}
+	}
+	copied.Values = append([]string(nil), item.Values...)
+	copied.Messages = append([]entities.ConversationMessage(nil), item.Messages...)
+	return copied
+}
+
+func (s *TrainingDatasetService) ValidateSplitRatios(trainRatio float64, validationRatio float64, testRatio float64) error {
+	if trainRatio <= 0 {
+		return errors.New("train ratio must be greater than 0")
+	}
+	if validationRatio < 0 || testRatio < 0 {
+		return errors.New("validation and test ratios cannot be negative")
+	}
+	if math.Abs(trainRatio+validationRatio+testRatio-1) > 0.0001 {
+		return errors.New("split ratios must add up to 1")
+	}
+	return nil
+}
+
+// AssignSplits assigns every item to the train, validation or test split.
+// The assignment only depends on the items and the seed, so the same seed always produces the same splits.
+// When stratified, items are grouped by source document and every group is split by the same ratios,
+// rounding is carried over between groups so the overall split sizes still match the ratios.
+func (s *TrainingDatasetService) AssignSplits(
+	trainingDataItems []entities.TrainingDataItem,
+	validationRatio float64,
+	testRatio float64,
+	seed int64,
+	stratifyBySourceDocument bool,
+) map[uuid.UUID]entities.TrainingDataItemSplit {
+	groups := make(map[string][]entities.TrainingDataItem)
+	for _, item := range trainingDataItems {
+		key := ""
+		if stratifyBySourceDocument && item.SourceDocument != nil {
+			key = *item.SourceDocument
+		}
+		groups[key] = append(groups[key], item)
+	}
+
+	groupKeys := make([]string, 0, len(groups))
+	for key := range groups {
+		groupKeys = append(groupKeys, key)
+	}
+	sort.Strings(groupKeys)
+
+	rng := rand.New(rand.NewSource(seed))
+	splits := make(map[uuid.UUID]entities.TrainingDataItemSplit, len(trainingDataItems))
+	seen, assignedValidation, assignedTest := 0, 0, 0
+
+	for _, key := range groupKeys {
+		// Sort before shuffling so the result does not depend on the order the items were loaded in
+		group := groups[key]
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].ID.String() < group[j].ID.String()
+		})
+		rng.Shuffle(len(group), func(i, j int) {
+			group[i], group[j] = group[j], group[i]
+		})
+
+		seen += len(group)
+		validationCount := int(math.Round(float64(seen)*validationRatio)) - assignedValidation
+		testCount := int(math.Round(float64(seen)*testRatio)) - assignedTest
+		if validationCount+testCount > len(group) {
+			testCount = len(group) - validationCount
+		}
+		assignedValidation += validationCount
+		assignedTest += testCount
+
+		for i, item := range group {
+			switch {
+			case i < validationCount:
+				splits[item.ID] = entities.TrainingDataItemSplitValidation
+			case i < validationCount+testCount:
+				splits[item.ID] = entities.TrainingDataItemSplitTest
+			default:
+				splits[item.ID] = entities.TrainingDataItemSplitTrain
+			}
+		}
+	}
+
+	return splits
+}
+
+// FilterTrainingDataItemsBySplit returns the items assigned to the given split
+func (s *TrainingDatasetService) FilterTrainingDataItemsBySplit(
+	trainingDataItems []entities.TrainingDataItem,
+	split entities.TrainingDataItemSplit,
+) []entities.TrainingDataItem {
+	var filtered []entities.TrainingDataItem
+	for _, item := range trainingDataItems {
+		itemSplit := item.Split
+		if itemSplit == "" {
+			itemSplit = entities.TrainingDataItemSplitTrain
+		}
+		if itemSplit == split {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
 func (s *TrainingDatasetService) ConvertToFinetuneJobData(
 	trainingDataItems []entities.TrainingDataItem,
 	fieldNames []string,
@@ -192,6 +585,239 @@ func (s *TrainingDatasetService) ConvertToFinetuneJobData(
 	return jobData
 }
 
+// ConvertConversationsToFinetuneJobData converts conversation items to the chat format of the finetune job, one
+// object with the messages per item
+func (s *TrainingDatasetService) ConvertConversationsToFinetuneJobData(
+	trainingDataset *entities.TrainingDataset,
+	trainingDataItems []entities.TrainingDataItem,
+) []map[string]interface{} {
+	var jobData []map[string]interface{}
+	for _, item := range trainingDataItems {
+		jobData = append(jobData, map[string]interface{}{
+			"messages": s.ConversationMessages(trainingDataset, item),
+		})
+	}
+	return jobData
+}
+
+// ValidateExportFormat checks the format against the type of the dataset, conversations can only be exported
+// as JSON lines
+func (s *TrainingDatasetService) ValidateExportFormat(format entities.TrainingDatasetExportFormat, datasetType entities.TrainingDatasetType) error {
+	if datasetType == entities.TrainingDatasetTypeConversation {
+		switch format {
+		case entities.TrainingDatasetExportFormatJSONL, entities.TrainingDatasetExportFormatChat:
+			return nil
+		default:
+			return fmt.Errorf("unsupported export format for conversation datasets: %s", format)
+		}
+	}
+
+	switch format {
+	case entities.TrainingDatasetExportFormatCSV,
+		entities.TrainingDatasetExportFormatJSONL,
+		entities.TrainingDatasetExportFormatChat,
+		entities.TrainingDatasetExportFormatAlpaca:
+		return nil
+	default:
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// ExportTrainingData serializes the given items in one of the JSON based export formats.
+// CSV is not handled here because the CSV download writes the raw rows directly.
+func (s *TrainingDatasetService) ExportTrainingData(
+	format entities.TrainingDatasetExportFormat,
+	trainingDataset *entities.TrainingDataset,
+	trainingDataItems []entities.TrainingDataItem,
+	systemPrompt string,
+	includeMetadata bool,
+) ([]byte, error) {
+	if trainingDataset.Type == entities.TrainingDatasetTypeConversation {
+		return s.exportConversations(format, trainingDataset, trainingDataItems, systemPrompt, includeMetadata)
+	}
+
+	switch format {
+	case entities.TrainingDatasetExportFormatJSONL:
+		var records []interface{}
+		for _, item := range trainingDataItems {
+			record := make(map[string]interface{})
+			for i, fieldName := range trainingDataset.FieldNames {
+				if i < len(item.Values) {
+					record[fieldName] = item.Values[i]
+				}
+			}
+			if includeMetadata {
+				addSourceDocumentMetadata(record, item)
+			}
+			records = append(records, record)
+		}
+		return encodeJSONLines(records)
+
+	case entities.TrainingDatasetExportFormatChat:
+		inputIndex, outputIndex, err := s.InputOutputFieldIndexes(trainingDataset)
+		if err != nil {
+			return nil, err
+		}
+
+		var records []interface{}
+		for _, item := range trainingDataItems {
+			var messages []map[string]string
+			if systemPrompt != "" {
+				messages = append(messages, map[string]string{"role": "system", "content": systemPrompt})
+			}
+			messages = append(messages,
+				map[string]string{"role": "user", "content": itemValue(item, inputIndex)},
+				map[string]string{"role": "assistant", "content": itemValue(item, outputIndex)},
+			)
+
+			record := map[string]interface{}{"messages": messages}
+			if includeMetadata {
+				metadata := make(map[string]interface{})
+				addSourceDocumentMetadata(metadata, item)
+				record["metadata"] = metadata
+			}
+			records = append(records, record)
+		}
+		return encodeJSONLines(records)
+
+	case entities.TrainingDatasetExportFormatAlpaca:
+		inputIndex, outputIndex, err := s.InputOutputFieldIndexes(trainingDataset)
+		if err != nil {
+			return nil, err
+		}
+
+		records := []map[string]interface{}{}
+		for _, item := range trainingDataItems {
+			// With a system prompt the prompt becomes the instruction and the input field its input
+			instruction, input := itemValue(item, inputIndex), ""
+			if systemPrompt != "" {
+				instruction, input = systemPrompt, itemValue(item, inputIndex)
+			}
+
+			record := map[string]interface{}{
+				"instruction": instruction,
+				"input":       input,
+				"output":      itemValue(item, outputIndex),
+			}
+			if includeMetadata {
+				addSourceDocumentMetadata(record, item)
+			}
+			records = append(records, record)
+		}
+		return json.MarshalIndent(records, "", "  ")
+
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// exportConversations writes one conversation per line in both JSON lines formats. A system prompt given for the
+// export replaces the system prompt of the dataset, system messages of the items are kept.
+func (s *TrainingDatasetService) exportConversations(
+	format entities.TrainingDatasetExportFormat,
+	trainingDataset *entities.TrainingDataset,
+	trainingDataItems []entities.TrainingDataItem,
+	systemPrompt string,
+	includeMetadata bool,
+) ([]byte, error) {
+	if format != entities.TrainingDatasetExportFormatJSONL && format != entities.TrainingDatasetExportFormatChat {
+		return nil, fmt.Errorf("unsupported export format for conversation datasets: %s", format)
+	}
+
+	exported := trainingDataset
+	if systemPrompt != "" {
+		withSystemPrompt := *trainingDataset
+		withSystemPrompt.Conversation = &entities.ConversationConfig{SystemPrompt: systemPrompt}
+		if trainingDataset.Conversation != nil {
+			withSystemPrompt.Conversation.Turns = trainingDataset.Conversation.Turns
+		}
+		exported = &withSystemPrompt
+	}
+
+	var records []interface{}
+	for _, item := range trainingDataItems {
+		record := map[string]interface{}{"messages": s.ConversationMessages(exported, item)}
+		if includeMetadata {
+			metadata := make(map[string]interface{})
+			addSourceDocumentMetadata(metadata, item)
+			record["metadata"] = metadata
+		}
+		records = append(records, record)
+	}
+	return encodeJSONLines(records)
+}
+
+// InputOutputFieldIndexes returns the positions of the input and output field in the values of an item
+func (s *TrainingDatasetService) InputOutputFieldIndexes(trainingDataset *entities.TrainingDataset) (int, int, error) {
+	inputIndex, outputIndex := -1, -1
+	for i, fieldName := range trainingDataset.FieldNames {
+		if fieldName == trainingDataset.InputField {
+			inputIndex = i
+		}
+		if fieldName == trainingDataset.OutputField {
+			outputIndex = i
+		}
+	}
+
+	if inputIndex == -1 {
+		return 0, 0, fmt.Errorf("input field %s not found in field names", trainingDataset.InputField)
+	}
+	if outputIndex == -1 {
+		return 0, 0, fmt.Errorf("output field %s not found in field names", trainingDataset.OutputField)
+	}
+
+	return inputIndex, outputIndex, nil
+}
+
+func itemValue(item entities.TrainingDataItem, index int) string {
+	if index < len(item.Values) {
+		return item.Values[index]
+	}
+	return ""
+}
+
+func addSourceDocumentMetadata(record map[string]interface{}, item entities.TrainingDataItem) {
+	if item.SourceDocument != nil {
+		record["source_document"] = *item.SourceDocument
+	}
+	if item.SourceDocumentStart != nil {
+		record["source_document_start"] = *item.SourceDocumentStart
+	}
+	if item.SourceDocumentEnd != nil {
+		record["source_document_end"] = *item.SourceDocumentEnd
+	}
+}
+
+func encodeJSONLines(records []interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return nil, fmt.Errorf("failed to encode record: %w", err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// GenerateExportFilename creates the download filename for the given export format.
+// JSON lines based formats use .jsonl, Alpaca uses .json and everything else .csv
+func (s *TrainingDatasetService) GenerateExportFilename(projectName string, version int, format entities.TrainingDatasetExportFormat) string {
+	filename := s.GenerateCsvFilename(projectName, version)
+	base := strings.TrimSuffix(filename, ".csv")
+
+	switch format {
+	case entities.TrainingDatasetExportFormatJSONL:
+		return base + ".jsonl"
+	case entities.TrainingDatasetExportFormatChat:
+		return base + "_chat.jsonl"
+	case entities.TrainingDatasetExportFormatAlpaca:
+		return base + "_alpaca.json"
+	default:
+		return filename
+	}
+}
+
 // GenerateCsvFilename creates a filename in the format: dataset_{project_name}_v{version}.csv
 // Project name is converted to lowercase, non-alphanumeric and non-space characters are removed,
 // and spaces are replaced with underscores
@@ -214,4 +840,32 @@ func (s *TrainingDatasetService) GenerateCsvFilename(projectName string, version
 	name = strings.Trim(name, "_")
 
 	return fmt.Sprintf("dataset_%s_v%d.csv", name, version)
-}
\ No newline at end of file
+}
+
+func validateConversationMessages(messages []entities.ConversationMessage) error {
+	conversation := messages
+	if len(conversation) > 0 && conversation[0].Role == entities.ConversationMessageRoleSystem {
+		conversation = conversation[1:]
+	}
+	if len(conversation) < 2 {
+		return errors.New("messages must contain at least one user and one assistant message")
+	}
+
+	for i, message := range conversation {
+		expected := entities.ConversationMessageRoleUser
+		if i%2 == 1 {
+			expected = entities.ConversationMessageRoleAssistant
+		}
+		if message.Role != expected {
+			return fmt.Errorf("messages must alternate between user and assistant, message %d has role '%s'", len(messages)-len(conversation)+i+1, message.Role)
+		}
+		if strings.TrimSpace(message.Content) == "" {
+			return fmt.Errorf("messages must not be empty, message %d has no content", len(messages)-len(conversation)+i+1)
+		}
+	}
+	if len(conversation)%2 == 1 {
+		return errors.New("messages must end with an assistant message")
+	}
+
+	return nil
+}
@@ -2,8 +2,12 @@ package services
 
 import (
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
 )
 
 func TestTrainingDatasetService_GenerateCsvFilename(t *testing.T) {
@@ -72,3 +76,429 @@ func TestTrainingDatasetService_GenerateCsvFilename(t *testing.T) {
 		})
 	}
 }
+
+func TestTrainingDatasetService_ActiveTrainingDataItems(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	original := entities.TrainingDataItem{ID: uuid.New(), Values: []string{"a", "b"}}
+	originalID := original.ID
+	correction := entities.TrainingDataItem{ID: uuid.New(), Values: []string{"a", "c"}, CorrectsID: &originalID}
+	deletedCorrection := entities.TrainingDataItem{ID: uuid.New(), Values: []string{"a", "d"}, CorrectsID: &originalID, Deleted: true}
+	deleted := entities.TrainingDataItem{ID: uuid.New(), Values: []string{"x", "y"}, Deleted: true}
+	untouched := entities.TrainingDataItem{ID: uuid.New(), Values: []string{"e", "f"}}
+
+	t.Run("Correction replaces original", func(t *testing.T) {
+		result := service.ActiveTrainingDataItems([]entities.TrainingDataItem{original, correction, deleted, untouched})
+		assert.Equal(t, []entities.TrainingDataItem{correction, untouched}, result)
+	})
+
+	t.Run("Deleted correction does not replace original", func(t *testing.T) {
+		result := service.ActiveTrainingDataItems([]entities.TrainingDataItem{original, deletedCorrection, untouched})
+		assert.Equal(t, []entities.TrainingDataItem{original, untouched}, result)
+	})
+
+	t.Run("Empty input", func(t *testing.T) {
+		result := service.ActiveTrainingDataItems(nil)
+		assert.Empty(t, result)
+	})
+}
+
+func TestTrainingDatasetService_ValidateTrainingDataItemValues(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	assert.NoError(t, service.ValidateTrainingDataItemValues([]string{"q", "a"}, []string{"question", "answer"}))
+
+	err := service.ValidateTrainingDataItemValues([]string{"q"}, []string{"question", "answer"})
+	assert.EqualError(t, err, "values has 1 entries but expected 2 entries")
+}
+
+func TestTrainingDatasetService_CreateCorrection(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	sourceDocument := "doc.txt"
+	start, end := "10", "20"
+	generationTime := 1.5
+	original := &entities.TrainingDataItem{
+		ID:                    uuid.New(),
+		Values:                []string{"q", "a"},
+		SourceDocument:        &sourceDocument,
+		SourceDocumentStart:   &start,
+		SourceDocumentEnd:     &end,
+		GenerationTimeSeconds: generationTime,
+	}
+
+	correction := service.CreateCorrection(original, []string{"q", "better a"}, nil)
+
+	assert.NotEqual(t, original.ID, correction.ID)
+	assert.Equal(t, []string{"q", "better a"}, correction.Values)
+	if assert.NotNil(t, correction.CorrectsID) {
+		assert.Equal(t, original.ID, *correction.CorrectsID)
+	}
+	assert.Equal(t, original.SourceDocument, correction.SourceDocument)
+	assert.Equal(t, original.SourceDocumentStart, correction.SourceDocumentStart)
+	assert.Equal(t, original.SourceDocumentEnd, correction.SourceDocumentEnd)
+	assert.Equal(t, original.GenerationTimeSeconds, correction.GenerationTimeSeconds)
+	assert.False(t, correction.Deleted)
+}
+
+func TestTrainingDatasetService_CreateExtendedTrainingDataset(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	model := "qwen3"
+	source := &entities.TrainingDataset{
+		ID:                      uuid.New(),
+		ProjectID:               uuid.New(),
+		Version:                 3,
+		GenerateModel:           &model,
+		InputField:              "question",
+		OutputField:             "answer",
+		JSONObjectFields:        map[string]string{"question": "A question", "answer": "The answer"},
+		ExpectedOutputSizeChars: 200,
+		GeneratePromptID:        uuid.New(),
+		LanguageISO:             "eng",
+		Status:                  entities.TrainingDatasetStatusDone,
+		FieldNames:              []string{"question", "answer"},
+		GenerateExamplesNumber:  100,
+	}
+	items := []entities.TrainingDataItem{{ID: uuid.New(), Values: []string{"q", "a"}}}
+
+	t.Run("The new version keeps the configuration and the items of the source", func(t *testing.T) {
+		extended, err := service.CreateExtendedTrainingDataset(source, items, 20)
+		assert.NoError(t, err)
+
+		assert.NotEqual(t, source.ID, extended.ID)
+		assert.Equal(t, entities.TrainingDatasetStatusPlanning, extended.Status)
+		assert.Equal(t, 20, extended.GenerateExamplesNumber)
+		assert.Equal(t, source.GeneratePromptID, extended.GeneratePromptID)
+		assert.Equal(t, source.JSONObjectFields, extended.JSONObjectFields)
+		assert.Equal(t, source.GenerateModel, extended.GenerateModel)
+		if assert.NotNil(t, extended.ExtendsTrainingDatasetID) {
+			assert.Equal(t, source.ID, *extended.ExtendsTrainingDatasetID)
+		}
+		if assert.Len(t, extended.Data, 1) {
+			assert.NotEqual(t, items[0].ID, extended.Data[0].ID)
+			assert.Equal(t, items[0].Values, extended.Data[0].Values)
+		}
+	})
+
+	t.Run("Only DONE training datasets can be extended", func(t *testing.T) {
+		running := *source
+		running.Status = entities.TrainingDatasetStatusRunning
+		_, err := service.CreateExtendedTrainingDataset(&running, items, 20)
+		assert.EqualError(t, err, "only DONE training datasets can be extended")
+	})
+
+	t.Run("The number of examples must be positive", func(t *testing.T) {
+		_, err := service.CreateExtendedTrainingDataset(source, items, 0)
+		assert.EqualError(t, err, "generate examples number must be at least 1")
+	})
+}
+
+func TestTrainingDatasetService_CopyTrainingDataItems(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	originalID := uuid.New()
+	correctionID := uuid.New()
+	missingID := uuid.New()
+	reason := "duplicate"
+	items := []entities.TrainingDataItem{
+		// The correction is listed before its original and has to be moved behind it
+		{ID: correctionID, Values: []string{"q", "better a"}, CorrectsID: &originalID},
+		{ID: originalID, Values: []string{"q", "a"}, Deleted: true, DeletedReason: &reason},
+		{ID: uuid.New(), Values: []string{"q2", "a2"}, CorrectsID: &missingID},
+	}
+
+	copies := service.CopyTrainingDataItems(items)
+
+	if assert.Len(t, copies, 3) {
+		assert.Equal(t, []string{"q", "a"}, copies[0].Values)
+		assert.NotEqual(t, originalID, copies[0].ID)
+		assert.True(t, copies[0].Deleted)
+		assert.Equal(t, &reason, copies[0].DeletedReason)
+
+		assert.Nil(t, copies[1].CorrectsID)
+		assert.Equal(t, []string{"q2", "a2"}, copies[1].Values)
+
+		assert.Equal(t, []string{"q", "better a"}, copies[2].Values)
+		if assert.NotNil(t, copies[2].CorrectsID) {
+			assert.Equal(t, copies[0].ID, *copies[2].CorrectsID)
+		}
+	}
+
+	// The source items are left unchanged
+	assert.Equal(t, correctionID, items[0].ID)
+	assert.Equal(t, originalID, *items[0].CorrectsID)
+}
+
+func TestTrainingDatasetService_ExportTrainingData(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	sourceDocument := "doc.txt"
+	trainingDataset := &entities.TrainingDataset{
+		FieldNames:  []string{"question", "answer"},
+		InputField:  "question",
+		OutputField: "answer",
+	}
+	items := []entities.TrainingDataItem{
+		{ID: uuid.New(), Values: []string{"What is AI?", "Artificial Intelligence"}, SourceDocument: &sourceDocument},
+	}
+
+	t.Run("JSONL", func(t *testing.T) {
+		content, err := service.ExportTrainingData(entities.TrainingDatasetExportFormatJSONL, trainingDataset, items, "", false)
+		assert.NoError(t, err)
+		assert.Equal(t, "{\"answer\":\"Artificial Intelligence\",\"question\":\"What is AI?\"}\n", string(content))
+	})
+
+	t.Run("JSONL with metadata", func(t *testing.T) {
+		content, err := service.ExportTrainingData(entities.TrainingDatasetExportFormatJSONL, trainingDataset, items, "", true)
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "\"source_document\":\"doc.txt\"")
+	})
+
+	t.Run("Chat with system prompt", func(t *testing.T) {
+		content, err := service.ExportTrainingData(entities.TrainingDatasetExportFormatChat, trainingDataset, items, "You are helpful.", false)
+		assert.NoError(t, err)
+		assert.Equal(t, "{\"messages\":[{\"content\":\"You are helpful.\",\"role\":\"system\"},{\"content\":\"What is AI?\",\"role\":\"user\"},{\"content\":\"Artificial Intelligence\",\"role\":\"assistant\"}]}\n", string(content))
+	})
+
+	t.Run("Alpaca", func(t *testing.T) {
+		content, err := service.ExportTrainingData(entities.TrainingDatasetExportFormatAlpaca, trainingDataset, items, "", false)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[{"instruction":"What is AI?","input":"","output":"Artificial Intelligence"}]`, string(content))
+	})
+
+	t.Run("Alpaca with system prompt", func(t *testing.T) {
+		content, err := service.ExportTrainingData(entities.TrainingDatasetExportFormatAlpaca, trainingDataset, items, "Answer the question.", false)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[{"instruction":"Answer the question.","input":"What is AI?","output":"Artificial Intelligence"}]`, string(content))
+	})
+
+	t.Run("Missing input field", func(t *testing.T) {
+		invalidDataset := &entities.TrainingDataset{
+			FieldNames:  []string{"question", "answer"},
+			InputField:  "prompt",
+			OutputField: "answer",
+		}
+		_, err := service.ExportTrainingData(entities.TrainingDatasetExportFormatChat, invalidDataset, items, "", false)
+		assert.EqualError(t, err, "input field prompt not found in field names")
+	})
+}
+
+func TestTrainingDatasetService_ExportTrainingData_Conversation(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	trainingDataset := &entities.TrainingDataset{
+		Type:         entities.TrainingDatasetTypeConversation,
+		Conversation: &entities.ConversationConfig{SystemPrompt: "You are a support agent.", Turns: 1},
+		FieldNames:   []string{},
+	}
+	items := []entities.TrainingDataItem{
+		{ID: uuid.New(), Messages: []entities.ConversationMessage{
+			{Role: entities.ConversationMessageRoleUser, Content: "Hi"},
+			{Role: entities.ConversationMessageRoleAssistant, Content: "Hello"},
+		}},
+		{ID: uuid.New(), Messages: []entities.ConversationMessage{
+			{Role: entities.ConversationMessageRoleSystem, Content: "Be brief."},
+			{Role: entities.ConversationMessageRoleUser, Content: "Q"},
+			{Role: entities.ConversationMessageRoleAssistant, Content: "A"},
+		}},
+	}
+
+	t.Run("Chat with the system prompt of the dataset", func(t *testing.T) {
+		content, err := service.ExportTrainingData(entities.TrainingDatasetExportFormatChat, trainingDataset, items, "", false)
+		assert.NoError(t, err)
+		assert.Equal(t, "{\"messages\":[{\"role\":\"system\",\"content\":\"You are a support agent.\"},{\"role\":\"user\",\"content\":\"Hi\"},{\"role\":\"assistant\",\"content\":\"Hello\"}]}\n"+
+			"{\"messages\":[{\"role\":\"system\",\"content\":\"Be brief.\"},{\"role\":\"user\",\"content\":\"Q\"},{\"role\":\"assistant\",\"content\":\"A\"}]}\n", string(content))
+	})
+
+	t.Run("The system prompt of the export replaces the one of the dataset", func(t *testing.T) {
+		content, err := service.ExportTrainingData(entities.TrainingDatasetExportFormatJSONL, trainingDataset, items[:1], "You are helpful.", false)
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "\"content\":\"You are helpful.\"")
+		assert.NotContains(t, string(content), "support agent")
+	})
+
+	t.Run("CSV and Alpaca are not supported", func(t *testing.T) {
+		assert.EqualError(t, service.ValidateExportFormat(entities.TrainingDatasetExportFormatCSV, entities.TrainingDatasetTypeConversation), "unsupported export format for conversation datasets: csv")
+		_, err := service.ExportTrainingData(entities.TrainingDatasetExportFormatAlpaca, trainingDataset, items, "", false)
+		assert.EqualError(t, err, "unsupported export format for conversation datasets: alpaca")
+		assert.NoError(t, service.ValidateExportFormat(entities.TrainingDatasetExportFormatAlpaca, entities.TrainingDatasetTypeRecords))
+	})
+}
+
+func TestTrainingDatasetService_ValidateConversationMessages(t *testing.T) {
+	service := &TrainingDatasetService{}
+	message := func(role entities.ConversationMessageRole, content string) entities.ConversationMessage {
+		return entities.ConversationMessage{Role: role, Content: content}
+	}
+	system, user, assistant := entities.ConversationMessageRoleSystem, entities.ConversationMessageRoleUser, entities.ConversationMessageRoleAssistant
+
+	assert.NoError(t, service.ValidateConversationMessages([]entities.ConversationMessage{message(user, "Q"), message(assistant, "A")}))
+	assert.NoError(t, service.ValidateConversationMessages([]entities.ConversationMessage{message(system, "S"), message(user, "Q1"), message(assistant, "A1"), message(user, "Q2"), message(assistant, "A2")}))
+
+	assert.EqualError(t, service.ValidateConversationMessages([]entities.ConversationMessage{message(system, "S"), message(user, "Q")}),
+		"messages must contain at least one user and one assistant message")
+	assert.EqualError(t, service.ValidateConversationMessages([]entities.ConversationMessage{message(user, "Q"), message(system, "S")}),
+		"messages must alternate between user and assistant, message 2 has role 'system'")
+	assert.EqualError(t, service.ValidateConversationMessages([]entities.ConversationMessage{message(user, "Q"), message(assistant, "A"), message(user, "Q2")}),
+		"messages must end with an assistant message")
+	assert.EqualError(t, service.ValidateConversationMessages([]entities.ConversationMessage{message(user, "Q"), message(assistant, " ")}),
+		"messages must not be empty, message 2 has no content")
+}
+
+func TestTrainingDatasetService_ConvertConversationsToFinetuneJobData(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	trainingDataset := &entities.TrainingDataset{
+		Type:         entities.TrainingDatasetTypeConversation,
+		Conversation: &entities.ConversationConfig{SystemPrompt: "You are a support agent.", Turns: 1},
+	}
+	messages := []entities.ConversationMessage{
+		{Role: entities.ConversationMessageRoleUser, Content: "Hi"},
+		{Role: entities.ConversationMessageRoleAssistant, Content: "Hello"},
+	}
+
+	jobData := service.ConvertConversationsToFinetuneJobData(trainingDataset, []entities.TrainingDataItem{{ID: uuid.New(), Messages: messages}})
+
+	assert.Equal(t, []map[string]interface{}{{
+		"messages": []entities.ConversationMessage{
+			{Role: entities.ConversationMessageRoleSystem, Content: "You are a support agent."},
+			messages[0],
+			messages[1],
+		},
+	}}, jobData)
+}
+
+func TestTrainingDatasetService_GenerateExportFilename(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	assert.Equal(t, "dataset_my_project_v1.csv", service.GenerateExportFilename("My Project", 1, entities.TrainingDatasetExportFormatCSV))
+	assert.Equal(t, "dataset_my_project_v1.jsonl", service.GenerateExportFilename("My Project", 1, entities.TrainingDatasetExportFormatJSONL))
+	assert.Equal(t, "dataset_my_project_v1_chat.jsonl", service.GenerateExportFilename("My Project", 1, entities.TrainingDatasetExportFormatChat))
+	assert.Equal(t, "dataset_my_project_v1_alpaca.json", service.GenerateExportFilename("My Project", 1, entities.TrainingDatasetExportFormatAlpaca))
+}
+
+func TestTrainingDatasetService_ValidateSplitRatios(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	assert.NoError(t, service.ValidateSplitRatios(0.8, 0.1, 0.1))
+	assert.NoError(t, service.ValidateSplitRatios(1, 0, 0))
+	assert.EqualError(t, service.ValidateSplitRatios(0, 0.5, 0.5), "train ratio must be greater than 0")
+	assert.EqualError(t, service.ValidateSplitRatios(0.9, -0.1, 0.2), "validation and test ratios cannot be negative")
+	assert.EqualError(t, service.ValidateSplitRatios(0.8, 0.1, 0.2), "split ratios must add up to 1")
+}
+
+func TestTrainingDatasetService_AssignSplits(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	documentA, documentB := "a.txt", "b.txt"
+	var items []entities.TrainingDataItem
+	for i := 0; i < 100; i++ {
+		document := &documentA
+		if i%4 == 0 {
+			document = &documentB
+		}
+		items = append(items, entities.TrainingDataItem{ID: uuid.New(), SourceDocument: document})
+	}
+
+	countSplits := func(splits map[uuid.UUID]entities.TrainingDataItemSplit, document *string) map[entities.TrainingDataItemSplit]int {
+		counts := make(map[entities.TrainingDataItemSplit]int)
+		for _, item := range items {
+			if document == nil || item.SourceDocument == document {
+				counts[splits[item.ID]]++
+			}
+		}
+		return counts
+	}
+
+	t.Run("Ratios are respected", func(t *testing.T) {
+		splits := service.AssignSplits(items, 0.1, 0.2, 42, false)
+		assert.Len(t, splits, 100)
+
+		counts := countSplits(splits, nil)
+		assert.Equal(t, 70, counts[entities.TrainingDataItemSplitTrain])
+		assert.Equal(t, 10, counts[entities.TrainingDataItemSplitValidation])
+		assert.Equal(t, 20, counts[entities.TrainingDataItemSplitTest])
+	})
+
+	t.Run("Same seed produces the same splits regardless of item order", func(t *testing.T) {
+		reversed := make([]entities.TrainingDataItem, len(items))
+		for i, item := range items {
+			reversed[len(items)-1-i] = item
+		}
+
+		assert.Equal(t, service.AssignSplits(items, 0.1, 0.1, 7, false), service.AssignSplits(reversed, 0.1, 0.1, 7, false))
+		assert.NotEqual(t, service.AssignSplits(items, 0.1, 0.1, 7, false), service.AssignSplits(items, 0.1, 0.1, 8, false))
+	})
+
+	t.Run("Stratified by source document", func(t *testing.T) {
+		splits := service.AssignSplits(items, 0.2, 0, 42, true)
+
+		countsA := countSplits(splits, &documentA)
+		countsB := countSplits(splits, &documentB)
+		assert.Equal(t, 15, countsA[entities.TrainingDataItemSplitValidation])
+		assert.Equal(t, 5, countsB[entities.TrainingDataItemSplitValidation])
+		assert.Equal(t, 0, countsA[entities.TrainingDataItemSplitTest]+countsB[entities.TrainingDataItemSplitTest])
+	})
+}
+
+func TestTrainingDatasetService_FilterTrainingDataItemsBySplit(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	train := entities.TrainingDataItem{ID: uuid.New(), Split: entities.TrainingDataItemSplitTrain}
+	unassigned := entities.TrainingDataItem{ID: uuid.New()}
+	validation := entities.TrainingDataItem{ID: uuid.New(), Split: entities.TrainingDataItemSplitValidation}
+	items := []entities.TrainingDataItem{train, unassigned, validation}
+
+	assert.Equal(t, []entities.TrainingDataItem{train, unassigned}, service.FilterTrainingDataItemsBySplit(items, entities.TrainingDataItemSplitTrain))
+	assert.Equal(t, []entities.TrainingDataItem{validation}, service.FilterTrainingDataItemsBySplit(items, entities.TrainingDataItemSplitValidation))
+	assert.Empty(t, service.FilterTrainingDataItemsBySplit(items, entities.TrainingDataItemSplitTest))
+}
+
+func TestTrainingDatasetService_SelectTrainingDataSubset_MinimumQualityScore(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	low, high := 3.0, 8.5
+	lowItem := entities.TrainingDataItem{ID: uuid.New(), Values: []string{"a"}, QualityScore: &low}
+	highItem := entities.TrainingDataItem{ID: uuid.New(), Values: []string{"b"}, QualityScore: &high}
+	unscoredItem := entities.TrainingDataItem{ID: uuid.New(), Values: []string{"c"}}
+	items := []entities.TrainingDataItem{lowItem, highItem, unscoredItem}
+
+	t.Run("Without minimum score all items are used", func(t *testing.T) {
+		result := service.SelectTrainingDataSubset(items, nil, false, nil)
+		assert.Len(t, result, 3)
+	})
+
+	t.Run("Minimum score leaves out low and unscored items", func(t *testing.T) {
+		minimumScore := 7.0
+		result := service.SelectTrainingDataSubset(items, nil, false, &minimumScore)
+		assert.Equal(t, []entities.TrainingDataItem{highItem}, result)
+	})
+}
+
+func TestTrainingDatasetService_ValidateMinimumQualityScore(t *testing.T) {
+	service := &TrainingDatasetService{}
+
+	valid, tooHigh := 7.5, 11.0
+	assert.NoError(t, service.ValidateMinimumQualityScore(nil))
+	assert.NoError(t, service.ValidateMinimumQualityScore(&valid))
+	assert.EqualError(t, service.ValidateMinimumQualityScore(&tooHigh), "minimum quality score must be between 1 and 10")
+}
+
+func TestTrainingDatasetService_TrainingDataItemCursor(t *testing.T) {
+	service := &TrainingDatasetService{}
+	item := &entities.TrainingDataItem{
+		ID:        uuid.New(),
+		CreatedAt: time.Date(2025, 10, 17, 12, 30, 45, 123456000, time.UTC),
+	}
+
+	cursor, err := service.DecodeTrainingDataItemCursor(service.EncodeTrainingDataItemCursor(item))
+	assert.NoError(t, err)
+	assert.Equal(t, item.ID, cursor.ID)
+	assert.True(t, item.CreatedAt.Equal(cursor.CreatedAt))
+
+	for _, invalid := range []string{"not base64!", "bm8tc2VwYXJhdG9y", "eA|bad"} {
+		_, err := service.DecodeTrainingDataItemCursor(invalid)
+		assert.EqualError(t, err, "invalid cursor")
+	}
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+// ApproximateCharsPerToken is the rule of thumb used to estimate token counts without a tokenizer
+const ApproximateCharsPerToken = 4
+
+// minLanguageStopwordHits is the number of stopwords a text needs before its language is trusted
+const minLanguageStopwordHits = 3
+
+// languageStopwords contains frequent short words for the languages that can be selected for a training dataset,
+// keyed by ISO 639-3 code
+var languageStopwords = map[string][]string{
+	"eng": {"the", "and", "is", "are", "of", "to", "in", "that", "with", "for", "this", "it", "was", "not", "be", "on", "have", "you", "what", "which"},
+	"deu": {"der", "die", "das", "und", "ist", "nicht", "ein", "eine", "mit", "von", "zu", "den", "auf", "für", "sich", "auch", "wird", "sind", "dem", "wie"},
+	"fra": {"le", "la", "les", "et", "est", "des", "une", "un", "du", "que", "pour", "dans", "pas", "qui", "sur", "avec", "sont", "ce", "au", "par"},
+	"ita": {"il", "lo", "gli", "della", "che", "è", "di", "per", "non", "sono", "una", "con", "del", "nel", "alla", "anche", "come", "più", "questo", "delle"},
+	"spa": {"el", "los", "las", "y", "es", "que", "de", "del", "en", "por", "una", "con", "para", "no", "se", "como", "más", "está", "son", "su"},
+	"por": {"o", "os", "as", "e", "é", "que", "de", "do", "da", "em", "um", "uma", "para", "com", "não", "no", "na", "dos", "mais", "são"},
+	"pol": {"i", "w", "na", "z", "się", "jest", "nie", "to", "że", "do", "jak", "ale", "co", "od", "po", "tak", "jego", "przez", "być", "oraz"},
+	"fin": {"ja", "on", "ei", "se", "että", "oli", "ovat", "mutta", "kun", "myös", "tai", "hän", "niin", "kuin", "joka", "tämä", "sen", "olla", "mitä", "vain"},
+	"est": {"ja", "on", "ei", "see", "et", "oli", "ka", "kui", "ta", "mis", "või", "aga", "nii", "siis", "oma", "veel", "kes", "seda", "mida", "olema"},
+}
+
+type TrainingDatasetStatsService struct {
+	TrainingDatasetService *TrainingDatasetService
+}
+
+// ComputeTrainingDatasetStats summarizes the items of a training dataset
+func (s *TrainingDatasetStatsService) ComputeTrainingDatasetStats(trainingDataset *entities.TrainingDataset) *entities.TrainingDatasetStats {
+	activeItems := s.TrainingDatasetService.ActiveTrainingDataItems(trainingDataset.Data)
+
+	stats := &entities.TrainingDatasetStats{
+		TotalItems:              len(trainingDataset.Data),
+		ActiveItems:             len(activeItems),
+		LanguageISO:             trainingDataset.LanguageISO,
+		LanguageMismatchItemIDs: []uuid.UUID{},
+	}
+
+	correctedIDs := make(map[uuid.UUID]bool)
+	for _, item := range trainingDataset.Data {
+		if item.Deleted {
+			stats.DeletedItems++
+		} else if item.CorrectsID != nil {
+			correctedIDs[*item.CorrectsID] = true
+		}
+	}
+	stats.CorrectedItems = len(correctedIDs)
+
+	// Conversations have no fields, their messages are summarized by role instead
+	fieldNames := trainingDataset.FieldNames
+	if trainingDataset.Type == entities.TrainingDatasetTypeConversation {
+		fieldNames = []string{string(entities.ConversationMessageRoleUser), string(entities.ConversationMessageRoleAssistant)}
+	}
+
+	stats.Fields = make([]entities.TrainingDatasetFieldStats, len(fieldNames))
+	for i, fieldName := range fieldNames {
+		var characters, tokens []float64
+		emptyValues := 0
+		for _, item := range activeItems {
+			value := ""
+			if trainingDataset.Type == entities.TrainingDatasetTypeConversation {
+				value = conversationRoleText(item.Messages, entities.ConversationMessageRole(fieldName))
+			} else if i < len(item.Values) {
+				value = item.Values[i]
+			}
+			if strings.TrimSpace(value) == "" {
+				emptyValues++
+			}
+			length := utf8.RuneCountInString(value)
+			characters = append(characters, float64(length))
+			tokens = append(tokens, float64(ApproximateTokenCount(length)))
+		}
+		stats.Fields[i] = entities.TrainingDatasetFieldStats{
+			FieldName:   fieldName,
+			EmptyValues: emptyValues,
+			Characters:  ComputeDistribution(characters),
+			Tokens:      ComputeDistribution(tokens),
+		}
+	}
+
+	itemsByDocument := make(map[string]int)
+	for _, item := range activeItems {
+		if item.SourceDocument == nil || *item.SourceDocument == "" {
+			stats.ItemsWithoutSourceDocument++
+			continue
+		}
+		itemsByDocument[*item.SourceDocument]++
+	}
+	itemsPerDocument := make([]float64, 0, len(itemsByDocument))
+	for _, count := range itemsByDocument {
+		itemsPerDocument = append(itemsPerDocument, float64(count))
+	}
+	stats.SourceDocuments = len(itemsByDocument)
+	stats.ItemsPerSourceDocument = ComputeDistribution(itemsPerDocument)
+
+	// Uploaded items have no generation time, they would only drag the percentiles down
+	var generationTimes []float64
+	for _, item := range activeItems {
+		if item.GenerationTimeSeconds > 0 {
+			generationTimes = append(generationTimes, item.GenerationTimeSeconds)
+		}
+	}
+	stats.GenerationTimeSeconds = ComputeDistribution(generationTimes)
+
+	if _, supported := languageStopwords[trainingDataset.LanguageISO]; supported {
+		stats.LanguageChecked = true
+		for _, item := range activeItems {
+			text := strings.Join(item.Values, " ")
+			if len(item.Messages) > 0 {
+				text = conversationRoleText(item.Messages, "")
+			}
+			language := DetectLanguage(text)
+			if language == "" {
+				stats.LanguageUndetectedItems++
+			} else if language != trainingDataset.LanguageISO {
+				stats.LanguageMismatchItemIDs = append(stats.LanguageMismatchItemIDs, item.ID)
+			}
+		}
+	}
+
+	return stats
+}
+
+// conversationRoleText joins the contents of the messages with the given role, an empty role joins all messages
+func conversationRoleText(messages []entities.ConversationMessage, role entities.ConversationMessageRole) string {
+	var contents []string
+	for _, message := range messages {
+		if role == "" || message.Role == role {
+			contents = append(contents, message.Content)
+		}
+	}
+	return strings.Join(contents, " ")
+}
+
+// ApproximateTokenCount estimates the number of tokens of a text with the given number of characters
+func ApproximateTokenCount(characters int) int {
+	return (characters + ApproximateCharsPerToken - 1) / ApproximateCharsPerToken
+}
+
+// ComputeDistribution returns the summary of the values, an empty list results in a zero distribution
+func ComputeDistribution(values []float64) entities.Distribution {
+	if len(values) == 0 {
+		return entities.Distribution{}
+	}
+
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	sum := 0.0
+	for _, value := range sorted {
+		sum += value
+	}
+
+	return entities.Distribution{
+		Count: len(sorted),
+		Min:   sorted[0],
+		Max:   sorted[len(sorted)-1],
+		Mean:  math.Round(sum/float64(len(sorted))*100) / 100,
+		P50:   percentile(sorted, 50),
+		P90:   percentile(sorted, 90),
+		P99:   percentile(sorted, 99),
+	}
+}
+
+// percentile returns the nearest rank percentile of sorted values
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// DetectLanguage returns the ISO 639-3 code of the language with the most stopwords in the text.
+// An empty string is returned if there are too few stopwords or two languages are tied.
+func DetectLanguage(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	wordCounts := make(map[string]int)
+	for _, word := range words {
+		wordCounts[word]++
+	}
+
+	bestLanguage := ""
+	bestHits, secondHits := 0, 0
+	for language, stopwords := range languageStopwords {
+		hits := 0
+		for _, stopword := range stopwords {
+			hits += wordCounts[stopword]
+		}
+		if hits > bestHits {
+			bestLanguage, bestHits, secondHits = language, hits, bestHits
+		} else if hits > secondHits {
+			secondHits = hits
+		}
+	}
+
+	if bestHits < minLanguageStopwordHits || bestHits == secondHits {
+		return ""
+	}
+	return bestLanguage
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+func TestComputeDistribution(t *testing.T) {
+	distribution := ComputeDistribution([]float64{5, 1, 3, 2, 4, 6, 7, 8, 9, 10})
+
+	assert.Equal(t, entities.Distribution{Count: 10, Min: 1, Max: 10, Mean: 5.5, P50: 5, P90: 9, P99: 10}, distribution)
+	assert.Equal(t, entities.Distribution{}, ComputeDistribution(nil))
+}
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "english", text: "What is the capital of France and why is it important?", expected: "eng"},
+		{name: "german", text: "Die Hauptstadt von Frankreich ist Paris und sie ist sehr groß.", expected: "deu"},
+		{name: "spanish", text: "La capital de Francia es París y es una ciudad con mucha historia.", expected: "spa"},
+		{name: "too short", text: "Paris", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DetectLanguage(tt.text))
+		})
+	}
+}
+
+func TestTrainingDatasetStatsService_ComputeTrainingDatasetStats(t *testing.T) {
+	service := &TrainingDatasetStatsService{TrainingDatasetService: &TrainingDatasetService{}}
+
+	documentA := "a.pdf"
+	documentB := "b.pdf"
+	originalID := uuid.New()
+	germanID := uuid.New()
+	trainingDataset := &entities.TrainingDataset{
+		LanguageISO: "eng",
+		FieldNames:  []string{"question", "answer"},
+		Data: []entities.TrainingDataItem{
+			{ID: originalID, Values: []string{"What is AI?", "old"}, SourceDocument: &documentA, GenerationTimeSeconds: 2},
+			{ID: uuid.New(), CorrectsID: &originalID, Values: []string{"What is the meaning of AI in this text?", "It is the ability of machines to learn."}, SourceDocument: &documentA, GenerationTimeSeconds: 2},
+			{ID: germanID, Values: []string{"Was ist die Bedeutung von KI?", "Die Fähigkeit von Maschinen, zu lernen und sich anzupassen."}, SourceDocument: &documentB, GenerationTimeSeconds: 4},
+			{ID: uuid.New(), Values: []string{"Deleted", ""}, Deleted: true},
+			{ID: uuid.New(), Values: []string{"Uploaded", ""}},
+		},
+	}
+
+	stats := service.ComputeTrainingDatasetStats(trainingDataset)
+
+	assert.Equal(t, 5, stats.TotalItems)
+	assert.Equal(t, 3, stats.ActiveItems)
+	assert.Equal(t, 1, stats.DeletedItems)
+	assert.Equal(t, 1, stats.CorrectedItems)
+
+	assert.Len(t, stats.Fields, 2)
+	assert.Equal(t, "answer", stats.Fields[1].FieldName)
+	assert.Equal(t, 1, stats.Fields[1].EmptyValues)
+	assert.Equal(t, float64(8), stats.Fields[0].Characters.Min)
+	assert.Equal(t, float64(2), stats.Fields[0].Tokens.Min)
+
+	assert.Equal(t, 2, stats.SourceDocuments)
+	assert.Equal(t, 1, stats.ItemsWithoutSourceDocument)
+	assert.Equal(t, float64(1), stats.ItemsPerSourceDocument.Max)
+
+	assert.True(t, stats.LanguageChecked)
+	assert.Equal(t, []uuid.UUID{germanID}, stats.LanguageMismatchItemIDs)
+	assert.Equal(t, 1, stats.LanguageUndetectedItems)
+
+	// The uploaded item without generation time is not part of the percentiles
+	assert.Equal(t, 2, stats.GenerationTimeSeconds.Count)
+	assert.Equal(t, float64(4), stats.GenerationTimeSeconds.Max)
+}
+
+func TestTrainingDatasetStatsService_ComputeTrainingDatasetStats_Conversation(t *testing.T) {
+	service := &TrainingDatasetStatsService{TrainingDatasetService: &TrainingDatasetService{}}
+
+	trainingDataset := &entities.TrainingDataset{
+		Type:        entities.TrainingDatasetTypeConversation,
+		LanguageISO: "eng",
+		FieldNames:  []string{},
+		Data: []entities.TrainingDataItem{
+			{ID: uuid.New(), Values: []string{}, Messages: []entities.ConversationMessage{
+				{Role: entities.ConversationMessageRoleUser, Content: "Hi"},
+				{Role: entities.ConversationMessageRoleAssistant, Content: "Hello"},
+				{Role: entities.ConversationMessageRoleUser, Content: "Bye"},
+				{Role: entities.ConversationMessageRoleAssistant, Content: "Goodbye"},
+			}},
+		},
+	}
+
+	stats := service.ComputeTrainingDatasetStats(trainingDataset)
+
+	if assert.Len(t, stats.Fields, 2) {
+		assert.Equal(t, "user", stats.Fields[0].FieldName)
+		assert.Equal(t, float64(len("Hi Bye")), stats.Fields[0].Characters.Max)
+		assert.Equal(t, "assistant", stats.Fields[1].FieldName)
+		assert.Equal(t, float64(len("Hello Goodbye")), stats.Fields[1].Characters.Max)
+	}
+}
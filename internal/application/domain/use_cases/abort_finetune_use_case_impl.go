@@ -0,0 +1,124 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type AbortFinetuneUseCaseImpl struct {
+	ProjectService          *services.ProjectService
+	StatusTransitionService *services.StatusTransitionService
+	FinetuneRepository      persistence.FinetuneRepository
+	FinetuneBackends        clients.FinetuneBackends
+	// FinetuneDispatchEventRepository records the cancelled backend jobs
+	FinetuneDispatchEventRepository persistence.FinetuneDispatchEventRepository
+}
+
+func (uc *AbortFinetuneUseCaseImpl) AbortFinetune(ctx context.Context, command in.AbortFinetuneCommand) error {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	finetune, err := uc.FinetuneRepository.GetByID(ctx, command.FinetuneID)
+	if err != nil {
+		return fmt.Errorf("failed to get finetune: %w", err)
+	}
+	if finetune == nil || finetune.ProjectID != command.ProjectID {
+		return errors.New("finetune not found")
+	}
+
+	if err := uc.StatusTransitionService.ValidateFinetuneStatusTransition(finetune.Status, entities.FinetuneStatusAborted); err != nil {
+		return err
+	}
+
+	// A queued finetune has no job yet, it is taken out of the queue unless the scheduler dispatched it in the meantime
+	if finetune.Status == entities.FinetuneStatusQueued {
+		message := "Cancelled while queued"
+		cancelled, err := uc.FinetuneRepository.CancelQueued(ctx, finetune.ID, &entities.FinetuneDispatchEvent{
+			FinetuneID: finetune.ID,
+			Type:       entities.FinetuneDispatchEventCancelled,
+			Message:    &message,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to cancel queued finetune: %w", err)
+		}
+		if cancelled {
+			return nil
+		}
+
+		finetune, err = uc.FinetuneRepository.GetByID(ctx, command.FinetuneID)
+		if err != nil {
+			return fmt.Errorf("failed to get finetune: %w", err)
+		}
+		if finetune == nil {
+			return errors.New("finetune not found")
+		}
+		if err := uc.StatusTransitionService.ValidateFinetuneStatusTransition(finetune.Status, entities.FinetuneStatusAborted); err != nil {
+			return err
+		}
+	}
+
+	// Finetunes without a backend job yet are only marked as aborted, a job the dispatcher starts meanwhile is
+	// cancelled below or by the dispatcher
+	if finetune.BackendJobID != nil && *finetune.BackendJobID != "" {
+		if err := uc.cancelBackendJob(ctx, finetune); err != nil {
+			return fmt.Errorf("failed to cancel finetune job: %w", err)
+		}
+
+		message := fmt.Sprintf("Cancelled %s job %s", finetune.Backend, *finetune.BackendJobID)
+		if err := uc.FinetuneDispatchEventRepository.Create(ctx, &entities.FinetuneDispatchEvent{
+			FinetuneID: finetune.ID,
+			Type:       entities.FinetuneDispatchEventCancelled,
+			Message:    &message,
+		}); err != nil {
+			return fmt.Errorf("failed to record dispatch event: %w", err)
+		}
+	}
+
+	if err := uc.FinetuneRepository.UpdateStatus(ctx, finetune.ID, entities.FinetuneStatusAborted); err != nil {
+		return fmt.Errorf("failed to update finetune status: %w", err)
+	}
+
+	// The dispatcher may have stored a job ID after the finetune was read, it checks for the abort after storing it
+	// and this checks for a job ID after aborting, so one of them cancels the job
+	if finetune.BackendJobID == nil || *finetune.BackendJobID == "" {
+		current, err := uc.FinetuneRepository.GetByID(ctx, finetune.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get finetune: %w", err)
+		}
+		if current != nil && current.BackendJobID != nil && *current.BackendJobID != "" {
+			if err := uc.cancelBackendJob(ctx, current); err != nil {
+				return fmt.Errorf("failed to cancel finetune job: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// cancelBackendJob cancels the job unless it has already ended in the backend or the backend has forgotten it
+func (uc *AbortFinetuneUseCaseImpl) cancelBackendJob(ctx context.Context, finetune *entities.Finetune) error {
+	backend, err := uc.FinetuneBackends.Get(finetune.Backend)
+	if err != nil {
+		return err
+	}
+
+	status, err := backend.Status(ctx, *finetune.BackendJobID)
+	if err != nil {
+		return err
+	}
+	if status.IsFinal() || status == clients.FinetuneBackendJobStatusUnknown {
+		return nil
+	}
+
+	return backend.Cancel(ctx, *finetune.BackendJobID)
+}
@@ -0,0 +1,51 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type AbortTrainingDatasetUseCaseImpl struct {
+	ProjectService            *services.ProjectService
+	StatusTransitionService   *services.StatusTransitionService
+	TrainingDatasetRepository persistence.TrainingDatasetRepository
+	TrainingDatasetJobClient  clients.TrainingDatasetJobClient
+}
+
+func (uc *AbortTrainingDatasetUseCaseImpl) AbortTrainingDataset(ctx context.Context, command in.AbortTrainingDatasetCommand) error {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return errors.New("training dataset not found")
+	}
+
+	if err := uc.StatusTransitionService.ValidateTrainingDatasetStatusTransition(trainingDataset.Status, entities.TrainingDatasetStatusAborted); err != nil {
+		return err
+	}
+
+	// The runner is signalled first, the status is only changed when the signal is sent
+	if err := uc.TrainingDatasetJobClient.CancelJob(ctx, trainingDataset.ID.String()); err != nil {
+		return fmt.Errorf("failed to cancel training dataset job: %w", err)
+	}
+
+	if err := uc.TrainingDatasetRepository.UpdateStatus(ctx, trainingDataset.ID, entities.TrainingDatasetStatusAborted); err != nil {
+		return fmt.Errorf("failed to update training dataset status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package use_cases
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+)
+
+type ChunkCorpusUseCaseImpl struct {
+	CorpusService         *services.CorpusService
+	CorpusChunkingService *services.CorpusChunkingService
+}
+
+func (uc *ChunkCorpusUseCaseImpl) ChunkCorpus(ctx context.Context, command in.ChunkCorpusCommand) (*in.ChunkCorpusResult, error) {
+	// Chunks are derived from the documents, so reading the corpus is enough to create them
+	corpus, err := uc.CorpusService.GetCorpus(ctx, command.CorpusID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.CorpusChunkingService.ValidateChunkingConfig(command.Config); err != nil {
+		return nil, err
+	}
+
+	documentChunks, err := uc.CorpusChunkingService.ChunkCorpus(ctx, corpus, command.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &in.ChunkCorpusResult{
+		Config:    command.Config,
+		ConfigKey: uc.CorpusChunkingService.ChunkingConfigKey(command.Config),
+		Documents: make([]in.ChunkCorpusDocumentResult, 0, len(documentChunks)),
+	}
+	for _, document := range documentChunks {
+		tokenCount := 0
+		for _, chunk := range document.Chunks {
+			tokenCount += chunk.TokenCount
+		}
+		result.Documents = append(result.Documents, in.ChunkCorpusDocumentResult{
+			FileName:   document.FileName,
+			ChunkCount: len(document.Chunks),
+			TokenCount: tokenCount,
+		})
+	}
+
+	return result, nil
+}
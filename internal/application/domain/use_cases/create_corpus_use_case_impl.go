@@ -0,0 +1,46 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type CreateCorpusUseCaseImpl struct {
+	CorpusRepository persistence.CorpusRepository
+	CorpusService    *services.CorpusService
+	ProjectService   *services.ProjectService
+}
+
+func (uc *CreateCorpusUseCaseImpl) CreateCorpus(ctx context.Context, command in.CreateCorpusCommand) (*entities.Corpus, error) {
+	if err := uc.CorpusService.ValidateCorpusName(command.Name); err != nil {
+		return nil, err
+	}
+
+	// A corpus can only be attached to a project of the owner
+	if command.ProjectID != nil {
+		if _, err := uc.ProjectService.GetProject(ctx, *command.ProjectID, command.OwnerID); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := uc.CorpusRepository.GetByNameForOwner(ctx, command.Name, command.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check corpus name: %w", err)
+	}
+	if existing != nil && existing.OwnerID != nil {
+		return nil, errors.New("corpus name already exists")
+	}
+
+	corpus := uc.CorpusService.CreateCorpus(command.Name, command.OwnerID, command.ProjectID)
+	if err := uc.CorpusRepository.Create(ctx, corpus); err != nil {
+		return nil, fmt.Errorf("failed to create corpus: %w", err)
+	}
+
+	return corpus, nil
+}
@@ -47,7 +47,7 @@ func (uc *CreateDeploymentUseCaseImpl) CreateDeployment(command in.CreateDeploym
 	}
 
 	// Create deployment
-	deployment := uc.DeploymentService.CreateDeployment(command.ModelName, command.ProjectID, command.FinetuneID)
+	deployment := uc.DeploymentService.CreateDeployment(command.ModelName, command.ProjectID, command.FinetuneID, command.RedactPII)
 
 	err = uc.DeploymentRepository.Create(deployment)
 	if err != nil {
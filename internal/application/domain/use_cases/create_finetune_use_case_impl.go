@@ -3,6 +3,7 @@ package use_cases
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"ai-platform/internal/application/domain/entities"
 	"ai-platform/internal/application/domain/services"
@@ -18,8 +19,10 @@ type CreateFinetuneUseCaseImpl struct {
 	CorpusRepository          persistence.CorpusRepository
 	FinetuneService           *services.FinetuneService
 	TrainingDatasetService    *services.TrainingDatasetService
-	FinetuneJobClient         clients.FinetuneJobClient
-	RunpodClient              clients.RunpodClient
+	PIIService                *services.PIIService
+	OutboxService             *services.OutboxService
+	FinetuneBackends          clients.FinetuneBackends
+	FinetuneQueueService      *services.FinetuneQueueService
 }
 
 func (uc *CreateFinetuneUseCaseImpl) Execute(ctx context.Context, command in.CreateFinetuneCommand) (*entities.Finetune, error) {
@@ -55,6 +58,29 @@ func (uc *CreateFinetuneUseCaseImpl) Execute(ctx context.Context, command in.Cre
 		return nil, err
 	}
 
+	if err := uc.TrainingDatasetService.ValidateMinimumQualityScore(command.TrainingDatasetMinQualityScore); err != nil {
+		return nil, err
+	}
+
+	hyperparameters := uc.FinetuneService.ResolveHyperparameters(command.Hyperparameters)
+	if err := uc.FinetuneService.ValidateHyperparameters(hyperparameters); err != nil {
+		return nil, err
+	}
+
+	// The backend has to be enabled in this environment, the job would fail in the dispatcher otherwise
+	backend := uc.FinetuneBackends.Default()
+	if command.Backend != nil {
+		backend = *command.Backend
+	}
+	if _, err := uc.FinetuneBackends.Get(backend); err != nil {
+		return nil, err
+	}
+
+	priority := uc.FinetuneQueueService.ResolvePriority(command.Priority)
+	if err := uc.FinetuneQueueService.ValidatePriority(priority); err != nil {
+		return nil, err
+	}
+
 	// Get next version number
 	version, err := uc.FinetuneRepository.GetNextVersion(ctx, command.ProjectID)
 	if err != nil {
@@ -73,42 +99,60 @@ func (uc *CreateFinetuneUseCaseImpl) Execute(ctx context.Context, command in.Cre
 		command.BaseModelName,
 		command.TrainingDatasetNumberExamples,
 		command.TrainingDatasetSelectRandom,
+		command.TrainingDatasetMinQualityScore,
+		hyperparameters,
+		backend,
+		priority,
 	)
 
-	// Save to repository
-	err = uc.FinetuneRepository.Create(ctx, finetune)
-	if err != nil {
-		return nil, err
-	}
-
-	// Select subset of training data
+	// Select subset of training data, validation and test items are held out
+	activeData := uc.TrainingDatasetService.ActiveTrainingDataItems(trainingDataset.Data)
 	selectedData := uc.TrainingDatasetService.SelectTrainingDataSubset(
-		trainingDataset.Data,
+		uc.TrainingDatasetService.FilterTrainingDataItemsBySplit(activeData, entities.TrainingDataItemSplitTrain),
 		command.TrainingDatasetNumberExamples,
 		command.TrainingDatasetSelectRandom,
+		command.TrainingDatasetMinQualityScore,
 	)
 
-	// Convert training data to finetune job format
-	jobData := uc.TrainingDatasetService.ConvertToFinetuneJobData(
-		selectedData,
-		trainingDataset.FieldNames,
-		trainingDataset.InputField,
-	)
+	validationItems := uc.TrainingDatasetService.FilterTrainingDataItemsBySplit(activeData, entities.TrainingDataItemSplitValidation)
+
+	// Redact personal data before it leaves the platform, the report covers everything sent to the job
+	var piiReport *entities.PIIReport
+	if command.RedactPII {
+		itemsToSend := append(append([]entities.TrainingDataItem{}, selectedData...), validationItems...)
+		redactedItems, report := uc.PIIService.ScanTrainingDataItems(trainingDataset.ID, itemsToSend, trainingDataset.FieldNames, true)
+		report.FinetuneID = &finetune.ID
+		piiReport = report
+		selectedData = redactedItems[:len(selectedData)]
+		validationItems = redactedItems[len(selectedData):]
+	}
 
-	// Create finetune job
+	// Create finetune job, conversations are sent in the chat format with the system prompt of the dataset
 	finetuneJob := entities.FinetuneJob{
 		FinetuneID:        finetune.ID.String(),
 		TrainingDatasetID: trainingDataset.ID.String(),
+		DataFormat:        entities.FinetuneJobDataFormatRecords,
 		InputField:        trainingDataset.InputField,
 		OutputField:       trainingDataset.OutputField,
 		UserID:            command.UserID.String(),
-		TrainingData:      jobData,
+		Hyperparameters:   hyperparameters,
 	}
-
-	// Submit job to S3
-	s3Key, err := uc.FinetuneJobClient.SubmitJob(ctx, finetuneJob)
-	if err != nil {
-		return nil, err
+	if trainingDataset.Type == entities.TrainingDatasetTypeConversation {
+		finetuneJob.DataFormat = entities.FinetuneJobDataFormatChat
+		finetuneJob.TrainingData = uc.TrainingDatasetService.ConvertConversationsToFinetuneJobData(trainingDataset, selectedData)
+		// The validation split is always sent completely so evaluations are comparable between finetunes
+		finetuneJob.ValidationData = uc.TrainingDatasetService.ConvertConversationsToFinetuneJobData(trainingDataset, validationItems)
+	} else {
+		finetuneJob.TrainingData = uc.TrainingDatasetService.ConvertToFinetuneJobData(
+			selectedData,
+			trainingDataset.FieldNames,
+			trainingDataset.InputField,
+		)
+		finetuneJob.ValidationData = uc.TrainingDatasetService.ConvertToFinetuneJobData(
+			validationItems,
+			trainingDataset.FieldNames,
+			trainingDataset.InputField,
+		)
 	}
 
 	// Get corpus information for documents S3 path
@@ -126,8 +170,25 @@ func (uc *CreateFinetuneUseCaseImpl) Execute(ctx context.Context, command in.Cre
 		corpusS3Path = ""
 	}
 
-	// Start finetune job on Runpod
-	err = uc.RunpodClient.StartFinetuneJob(ctx, s3Key, corpusS3Path, command.BaseModelName, modelName, finetune.ID.String())
+	// The job is stored with the finetune, the outbox dispatcher uploads it to S3 and starts it on the backend once the
+	// scheduler has dispatched the finetune
+	outboxJob, err := uc.OutboxService.NewFinetuneOutboxJob(finetune.ID, entities.FinetuneOutboxPayload{
+		Job:             finetuneJob,
+		DocumentsS3Path: corpusS3Path,
+		BaseModelName:   command.BaseModelName,
+		ModelName:       modelName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository, a failed request leaves neither the finetune nor its report or history behind
+	message := fmt.Sprintf("Queued with priority %s for the %s backend", priority, backend)
+	err = uc.FinetuneRepository.CreateWithOutboxJob(ctx, finetune, outboxJob, piiReport, &entities.FinetuneDispatchEvent{
+		FinetuneID: finetune.ID,
+		Type:       entities.FinetuneDispatchEventQueued,
+		Message:    &message,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,31 @@
+package use_cases
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+)
+
+type CreatePromptVersionUseCaseImpl struct {
+	ProjectService *services.ProjectService
+	PromptService  *services.PromptService
+}
+
+func (uc *CreatePromptVersionUseCaseImpl) CreatePromptVersion(ctx context.Context, command in.CreatePromptVersionCommand) (*in.CreatePromptVersionResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	prompt, created, err := uc.PromptService.SavePromptVersion(ctx, command.ProjectID, command.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	return &in.CreatePromptVersionResult{
+		Prompt:  prompt,
+		Created: created,
+	}, nil
+}
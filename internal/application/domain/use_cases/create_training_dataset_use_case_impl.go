@@ -3,13 +3,13 @@ package use_cases
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 
 	"ai-platform/internal/application/domain/entities"
 	"ai-platform/internal/application/domain/services"
 	"ai-platform/internal/application/port/in"
-	"ai-platform/internal/application/port/out/clients"
 	"ai-platform/internal/application/port/out/persistence"
 )
 
@@ -17,9 +17,12 @@ type CreateTrainingDatasetUseCaseImpl struct {
 	TrainingDatasetRepository persistence.TrainingDatasetRepository
 	ProjectRepository         persistence.ProjectRepository
 	CorpusRepository          persistence.CorpusRepository
+	CorpusService             *services.CorpusService
+	CorpusChunkingService     *services.CorpusChunkingService
 	PromptRepository          persistence.PromptRepository
+	PromptService             *services.PromptService
 	TrainingDatasetService    *services.TrainingDatasetService
-	TrainingDatasetJobClient  clients.TrainingDatasetJobClient
+	OutboxService             *services.OutboxService
 }
 
 
@@ -37,10 +40,15 @@ func (uc *CreateTrainingDatasetUseCaseImpl) Execute(ctx context.Context, command
 		return nil, err
 	}
 
-	// Verify corpus exists (assuming it exists as stated in requirements)
+	// Verify corpus exists, the corpora of the user take precedence over shared corpora with the same name
 	var corpus *entities.Corpus
-	if (command.CorpusName != "") {
-		corpus, err = uc.CorpusRepository.GetByName(ctx, command.CorpusName)
+	if command.CorpusID != nil {
+		corpus, err = uc.CorpusService.GetCorpus(ctx, *command.CorpusID, command.UserID)
+		if err != nil {
+			return nil, err
+		}
+	} else if command.CorpusName != "" {
+		corpus, err = uc.CorpusRepository.GetByNameForOwner(ctx, command.CorpusName, command.UserID)
 		if err != nil {
 			return nil, err
 		}
@@ -49,13 +57,30 @@ func (uc *CreateTrainingDatasetUseCaseImpl) Execute(ctx context.Context, command
 		}
 	}
 
-	// Create prompt entity
-	prompt := &entities.Prompt{
-		ID:      uuid.New(),
-		Version: 1,
-		Text:    command.GeneratePrompt,
+	// Split the corpus before anything is created, so that the chunks exist when the runner reports item offsets
+	if command.Chunking != nil {
+		if corpus == nil {
+			return nil, errors.New("chunking requires a corpus")
+		}
+		if err := uc.CorpusChunkingService.ValidateChunkingConfig(*command.Chunking); err != nil {
+			return nil, err
+		}
+		if _, err := uc.CorpusChunkingService.ChunkCorpus(ctx, corpus, *command.Chunking); err != nil {
+			return nil, err
+		}
+	}
+
+	// An edited prompt becomes the next prompt version of the project, it is stored with the training dataset. An
+	// unchanged prompt is reused.
+	prompt, created, err := uc.PromptService.PreparePromptVersion(ctx, command.ProjectID, command.GeneratePrompt)
+	if err != nil {
+		return nil, err
+	}
+	var newPrompt *entities.Prompt
+	if created {
+		newPrompt = prompt
 	}
-	err = uc.PromptRepository.Create(ctx, prompt)
+	prompts, err := uc.PromptRepository.GetByProjectID(ctx, command.ProjectID)
 	if err != nil {
 		return nil, err
 	}
@@ -73,32 +98,53 @@ func (uc *CreateTrainingDatasetUseCaseImpl) Execute(ctx context.Context, command
 	if corpus != nil {
 		corpusID = &corpus.ID
 	}
-	trainingDataset, err := uc.TrainingDatasetService.CreateTrainingDataset(
-		command.ProjectID,
-		corpusID,
-		prompt.ID,
-		command.InputField,
-		command.OutputField,
-		command.JSONObjectFields,
-		command.ExpectedOutputSizeChars,
-		command.LanguageISO,
-		command.FieldNames,
-		command.GenerateExamplesNumber,
-	)
+	var trainingDataset *entities.TrainingDataset
+	switch command.Type {
+	case entities.TrainingDatasetTypeConversation:
+		if command.Conversation == nil {
+			return nil, errors.New("conversation is required for conversation datasets")
+		}
+		trainingDataset, err = uc.TrainingDatasetService.CreateConversationTrainingDataset(
+			command.ProjectID,
+			corpusID,
+			prompt.ID,
+			*command.Conversation,
+			command.ExpectedOutputSizeChars,
+			command.LanguageISO,
+			command.GenerateExamplesNumber,
+		)
+	case "", entities.TrainingDatasetTypeRecords:
+		if command.JSONObjectFields == nil {
+			return nil, errors.New("json_object_fields is required")
+		}
+		trainingDataset, err = uc.TrainingDatasetService.CreateTrainingDataset(
+			command.ProjectID,
+			corpusID,
+			prompt.ID,
+			command.InputField,
+			command.OutputField,
+			command.JSONObjectFields,
+			command.ExpectedOutputSizeChars,
+			command.LanguageISO,
+			command.FieldNames,
+			command.GenerateExamplesNumber,
+		)
+	default:
+		return nil, fmt.Errorf("unsupported training dataset type: %s", command.Type)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	// Set the correct version
 	trainingDataset.Version = nextVersion
+	trainingDataset.GeneratePromptHistoryIDs = uc.PromptService.PromptHistoryIDs(prompts, prompt)
+	trainingDataset.ChunkingConfig = command.Chunking
+	// The model is stored so that a resumed generation runs with the same model
+	trainingDataset.GenerateModel = &command.GenerateModel
+	trainingDataset.GenerateModelRunner = &command.GenerateModelRunner
 
-	// Save to repository
-	err = uc.TrainingDatasetRepository.Create(ctx, trainingDataset)
-	if err != nil {
-		return nil, err
-	}
-
-	// Submit job to S3
+	// The job is stored with the training dataset and submitted to S3 by the outbox dispatcher
 	var corpusS3Path string
 	var corpusFilesSubset []string
 
@@ -126,13 +172,19 @@ func (uc *CreateTrainingDatasetUseCaseImpl) Execute(ctx context.Context, command
 		OutputField:             command.OutputField,
 		JSONObjectFields:        command.JSONObjectFields,
 		ExpectedOutputSizeChars: command.ExpectedOutputSizeChars,
+		Chunking:                command.Chunking,
+		Conversation:            trainingDataset.Conversation,
 	}
 
-	err = uc.TrainingDatasetJobClient.SubmitJob(ctx, job)
+	outboxJob, err := uc.OutboxService.NewTrainingDatasetOutboxJob(trainingDataset.ID, job)
 	if err != nil {
-		// Log error but don't fail the training dataset creation
-		// In production, you might want to implement retry logic or store the job for later submission
-		// For now, we'll continue successfully
+		return nil, err
+	}
+
+	// Save to repository
+	err = uc.TrainingDatasetRepository.CreateWithOutboxJob(ctx, trainingDataset, outboxJob, newPrompt)
+	if err != nil {
+		return nil, err
 	}
 
 	return trainingDataset, nil
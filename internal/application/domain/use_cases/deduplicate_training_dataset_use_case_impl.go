@@ -0,0 +1,64 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type DeduplicateTrainingDatasetUseCaseImpl struct {
+	ProjectService                   *services.ProjectService
+	TrainingDatasetService           *services.TrainingDatasetService
+	TrainingDataDeduplicationService *services.TrainingDataDeduplicationService
+	TrainingDatasetRepository        persistence.TrainingDatasetRepository
+}
+
+func (uc *DeduplicateTrainingDatasetUseCaseImpl) DeduplicateTrainingDataset(ctx context.Context, command in.DeduplicateTrainingDatasetCommand) (*in.DeduplicateTrainingDatasetResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := command.SimilarityThreshold
+	if threshold == 0 {
+		threshold = services.DefaultDuplicateSimilarityThreshold
+	}
+	if err := uc.TrainingDataDeduplicationService.ValidateSimilarityThreshold(threshold); err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	activeItems := uc.TrainingDatasetService.ActiveTrainingDataItems(trainingDataset.Data)
+	clusters := uc.TrainingDataDeduplicationService.FindDuplicates(activeItems, trainingDataset.FieldNames, trainingDataset.InputField, threshold)
+	reasons := uc.TrainingDataDeduplicationService.DuplicateReasons(clusters)
+
+	if !command.DryRun && len(reasons) > 0 {
+		err = uc.TrainingDatasetRepository.MarkItemsDeleted(ctx, trainingDataset.ID, reasons)
+		if err != nil {
+			return nil, fmt.Errorf("failed to mark duplicate training data items: %w", err)
+		}
+	}
+
+	duplicatesMarked := 0
+	if !command.DryRun {
+		duplicatesMarked = len(reasons)
+	}
+
+	return &in.DeduplicateTrainingDatasetResult{
+		Clusters:         clusters,
+		DuplicatesMarked: duplicatesMarked,
+		ItemsChecked:     len(activeItems),
+	}, nil
+}
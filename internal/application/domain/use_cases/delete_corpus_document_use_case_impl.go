@@ -0,0 +1,51 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type DeleteCorpusDocumentUseCaseImpl struct {
+	CorpusRepository         persistence.CorpusRepository
+	CorpusService            *services.CorpusService
+	CorpusDocumentRepository persistence.CorpusDocumentRepository
+	CorpusStorageClient      clients.CorpusStorageClient
+}
+
+func (uc *DeleteCorpusDocumentUseCaseImpl) DeleteCorpusDocument(ctx context.Context, command in.DeleteCorpusDocumentCommand) error {
+	corpus, err := uc.CorpusService.GetOwnedCorpus(ctx, command.CorpusID, command.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	document, err := uc.CorpusDocumentRepository.GetByFileName(ctx, corpus.ID, command.FileName)
+	if err != nil {
+		return fmt.Errorf("failed to get corpus document: %w", err)
+	}
+	if document == nil {
+		return errors.New("document not found")
+	}
+
+	if err := uc.CorpusStorageClient.DeleteDocument(ctx, corpus.S3Path, document.FileName); err != nil {
+		return err
+	}
+
+	if err := uc.CorpusDocumentRepository.DeleteByFileName(ctx, corpus.ID, document.FileName); err != nil {
+		return fmt.Errorf("failed to delete corpus document: %w", err)
+	}
+
+	// The removed file can no longer be part of the files subset
+	if uc.CorpusService.RemoveFromFilesSubset(corpus, document.FileName) {
+		if err := uc.CorpusRepository.Update(ctx, corpus); err != nil {
+			return fmt.Errorf("failed to update corpus: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type DeleteTrainingDataItemUseCaseImpl struct {
+	ProjectService            *services.ProjectService
+	TrainingDatasetRepository persistence.TrainingDatasetRepository
+}
+
+func (uc *DeleteTrainingDataItemUseCaseImpl) DeleteTrainingDataItem(ctx context.Context, command in.DeleteTrainingDataItemCommand) error {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return errors.New("training dataset not found")
+	}
+
+	item, err := uc.TrainingDatasetRepository.GetItemByID(ctx, trainingDataset.ID, command.TrainingDataItemID)
+	if err != nil {
+		return fmt.Errorf("failed to get training data item: %w", err)
+	}
+	if item == nil {
+		return errors.New("training data item not found")
+	}
+
+	// Items are only soft deleted, so they can be restored later
+	err = uc.TrainingDatasetRepository.UpdateItemDeleted(ctx, trainingDataset.ID, item.ID, true)
+	if err != nil {
+		return fmt.Errorf("failed to delete training data item: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type DispatchOutboxJobsUseCaseImpl struct {
+	OutboxService             *services.OutboxService
+	StatusTransitionService   *services.StatusTransitionService
+	OutboxJobRepository       persistence.OutboxJobRepository
+	TrainingDatasetRepository persistence.TrainingDatasetRepository
+	FinetuneRepository        persistence.FinetuneRepository
+	TrainingDatasetJobClient  clients.TrainingDatasetJobClient
+	FinetuneJobClient         clients.FinetuneJobClient
+	FinetuneBackends          clients.FinetuneBackends
+	// FinetuneDispatchEventRepository records the attempts to start the finetunes on their backend
+	FinetuneDispatchEventRepository persistence.FinetuneDispatchEventRepository
+}
+
+// errOutboxJobCancelled is returned by the delivery when the entity does not wait for its job anymore
+var errOutboxJobCancelled = errors.New("outbox job cancelled")
+
+func (uc *DispatchOutboxJobsUseCaseImpl) Execute(ctx context.Context, command in.DispatchOutboxJobsCommand) (*in.DispatchOutboxJobsResult, error) {
+	jobs, err := uc.OutboxJobRepository.ClaimDue(ctx, command.Limit, time.Now().Add(command.Lease))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox jobs: %w", err)
+	}
+
+	result := &in.DispatchOutboxJobsResult{}
+	var errs []error
+	for i := range jobs {
+		if ctx.Err() != nil {
+			break
+		}
+
+		job := &jobs[i]
+		if err := uc.dispatch(ctx, job, result); err != nil {
+			errs = append(errs, fmt.Errorf("outbox job %s: %w", job.ID, err))
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// dispatch delivers a single job and records the outcome, the returned error is only about recording it
+func (uc *DispatchOutboxJobsUseCaseImpl) dispatch(ctx context.Context, job *entities.OutboxJob, result *in.DispatchOutboxJobsResult) error {
+	deliveryErr := uc.deliver(ctx, job)
+	now := time.Now()
+
+	failed := false
+	switch {
+	case deliveryErr == nil:
+		uc.OutboxService.RecordDelivered(job, now)
+		result.Delivered++
+	case errors.Is(deliveryErr, errOutboxJobCancelled):
+		job.Status = entities.OutboxJobStatusCancelled
+		result.Cancelled++
+	case uc.OutboxService.RecordFailedAttempt(job, deliveryErr, now):
+		failed = true
+		result.Failed++
+	default:
+		result.Retrying++
+	}
+
+	if err := uc.OutboxJobRepository.Update(ctx, job); err != nil {
+		return fmt.Errorf("failed to update outbox job: %w", err)
+	}
+
+	if failed {
+		return uc.failEntity(ctx, job)
+	}
+	return nil
+}
+
+func (uc *DispatchOutboxJobsUseCaseImpl) deliver(ctx context.Context, job *entities.OutboxJob) error {
+	switch job.Type {
+	case entities.OutboxJobTypeTrainingDataset:
+		return uc.deliverTrainingDatasetJob(ctx, job)
+	case entities.OutboxJobTypeFinetune:
+		return uc.deliverFinetuneJob(ctx, job)
+	default:
+		return fmt.Errorf("unknown outbox job type %s", job.Type)
+	}
+}
+
+func (uc *DispatchOutboxJobsUseCaseImpl) deliverTrainingDatasetJob(ctx context.Context, job *entities.OutboxJob) error {
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, job.EntityID)
+	if err != nil {
+		return fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	// An aborted training dataset must not be generated anymore
+	if trainingDataset == nil || trainingDataset.Status != entities.TrainingDatasetStatusPlanning {
+		return errOutboxJobCancelled
+	}
+
+	var trainingDatasetJob entities.TrainingDatasetJob
+	if err := json.Unmarshal(job.Payload, &trainingDatasetJob); err != nil {
+		return fmt.Errorf("failed to unmarshal training dataset job: %w", err)
+	}
+
+	return uc.TrainingDatasetJobClient.SubmitJob(ctx, trainingDatasetJob)
+}
+
+func (uc *DispatchOutboxJobsUseCaseImpl) deliverFinetuneJob(ctx context.Context, job *entities.OutboxJob) error {
+	finetune, err := uc.FinetuneRepository.GetByID(ctx, job.EntityID)
+	if err != nil {
+		return fmt.Errorf("failed to get finetune: %w", err)
+	}
+	if finetune == nil {
+		return errOutboxJobCancelled
+	}
+	// An earlier delivery started the backend job but could not mark the outbox job as delivered, starting it again
+	// would pay for a second job
+	if finetune.BackendJobID != nil {
+		return nil
+	}
+	if finetune.Status != entities.FinetuneStatusPlanning {
+		return errOutboxJobCancelled
+	}
+
+	var payload entities.FinetuneOutboxPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal finetune job: %w", err)
+	}
+
+	backend, err := uc.FinetuneBackends.Get(finetune.Backend)
+	if err != nil {
+		return err
+	}
+
+	backendJobID, err := uc.submitFinetuneJob(ctx, backend, finetune, payload)
+	if err != nil {
+		uc.recordDispatchEvent(ctx, finetune.ID, entities.FinetuneDispatchEventSubmitFailed, err.Error())
+		return err
+	}
+	uc.recordDispatchEvent(ctx, finetune.ID, entities.FinetuneDispatchEventSubmitted, fmt.Sprintf("Started %s job %s", finetune.Backend, backendJobID))
+
+	// The backend job is started at this point, a retry would start a second one, so the job counts as delivered even
+	// when the job ID can not be stored
+	stored, err := uc.FinetuneRepository.SetBackendJobID(ctx, finetune.ID, backendJobID)
+	if err != nil {
+		lastError := fmt.Sprintf("failed to store %s job %s: %v", finetune.Backend, backendJobID, err)
+		job.LastError = &lastError
+		return nil
+	}
+	if !stored {
+		// Another delivery of the same job stored its job ID first, this job is the duplicate
+		uc.cancelBackendJob(ctx, backend, finetune, backendJobID, fmt.Sprintf("Cancelled duplicate %s job %s", finetune.Backend, backendJobID))
+		return nil
+	}
+
+	// An abort during the submit found no job ID to cancel, so the job it missed is cancelled here
+	current, err := uc.FinetuneRepository.GetByID(ctx, finetune.ID)
+	if err != nil {
+		log.Printf("failed to check finetune %s after starting %s job %s: %v", finetune.ID, finetune.Backend, backendJobID, err)
+		return nil
+	}
+	if current != nil && current.Status == entities.FinetuneStatusAborted {
+		uc.cancelBackendJob(ctx, backend, finetune, backendJobID, fmt.Sprintf("Cancelled %s job %s that was started during the abort", finetune.Backend, backendJobID))
+	}
+
+	return nil
+}
+
+// cancelBackendJob only logs when the job can not be cancelled, the outbox job is delivered either way
+func (uc *DispatchOutboxJobsUseCaseImpl) cancelBackendJob(ctx context.Context, backend clients.FinetuneBackend, finetune *entities.Finetune, backendJobID string, message string) {
+	if err := backend.Cancel(ctx, backendJobID); err != nil {
+		log.Printf("failed to cancel %s job %s of finetune %s: %v", finetune.Backend, backendJobID, finetune.ID, err)
+		return
+	}
+	uc.recordDispatchEvent(ctx, finetune.ID, entities.FinetuneDispatchEventCancelled, message)
+}
+
+// submitFinetuneJob uploads the job to S3 and starts it on the backend of the finetune
+func (uc *DispatchOutboxJobsUseCaseImpl) submitFinetuneJob(ctx context.Context, backend clients.FinetuneBackend, finetune *entities.Finetune, payload entities.FinetuneOutboxPayload) (string, error) {
+	s3Key, err := uc.FinetuneJobClient.SubmitJob(ctx, payload.Job)
+	if err != nil {
+		return "", err
+	}
+
+	return backend.Submit(ctx, clients.FinetuneBackendJob{
+		FinetuneID:      finetune.ID,
+		JobS3Key:        s3Key,
+		DocumentsS3Path: payload.DocumentsS3Path,
+		BaseModelName:   payload.BaseModelName,
+		ModelName:       payload.ModelName,
+		Hyperparameters: payload.Job.Hyperparameters,
+	})
+}
+
+// recordDispatchEvent only logs when the event can not be stored, the history must not change the outcome of the
+// delivery
+func (uc *DispatchOutboxJobsUseCaseImpl) recordDispatchEvent(ctx context.Context, finetuneID uuid.UUID, eventType entities.FinetuneDispatchEventType, message string) {
+	if err := uc.FinetuneDispatchEventRepository.Create(ctx, &entities.FinetuneDispatchEvent{
+		FinetuneID: finetuneID,
+		Type:       eventType,
+		Message:    &message,
+	}); err != nil {
+		log.Printf("failed to record dispatch event of finetune %s: %v", finetuneID, err)
+	}
+}
+
+// failEntity moves the training dataset or finetune of a permanently failed job to FAILED with the reason
+func (uc *DispatchOutboxJobsUseCaseImpl) failEntity(ctx context.Context, job *entities.OutboxJob) error {
+	reason := uc.OutboxService.FailureReason(job)
+
+	switch job.Type {
+	case entities.OutboxJobTypeTrainingDataset:
+		trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, job.EntityID)
+		if err != nil {
+			return fmt.Errorf("failed to get training dataset: %w", err)
+		}
+		if trainingDataset == nil || uc.StatusTransitionService.ValidateTrainingDatasetStatusTransition(trainingDataset.Status, entities.TrainingDatasetStatusFailed) != nil {
+			return nil
+		}
+		if err := uc.TrainingDatasetRepository.UpdateStatusWithReason(ctx, trainingDataset.ID, entities.TrainingDatasetStatusFailed, reason); err != nil {
+			return fmt.Errorf("failed to update training dataset status: %w", err)
+		}
+	case entities.OutboxJobTypeFinetune:
+		finetune, err := uc.FinetuneRepository.GetByID(ctx, job.EntityID)
+		if err != nil {
+			return fmt.Errorf("failed to get finetune: %w", err)
+		}
+		if finetune == nil || uc.StatusTransitionService.ValidateFinetuneStatusTransition(finetune.Status, entities.FinetuneStatusFailed) != nil {
+			return nil
+		}
+		if err := uc.FinetuneRepository.UpdateStatusWithReason(ctx, finetune.ID, entities.FinetuneStatusFailed, reason); err != nil {
+			return fmt.Errorf("failed to update finetune status: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type mockOutboxJobRepository struct {
+	jobs    []entities.OutboxJob
+	updated []entities.OutboxJob
+}
+
+func (m *mockOutboxJobRepository) ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]entities.OutboxJob, error) {
+	return m.jobs, nil
+}
+
+func (m *mockOutboxJobRepository) Update(ctx context.Context, job *entities.OutboxJob) error {
+	m.updated = append(m.updated, *job)
+	return nil
+}
+
+// mockOutboxTrainingDatasetRepository only implements what the dispatcher uses, other calls panic
+type mockOutboxTrainingDatasetRepository struct {
+	persistence.TrainingDatasetRepository
+	trainingDataset *entities.TrainingDataset
+	failureReason   string
+}
+
+func (m *mockOutboxTrainingDatasetRepository) GetMetadataByID(ctx context.Context, id uuid.UUID) (*entities.TrainingDataset, error) {
+	return m.trainingDataset, nil
+}
+
+func (m *mockOutboxTrainingDatasetRepository) UpdateStatusWithReason(ctx context.Context, id uuid.UUID, status entities.TrainingDatasetStatus, reason string) error {
+	m.trainingDataset.Status = status
+	m.failureReason = reason
+	return nil
+}
+
+type mockOutboxTrainingDatasetJobClient struct {
+	err       error
+	submitted int
+}
+
+func (m *mockOutboxTrainingDatasetJobClient) SubmitJob(ctx context.Context, job entities.TrainingDatasetJob) error {
+	m.submitted++
+	return m.err
+}
+
+func (m *mockOutboxTrainingDatasetJobClient) CancelJob(ctx context.Context, trainingDatasetID string) error {
+	return nil
+}
+
+func newTestDispatchOutboxJobsUseCase(t *testing.T, status entities.TrainingDatasetStatus, attempts int, submitErr error) (*DispatchOutboxJobsUseCaseImpl, *mockOutboxJobRepository, *mockOutboxTrainingDatasetRepository, *mockOutboxTrainingDatasetJobClient) {
+	outboxService := &services.OutboxService{}
+	trainingDatasetID := uuid.New()
+	job, err := outboxService.NewTrainingDatasetOutboxJob(trainingDatasetID, entities.TrainingDatasetJob{TrainingDatasetID: trainingDatasetID.String()})
+	require.NoError(t, err)
+	job.Attempts = attempts
+
+	outboxJobRepo := &mockOutboxJobRepository{jobs: []entities.OutboxJob{*job}}
+	trainingDatasetRepo := &mockOutboxTrainingDatasetRepository{
+		trainingDataset: &entities.TrainingDataset{ID: trainingDatasetID, Status: status},
+	}
+	jobClient := &mockOutboxTrainingDatasetJobClient{err: submitErr}
+
+	useCase := &DispatchOutboxJobsUseCaseImpl{
+		OutboxService:             outboxService,
+		StatusTransitionService:   &services.StatusTransitionService{},
+		OutboxJobRepository:       outboxJobRepo,
+		TrainingDatasetRepository: trainingDatasetRepo,
+		TrainingDatasetJobClient:  jobClient,
+	}
+	return useCase, outboxJobRepo, trainingDatasetRepo, jobClient
+}
+
+func TestDispatchOutboxJobsUseCaseImpl_Delivered(t *testing.T) {
+	useCase, outboxJobRepo, _, jobClient := newTestDispatchOutboxJobsUseCase(t, entities.TrainingDatasetStatusPlanning, 0, nil)
+
+	result, err := useCase.Execute(context.Background(), in.DispatchOutboxJobsCommand{Limit: 10, Lease: time.Minute})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Delivered)
+	assert.Equal(t, 1, jobClient.submitted)
+	require.Len(t, outboxJobRepo.updated, 1)
+	assert.Equal(t, entities.OutboxJobStatusDelivered, outboxJobRepo.updated[0].Status)
+	assert.NotNil(t, outboxJobRepo.updated[0].DeliveredAt)
+}
+
+func TestDispatchOutboxJobsUseCaseImpl_Retrying(t *testing.T) {
+	useCase, outboxJobRepo, trainingDatasetRepo, _ := newTestDispatchOutboxJobsUseCase(t, entities.TrainingDatasetStatusPlanning, 0, errors.New("failed to upload job to S3: timeout"))
+
+	result, err := useCase.Execute(context.Background(), in.DispatchOutboxJobsCommand{Limit: 10, Lease: time.Minute})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Retrying)
+	require.Len(t, outboxJobRepo.updated, 1)
+	assert.Equal(t, entities.OutboxJobStatusPending, outboxJobRepo.updated[0].Status)
+	assert.Equal(t, 1, outboxJobRepo.updated[0].Attempts)
+	assert.Equal(t, "failed to upload job to S3: timeout", *outboxJobRepo.updated[0].LastError)
+	assert.Equal(t, entities.TrainingDatasetStatusPlanning, trainingDatasetRepo.trainingDataset.Status)
+}
+
+func TestDispatchOutboxJobsUseCaseImpl_FailedPermanently(t *testing.T) {
+	useCase, outboxJobRepo, trainingDatasetRepo, _ := newTestDispatchOutboxJobsUseCase(t, entities.TrainingDatasetStatusPlanning, services.OutboxMaxAttempts-1, errors.New("access denied"))
+
+	result, err := useCase.Execute(context.Background(), in.DispatchOutboxJobsCommand{Limit: 10, Lease: time.Minute})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Failed)
+	assert.Equal(t, entities.OutboxJobStatusFailed, outboxJobRepo.updated[0].Status)
+	assert.Equal(t, entities.TrainingDatasetStatusFailed, trainingDatasetRepo.trainingDataset.Status)
+	assert.Contains(t, trainingDatasetRepo.failureReason, "access denied")
+}
+
+func TestDispatchOutboxJobsUseCaseImpl_CancelledWhenAborted(t *testing.T) {
+	useCase, outboxJobRepo, _, jobClient := newTestDispatchOutboxJobsUseCase(t, entities.TrainingDatasetStatusAborted, 0, nil)
+
+	result, err := useCase.Execute(context.Background(), in.DispatchOutboxJobsCommand{Limit: 10, Lease: time.Minute})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Cancelled)
+	assert.Equal(t, 0, jobClient.submitted)
+	assert.Equal(t, entities.OutboxJobStatusCancelled, outboxJobRepo.updated[0].Status)
+}
+
+// mockOutboxFinetuneRepository only implements what the dispatcher uses, other calls like Update panic
+type mockOutboxFinetuneRepository struct {
+	persistence.FinetuneRepository
+	finetune *entities.Finetune
+}
+
+func (m *mockOutboxFinetuneRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Finetune, error) {
+	finetune := *m.finetune
+	return &finetune, nil
+}
+
+func (m *mockOutboxFinetuneRepository) SetBackendJobID(ctx context.Context, id uuid.UUID, backendJobID string) (bool, error) {
+	if m.finetune.BackendJobID != nil {
+		return false, nil
+	}
+	m.finetune.BackendJobID = &backendJobID
+	return true, nil
+}
+
+type mockOutboxFinetuneJobClient struct{}
+
+func (m *mockOutboxFinetuneJobClient) SubmitJob(ctx context.Context, job entities.FinetuneJob) (string, error) {
+	return "jobs/finetune.json", nil
+}
+
+type mockOutboxFinetuneBackend struct {
+	// onSubmit runs while the job is submitted, e.g. to abort the finetune meanwhile
+	onSubmit  func()
+	submitted int
+	cancelled []string
+}
+
+func (m *mockOutboxFinetuneBackend) Submit(ctx context.Context, job clients.FinetuneBackendJob) (string, error) {
+	m.submitted++
+	if m.onSubmit != nil {
+		m.onSubmit()
+	}
+	return "job-1", nil
+}
+
+func (m *mockOutboxFinetuneBackend) Cancel(ctx context.Context, jobID string) error {
+	m.cancelled = append(m.cancelled, jobID)
+	return nil
+}
+
+func (m *mockOutboxFinetuneBackend) Status(ctx context.Context, jobID string) (clients.FinetuneBackendJobStatus, error) {
+	return clients.FinetuneBackendJobStatusRunning, nil
+}
+
+type mockOutboxFinetuneBackends struct {
+	backend clients.FinetuneBackend
+}
+
+func (m *mockOutboxFinetuneBackends) Get(backendType entities.FinetuneBackendType) (clients.FinetuneBackend, error) {
+	return m.backend, nil
+}
+
+func (m *mockOutboxFinetuneBackends) Default() entities.FinetuneBackendType {
+	return entities.FinetuneBackendLocal
+}
+
+type mockOutboxFinetuneDispatchEventRepository struct {
+	events []entities.FinetuneDispatchEvent
+}
+
+func (m *mockOutboxFinetuneDispatchEventRepository) Create(ctx context.Context, event *entities.FinetuneDispatchEvent) error {
+	m.events = append(m.events, *event)
+	return nil
+}
+
+func (m *mockOutboxFinetuneDispatchEventRepository) ListByFinetuneID(ctx context.Context, finetuneID uuid.UUID) ([]*entities.FinetuneDispatchEvent, error) {
+	return nil, nil
+}
+
+func newTestDispatchFinetuneOutboxJobsUseCase(t *testing.T, finetune *entities.Finetune) (*DispatchOutboxJobsUseCaseImpl, *mockOutboxJobRepository, *mockOutboxFinetuneRepository, *mockOutboxFinetuneBackend) {
+	outboxService := &services.OutboxService{}
+	job, err := outboxService.NewFinetuneOutboxJob(finetune.ID, entities.FinetuneOutboxPayload{ModelName: "qwen3_4b_test_v1"})
+	require.NoError(t, err)
+	job.Status = entities.OutboxJobStatusPending
+
+	outboxJobRepo := &mockOutboxJobRepository{jobs: []entities.OutboxJob{*job}}
+	finetuneRepo := &mockOutboxFinetuneRepository{finetune: finetune}
+	backend := &mockOutboxFinetuneBackend{}
+
+	useCase := &DispatchOutboxJobsUseCaseImpl{
+		OutboxService:                   outboxService,
+		StatusTransitionService:         &services.StatusTransitionService{},
+		OutboxJobRepository:             outboxJobRepo,
+		FinetuneRepository:              finetuneRepo,
+		FinetuneJobClient:               &mockOutboxFinetuneJobClient{},
+		FinetuneBackends:                &mockOutboxFinetuneBackends{backend: backend},
+		FinetuneDispatchEventRepository: &mockOutboxFinetuneDispatchEventRepository{},
+	}
+	return useCase, outboxJobRepo, finetuneRepo, backend
+}
+
+func TestDispatchOutboxJobsUseCaseImpl_FinetuneDelivered(t *testing.T) {
+	finetune := &entities.Finetune{ID: uuid.New(), Status: entities.FinetuneStatusPlanning, Backend: entities.FinetuneBackendLocal}
+	useCase, outboxJobRepo, finetuneRepo, backend := newTestDispatchFinetuneOutboxJobsUseCase(t, finetune)
+
+	result, err := useCase.Execute(context.Background(), in.DispatchOutboxJobsCommand{Limit: 10, Lease: time.Minute})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Delivered)
+	assert.Equal(t, 1, backend.submitted)
+	assert.Empty(t, backend.cancelled)
+	assert.Equal(t, "job-1", *finetuneRepo.finetune.BackendJobID)
+	assert.Equal(t, entities.OutboxJobStatusDelivered, outboxJobRepo.updated[0].Status)
+}
+
+func TestDispatchOutboxJobsUseCaseImpl_FinetuneAbortedDuringSubmit(t *testing.T) {
+	finetune := &entities.Finetune{ID: uuid.New(), Status: entities.FinetuneStatusPlanning, Backend: entities.FinetuneBackendLocal}
+	useCase, _, finetuneRepo, backend := newTestDispatchFinetuneOutboxJobsUseCase(t, finetune)
+	backend.onSubmit = func() {
+		finetuneRepo.finetune.Status = entities.FinetuneStatusAborted
+	}
+
+	_, err := useCase.Execute(context.Background(), in.DispatchOutboxJobsCommand{Limit: 10, Lease: time.Minute})
+
+	// The abort is kept and the job it could not see is cancelled
+	require.NoError(t, err)
+	assert.Equal(t, entities.FinetuneStatusAborted, finetuneRepo.finetune.Status)
+	assert.Equal(t, []string{"job-1"}, backend.cancelled)
+}
+
+func TestDispatchOutboxJobsUseCaseImpl_FinetuneAlreadyStarted(t *testing.T) {
+	backendJobID := "job-0"
+	finetune := &entities.Finetune{ID: uuid.New(), Status: entities.FinetuneStatusRunning, Backend: entities.FinetuneBackendLocal, BackendJobID: &backendJobID}
+	useCase, outboxJobRepo, _, backend := newTestDispatchFinetuneOutboxJobsUseCase(t, finetune)
+
+	result, err := useCase.Execute(context.Background(), in.DispatchOutboxJobsCommand{Limit: 10, Lease: time.Minute})
+
+	// A job whose earlier delivery started the backend job is not started a second time
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Delivered)
+	assert.Equal(t, 0, backend.submitted)
+	assert.Equal(t, entities.OutboxJobStatusDelivered, outboxJobRepo.updated[0].Status)
+}
@@ -32,8 +32,17 @@ func (u *DownloadModelUseCaseImpl) DownloadModel(ctx context.Context, command in
 		return nil, 0, "", fmt.Errorf("finetune %s has no model name", command.FinetuneID)
 	}
 
+	// Downloads are verified against the GGUF recorded when the finetune was done
+	var artifact *clients.ModelArtifact
+	if finetune.ModelFileSizeBytes != nil && finetune.ModelSHA256 != nil {
+		artifact = &clients.ModelArtifact{
+			SizeBytes: *finetune.ModelFileSizeBytes,
+			SHA256:    *finetune.ModelSHA256,
+		}
+	}
+
 	// Download the model from S3
-	reader, contentLength, err := u.DownloadModelClient.DownloadModel(ctx, command.FinetuneID, modelName)
+	reader, contentLength, err := u.DownloadModelClient.DownloadModel(ctx, command.FinetuneID, modelName, artifact)
 	if err != nil {
 		return nil, 0, "", fmt.Errorf("failed to download model: %w", err)
 	}
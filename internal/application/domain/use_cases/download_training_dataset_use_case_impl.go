@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"ai-platform/internal/application/domain/entities"
 	"ai-platform/internal/application/domain/services"
 	"ai-platform/internal/application/port/in"
 	"ai-platform/internal/application/port/out/persistence"
@@ -41,20 +42,66 @@ func (uc *DownloadTrainingDatasetUseCaseImpl) DownloadTrainingDataset(command in
 		return nil, fmt.Errorf("project not found")
 	}
 
-	// Convert data items to CSV format, filtering out deleted items
-	var data [][]string
-	for _, item := range trainingDataset.Data {
-		if !item.Deleted {
-			data = append(data, item.Values)
+	// Conversations have no columns, they are downloaded in the chat format unless JSON lines are requested
+	format := command.Format
+	if format == "" {
+		format = entities.TrainingDatasetExportFormatCSV
+		if trainingDataset.Type == entities.TrainingDatasetTypeConversation {
+			format = entities.TrainingDatasetExportFormatChat
 		}
 	}
+	if err := uc.TrainingDatasetService.ValidateExportFormat(format, trainingDataset.Type); err != nil {
+		return nil, err
+	}
 
-	// Generate filename: dataset_{project_name}_v{version}.csv
-	filename := uc.TrainingDatasetService.GenerateCsvFilename(project.Name, trainingDataset.Version)
+	// Filter out deleted and corrected items
+	activeItems := uc.TrainingDatasetService.ActiveTrainingDataItems(trainingDataset.Data)
+	filename := uc.TrainingDatasetService.GenerateExportFilename(project.Name, trainingDataset.Version, format)
+
+	if format != entities.TrainingDatasetExportFormatCSV {
+		content, err := uc.TrainingDatasetService.ExportTrainingData(format, trainingDataset, activeItems, command.SystemPrompt, command.IncludeMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		contentType := "application/x-ndjson"
+		if format == entities.TrainingDatasetExportFormatAlpaca {
+			contentType = "application/json"
+		}
+
+		return &in.DownloadTrainingDatasetResult{
+			FieldNames:  trainingDataset.FieldNames,
+			Filename:    filename,
+			Content:     content,
+			ContentType: contentType,
+		}, nil
+	}
+
+	// Convert data items to CSV format
+	fieldNames := trainingDataset.FieldNames
+	if command.IncludeMetadata {
+		fieldNames = append(append([]string{}, fieldNames...), "source_document", "source_document_start", "source_document_end")
+	}
+
+	var data [][]string
+	for _, item := range activeItems {
+		row := item.Values
+		if command.IncludeMetadata {
+			row = append(append([]string{}, row...), stringValue(item.SourceDocument), stringValue(item.SourceDocumentStart), stringValue(item.SourceDocumentEnd))
+		}
+		data = append(data, row)
+	}
 
 	return &in.DownloadTrainingDatasetResult{
-		FieldNames: trainingDataset.FieldNames,
+		FieldNames: fieldNames,
 		Data:       data,
 		Filename:   filename,
 	}, nil
 }
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
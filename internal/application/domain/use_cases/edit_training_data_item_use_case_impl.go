@@ -0,0 +1,69 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type EditTrainingDataItemUseCaseImpl struct {
+	ProjectService            *services.ProjectService
+	TrainingDatasetService    *services.TrainingDatasetService
+	TrainingDatasetRepository persistence.TrainingDatasetRepository
+}
+
+func (uc *EditTrainingDataItemUseCaseImpl) EditTrainingDataItem(ctx context.Context, command in.EditTrainingDataItemCommand) (*entities.TrainingDataItem, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	if err := uc.TrainingDatasetService.ValidateTrainingDataItemEdit(trainingDataset, command.Values, command.Messages); err != nil {
+		return nil, err
+	}
+
+	original, err := uc.TrainingDatasetRepository.GetItemByID(ctx, trainingDataset.ID, command.TrainingDataItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training data item: %w", err)
+	}
+	if original == nil {
+		return nil, errors.New("training data item not found")
+	}
+	if original.Deleted {
+		return nil, errors.New("training data item is deleted")
+	}
+
+	// Only the latest item in a correction chain can be edited
+	corrections, err := uc.TrainingDatasetRepository.GetItemCorrections(ctx, trainingDataset.ID, original.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training data item corrections: %w", err)
+	}
+	for _, correction := range corrections {
+		if !correction.Deleted {
+			return nil, errors.New("training data item is already corrected")
+		}
+	}
+
+	// The edit is stored as a new item that corrects the original
+	correction := uc.TrainingDatasetService.CreateCorrection(original, command.Values, command.Messages)
+	err = uc.TrainingDatasetRepository.CreateItems(ctx, trainingDataset.ID, []entities.TrainingDataItem{*correction})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create training data item correction: %w", err)
+	}
+
+	return correction, nil
+}
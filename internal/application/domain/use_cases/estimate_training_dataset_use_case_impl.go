@@ -0,0 +1,94 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+// estimateHistoryLimit is the number of finished generations of the project the estimate is calibrated with
+const estimateHistoryLimit = 10
+
+type EstimateTrainingDatasetUseCaseImpl struct {
+	ProjectService                 *services.ProjectService
+	CorpusService                  *services.CorpusService
+	CorpusChunkingService          *services.CorpusChunkingService
+	TrainingDatasetEstimateService *services.TrainingDatasetEstimateService
+	CorpusRepository               persistence.CorpusRepository
+	CorpusDocumentRepository       persistence.CorpusDocumentRepository
+	TrainingDatasetRepository      persistence.TrainingDatasetRepository
+}
+
+func (uc *EstimateTrainingDatasetUseCaseImpl) EstimateTrainingDataset(ctx context.Context, command in.EstimateTrainingDatasetCommand) (*in.EstimateTrainingDatasetResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The corpus is resolved like in CreateTrainingDataset, the corpora of the user take precedence
+	var corpus *entities.Corpus
+	if command.CorpusID != nil {
+		corpus, err = uc.CorpusService.GetCorpus(ctx, *command.CorpusID, command.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+	} else if command.CorpusName != "" {
+		corpus, err = uc.CorpusRepository.GetByNameForOwner(ctx, command.CorpusName, command.OwnerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get corpus: %w", err)
+		}
+		if corpus == nil {
+			return nil, errors.New("corpus not found")
+		}
+	}
+
+	chunking := uc.CorpusChunkingService.DefaultChunkingConfig()
+	if command.Chunking != nil {
+		if err := uc.CorpusChunkingService.ValidateChunkingConfig(*command.Chunking); err != nil {
+			return nil, err
+		}
+		chunking = *command.Chunking
+	}
+
+	input := entities.TrainingDatasetEstimateInput{
+		GeneratePrompt:          command.GeneratePrompt,
+		JSONObjectFields:        command.JSONObjectFields,
+		ExpectedOutputSizeChars: command.ExpectedOutputSizeChars,
+		GenerateExamplesNumber:  command.GenerateExamplesNumber,
+		Chunking:                chunking,
+	}
+	if corpus != nil {
+		documents, err := uc.CorpusDocumentRepository.GetByCorpusID(ctx, corpus.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get corpus documents: %w", err)
+		}
+		// The document sizes are bytes of the stored files, for PDFs this overestimates the text
+		for _, document := range documents {
+			if corpus.FilesSubset != nil && !slices.Contains(*corpus.FilesSubset, document.FileName) {
+				continue
+			}
+			input.CorpusSizeChars += document.SizeBytes
+		}
+	}
+
+	history, err := uc.TrainingDatasetRepository.ListGenerationHistory(ctx, command.ProjectID, estimateHistoryLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation history: %w", err)
+	}
+
+	estimate, err := uc.TrainingDatasetEstimateService.EstimateTrainingDataset(input, history, command.GPUPricePerHour)
+	if err != nil {
+		return nil, err
+	}
+
+	return &in.EstimateTrainingDatasetResult{
+		Estimate: estimate,
+	}, nil
+}
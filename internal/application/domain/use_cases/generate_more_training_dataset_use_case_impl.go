@@ -0,0 +1,149 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type GenerateMoreTrainingDatasetUseCaseImpl struct {
+	ProjectService                   *services.ProjectService
+	TrainingDatasetService           *services.TrainingDatasetService
+	TrainingDatasetGenerationService *services.TrainingDatasetGenerationService
+	OutboxService                    *services.OutboxService
+	TrainingDatasetRepository        persistence.TrainingDatasetRepository
+	PromptService                    *services.PromptService
+	PromptRepository                 persistence.PromptRepository
+	CorpusRepository                 persistence.CorpusRepository
+}
+
+func (uc *GenerateMoreTrainingDatasetUseCaseImpl) GenerateMoreTrainingDataset(ctx context.Context, command in.GenerateMoreTrainingDatasetCommand) (*in.GenerateMoreTrainingDatasetResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if source == nil || source.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	// Deleted items and corrections are copied too, so the edits of the source are kept in the new version
+	items, err := uc.TrainingDatasetRepository.GetAllItems(ctx, source.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training data items: %w", err)
+	}
+
+	trainingDataset, err := uc.TrainingDatasetService.CreateExtendedTrainingDataset(source, items, command.GenerateExamplesNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// The source is not necessarily the newest version of the project
+	trainingDataset.Version, err = uc.TrainingDatasetService.GetNextVersion(command.ProjectID, func(projectID uuid.UUID) (*entities.TrainingDataset, error) {
+		return uc.TrainingDatasetRepository.GetLatestByProjectID(ctx, projectID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next version: %w", err)
+	}
+	if trainingDataset.GenerateModel == nil || *trainingDataset.GenerateModel == "" {
+		trainingDataset.GenerateModel = &command.GenerateModel
+	}
+	if trainingDataset.GenerateModelRunner == nil || *trainingDataset.GenerateModelRunner == "" {
+		trainingDataset.GenerateModelRunner = &command.GenerateModelRunner
+	}
+
+	prompt, err := uc.PromptRepository.GetByID(ctx, source.GeneratePromptID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prompt: %w", err)
+	}
+	if prompt == nil {
+		return nil, errors.New("prompt not found")
+	}
+
+	// An edited prompt becomes the next prompt version, it is stored with the training dataset. The copied items keep
+	// the version that generated them.
+	var newPrompt *entities.Prompt
+	if command.GeneratePrompt != "" && command.GeneratePrompt != prompt.Text {
+		var created bool
+		prompt, created, err = uc.PromptService.PreparePromptVersion(ctx, command.ProjectID, command.GeneratePrompt)
+		if err != nil {
+			return nil, err
+		}
+		if created {
+			newPrompt = prompt
+		}
+		prompts, err := uc.PromptRepository.GetByProjectID(ctx, command.ProjectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get prompts: %w", err)
+		}
+		trainingDataset.GeneratePromptID = prompt.ID
+		trainingDataset.GeneratePromptHistoryIDs = uc.PromptService.PromptHistoryIDs(prompts, prompt)
+	}
+
+	corpusS3Path := ""
+	corpusFilesSubset := []string{}
+	if source.CorpusID != nil {
+		corpus, err := uc.CorpusRepository.GetByID(ctx, *source.CorpusID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get corpus: %w", err)
+		}
+		if corpus == nil {
+			return nil, errors.New("corpus not found")
+		}
+		corpusS3Path = corpus.S3Path
+		if corpus.FilesSubset != nil {
+			corpusFilesSubset = *corpus.FilesSubset
+		}
+	}
+
+	// The chunks the existing items were generated from are skipped, like when a generation is resumed
+	skipChunks := uc.TrainingDatasetGenerationService.CompletedChunks(items)
+
+	job := entities.TrainingDatasetJob{
+		CorpusS3Path:            corpusS3Path,
+		CorpusFilesSubset:       corpusFilesSubset,
+		LanguageISO:             trainingDataset.LanguageISO,
+		UserID:                  command.OwnerID.String(),
+		TrainingDatasetID:       trainingDataset.ID.String(),
+		GeneratePrompt:          prompt.Text,
+		GenerateExamplesNumber:  trainingDataset.GenerateExamplesNumber,
+		GenerateModel:           *trainingDataset.GenerateModel,
+		GenerateModelRunner:     *trainingDataset.GenerateModelRunner,
+		InputField:              trainingDataset.InputField,
+		OutputField:             trainingDataset.OutputField,
+		JSONObjectFields:        trainingDataset.JSONObjectFields,
+		ExpectedOutputSizeChars: trainingDataset.ExpectedOutputSizeChars,
+		Chunking:                trainingDataset.ChunkingConfig,
+		Conversation:            trainingDataset.Conversation,
+		SkipChunks:              skipChunks,
+	}
+
+	outboxJob, err := uc.OutboxService.NewTrainingDatasetOutboxJob(trainingDataset.ID, job)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.TrainingDatasetRepository.CreateWithOutboxJob(ctx, trainingDataset, outboxJob, newPrompt); err != nil {
+		return nil, fmt.Errorf("failed to create training dataset: %w", err)
+	}
+
+	return &in.GenerateMoreTrainingDatasetResult{
+		TrainingDatasetID: trainingDataset.ID,
+		Version:           trainingDataset.Version,
+		CopiedItems:       len(trainingDataset.Data),
+		SkippedChunks:     len(skipChunks),
+		PromptVersion:     prompt.Version,
+	}, nil
+}
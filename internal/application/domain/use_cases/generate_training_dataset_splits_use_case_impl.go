@@ -0,0 +1,67 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type GenerateTrainingDatasetSplitsUseCaseImpl struct {
+	ProjectService            *services.ProjectService
+	TrainingDatasetService    *services.TrainingDatasetService
+	TrainingDatasetRepository persistence.TrainingDatasetRepository
+}
+
+func (uc *GenerateTrainingDatasetSplitsUseCaseImpl) GenerateTrainingDatasetSplits(ctx context.Context, command in.GenerateTrainingDatasetSplitsCommand) (*in.GenerateTrainingDatasetSplitsResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.TrainingDatasetService.ValidateSplitRatios(command.TrainRatio, command.ValidationRatio, command.TestRatio); err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	// Only active items are split, replaced and deleted items keep their previous assignment
+	activeItems := uc.TrainingDatasetService.ActiveTrainingDataItems(trainingDataset.Data)
+	splits := uc.TrainingDatasetService.AssignSplits(
+		activeItems,
+		command.ValidationRatio,
+		command.TestRatio,
+		command.Seed,
+		command.StratifyBySourceDocument,
+	)
+
+	err = uc.TrainingDatasetRepository.UpdateItemSplits(ctx, trainingDataset.ID, splits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update training data item splits: %w", err)
+	}
+
+	result := &in.GenerateTrainingDatasetSplitsResult{}
+	for _, split := range splits {
+		switch split {
+		case entities.TrainingDataItemSplitTrain:
+			result.TrainItems++
+		case entities.TrainingDataItemSplitValidation:
+			result.ValidationItems++
+		case entities.TrainingDataItemSplitTest:
+			result.TestItems++
+		}
+	}
+
+	return result, nil
+}
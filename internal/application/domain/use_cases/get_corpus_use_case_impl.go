@@ -0,0 +1,32 @@
+package use_cases
+
+import (
+	"context"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type GetCorpusUseCaseImpl struct {
+	CorpusService            *services.CorpusService
+	CorpusDocumentRepository persistence.CorpusDocumentRepository
+}
+
+func (uc *GetCorpusUseCaseImpl) GetCorpus(ctx context.Context, command in.GetCorpusCommand) (*in.GetCorpusResult, error) {
+	corpus, err := uc.CorpusService.GetCorpus(ctx, command.CorpusID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := uc.CorpusDocumentRepository.GetByCorpusID(ctx, corpus.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get corpus documents: %w", err)
+	}
+
+	return &in.GetCorpusResult{
+		Corpus:    corpus,
+		Documents: documents,
+	}, nil
+}
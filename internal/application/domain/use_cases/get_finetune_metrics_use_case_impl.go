@@ -0,0 +1,44 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type GetFinetuneMetricsUseCaseImpl struct {
+	ProjectService           *services.ProjectService
+	FinetuneRepository       persistence.FinetuneRepository
+	FinetuneMetricRepository persistence.FinetuneMetricRepository
+}
+
+func (uc *GetFinetuneMetricsUseCaseImpl) GetFinetuneMetrics(ctx context.Context, command in.GetFinetuneMetricsCommand) (*in.GetFinetuneMetricsResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	finetune, err := uc.FinetuneRepository.GetByID(ctx, command.FinetuneID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finetune: %w", err)
+	}
+	if finetune == nil || finetune.ProjectID != command.ProjectID {
+		return nil, errors.New("finetune not found")
+	}
+
+	metrics, err := uc.FinetuneMetricRepository.ListByFinetuneID(ctx, finetune.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finetune metrics: %w", err)
+	}
+
+	return &in.GetFinetuneMetricsResult{
+		Status:           finetune.Status,
+		Metrics:          metrics,
+		InferenceSamples: finetune.InferenceSamples,
+	}, nil
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 	"fmt"
 
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
 	"ai-platform/internal/application/port/in"
 	"ai-platform/internal/application/port/out/persistence"
 	"github.com/google/uuid"
 )
 
 type GetFinetuneUseCaseImpl struct {
-	FinetuneRepository   persistence.FinetuneRepository
-	DeploymentRepository persistence.DeploymentRepository
+	FinetuneRepository              persistence.FinetuneRepository
+	DeploymentRepository            persistence.DeploymentRepository
+	FinetuneDispatchEventRepository persistence.FinetuneDispatchEventRepository
+	FinetuneQueueService            *services.FinetuneQueueService
 }
 
 func (uc *GetFinetuneUseCaseImpl) GetFinetune(ctx context.Context, command in.GetFinetuneCommand) (*in.GetFinetuneResult, error) {
@@ -41,8 +45,24 @@ func (uc *GetFinetuneUseCaseImpl) GetFinetune(ctx context.Context, command in.Ge
 		deploymentID = &deployment.ID
 	}
 
+	dispatchEvents, err := uc.FinetuneDispatchEventRepository.ListByFinetuneID(ctx, finetune.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dispatch events: %w", err)
+	}
+
+	queuePosition := 0
+	if finetune.Status == entities.FinetuneStatusQueued {
+		entries, err := uc.FinetuneRepository.ListScheduled(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get finetune queue: %w", err)
+		}
+		queuePosition = uc.FinetuneQueueService.QueuePosition(entries, finetune.ID)
+	}
+
 	return &in.GetFinetuneResult{
-		Finetune:     finetune,
-		DeploymentID: deploymentID,
+		Finetune:       finetune,
+		DeploymentID:   deploymentID,
+		QueuePosition:  queuePosition,
+		DispatchEvents: dispatchEvents,
 	}, nil
 }
\ No newline at end of file
@@ -0,0 +1,52 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type GetPromptDiffUseCaseImpl struct {
+	ProjectService   *services.ProjectService
+	PromptService    *services.PromptService
+	PromptRepository persistence.PromptRepository
+}
+
+func (uc *GetPromptDiffUseCaseImpl) GetPromptDiff(ctx context.Context, command in.GetPromptDiffCommand) (*in.GetPromptDiffResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := uc.getProjectPrompt(ctx, command.ProjectID, command.FromPromptID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := uc.getProjectPrompt(ctx, command.ProjectID, command.ToPromptID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &in.GetPromptDiffResult{
+		Diff: uc.PromptService.DiffPrompts(from, to),
+	}, nil
+}
+
+func (uc *GetPromptDiffUseCaseImpl) getProjectPrompt(ctx context.Context, projectID uuid.UUID, promptID uuid.UUID) (*entities.Prompt, error) {
+	prompt, err := uc.PromptRepository.GetByID(ctx, promptID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prompt: %w", err)
+	}
+	if prompt == nil || prompt.ProjectID != projectID {
+		return nil, errors.New("prompt not found")
+	}
+	return prompt, nil
+}
@@ -0,0 +1,72 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type GetTrainingDataItemChunkUseCaseImpl struct {
+	ProjectService            *services.ProjectService
+	CorpusChunkingService     *services.CorpusChunkingService
+	TrainingDatasetRepository persistence.TrainingDatasetRepository
+	CorpusChunkRepository     persistence.CorpusChunkRepository
+}
+
+func (uc *GetTrainingDataItemChunkUseCaseImpl) GetTrainingDataItemChunk(ctx context.Context, command in.GetTrainingDataItemChunkCommand) (*in.GetTrainingDataItemChunkResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	item, err := uc.TrainingDatasetRepository.GetItemByID(ctx, trainingDataset.ID, command.TrainingDataItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training data item: %w", err)
+	}
+	if item == nil {
+		return nil, errors.New("training data item not found")
+	}
+
+	// Only datasets generated with an explicit chunking config have stored chunks
+	if trainingDataset.CorpusID == nil || trainingDataset.ChunkingConfig == nil {
+		return nil, errors.New("chunk not found")
+	}
+	if item.SourceDocument == nil || item.SourceDocumentStart == nil || item.SourceDocumentEnd == nil {
+		return nil, errors.New("chunk not found")
+	}
+	start, err := strconv.Atoi(*item.SourceDocumentStart)
+	if err != nil {
+		return nil, errors.New("chunk not found")
+	}
+	end, err := strconv.Atoi(*item.SourceDocumentEnd)
+	if err != nil {
+		return nil, errors.New("chunk not found")
+	}
+
+	configKey := uc.CorpusChunkingService.ChunkingConfigKey(*trainingDataset.ChunkingConfig)
+	chunk, err := uc.CorpusChunkRepository.FindByRange(ctx, *trainingDataset.CorpusID, configKey, *item.SourceDocument, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find corpus chunk: %w", err)
+	}
+	if chunk == nil {
+		return nil, errors.New("chunk not found")
+	}
+
+	return &in.GetTrainingDataItemChunkResult{
+		Chunk: chunk,
+	}, nil
+}
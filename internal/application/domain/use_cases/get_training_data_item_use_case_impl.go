@@ -0,0 +1,52 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type GetTrainingDataItemUseCaseImpl struct {
+	ProjectService            *services.ProjectService
+	TrainingDatasetRepository persistence.TrainingDatasetRepository
+}
+
+func (uc *GetTrainingDataItemUseCaseImpl) GetTrainingDataItem(ctx context.Context, command in.GetTrainingDataItemCommand) (*in.GetTrainingDataItemResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	item, err := uc.TrainingDatasetRepository.GetItemByID(ctx, trainingDataset.ID, command.TrainingDataItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training data item: %w", err)
+	}
+	if item == nil {
+		return nil, errors.New("training data item not found")
+	}
+
+	// Load the items that correct this item, so the client can follow the lineage
+	corrections, err := uc.TrainingDatasetRepository.GetItemCorrections(ctx, trainingDataset.ID, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training data item corrections: %w", err)
+	}
+
+	return &in.GetTrainingDataItemResult{
+		FieldNames:  trainingDataset.FieldNames,
+		Item:        item,
+		Corrections: corrections,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type GetTrainingDatasetDiffUseCaseImpl struct {
+	ProjectService             *services.ProjectService
+	TrainingDatasetService     *services.TrainingDatasetService
+	TrainingDatasetDiffService *services.TrainingDatasetDiffService
+	TrainingDatasetRepository  persistence.TrainingDatasetRepository
+}
+
+func (uc *GetTrainingDatasetDiffUseCaseImpl) GetTrainingDatasetDiff(ctx context.Context, command in.GetTrainingDatasetDiffCommand) (*in.GetTrainingDatasetDiffResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := uc.getProjectTrainingDataset(ctx, command.ProjectID, command.FromTrainingDatasetID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := uc.getProjectTrainingDataset(ctx, command.ProjectID, command.ToTrainingDatasetID)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := uc.TrainingDatasetDiffService.DiffTrainingDatasets(
+		from,
+		to,
+		uc.TrainingDatasetService.ActiveTrainingDataItems(from.Data),
+		uc.TrainingDatasetService.ActiveTrainingDataItems(to.Data),
+	)
+
+	return &in.GetTrainingDatasetDiffResult{
+		Diff: diff,
+	}, nil
+}
+
+func (uc *GetTrainingDatasetDiffUseCaseImpl) getProjectTrainingDataset(ctx context.Context, projectID uuid.UUID, trainingDatasetID uuid.UUID) (*entities.TrainingDataset, error) {
+	trainingDataset, err := uc.TrainingDatasetRepository.GetByID(ctx, trainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != projectID {
+		return nil, errors.New("training dataset not found")
+	}
+	return trainingDataset, nil
+}
@@ -0,0 +1,65 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+const (
+	defaultPartialResultsLimit = 10
+	maxPartialResultsLimit     = 100
+)
+
+type GetTrainingDatasetPartialResultsUseCaseImpl struct {
+	ProjectService               *services.ProjectService
+	TrainingDatasetRepository    persistence.TrainingDatasetRepository
+	TrainingDatasetResultsClient clients.TrainingDatasetResultsClient
+}
+
+func (uc *GetTrainingDatasetPartialResultsUseCaseImpl) GetTrainingDatasetPartialResults(ctx context.Context, command in.GetTrainingDatasetPartialResultsCommand) (*in.GetTrainingDatasetPartialResultsResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	results, err := uc.TrainingDatasetResultsClient.GetPartialTrainingDatasetResults(ctx, trainingDataset.ID, trainingDataset.FieldNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get partial training dataset results: %w", err)
+	}
+
+	limit := command.Limit
+	if limit <= 0 {
+		limit = defaultPartialResultsLimit
+	}
+	if limit > maxPartialResultsLimit {
+		limit = maxPartialResultsLimit
+	}
+
+	items := results.TrainingDataItems
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	return &in.GetTrainingDatasetPartialResultsResult{
+		FieldNames:     trainingDataset.FieldNames,
+		ItemsGenerated: len(results.TrainingDataItems),
+		TokensIn:       results.TokensIn,
+		TokensOut:      results.TokensOut,
+		Items:          items,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type GetTrainingDatasetProgressUseCaseImpl struct {
+	ProjectService                    *services.ProjectService
+	TrainingDatasetProgressService    *services.TrainingDatasetProgressService
+	TrainingDatasetRepository         persistence.TrainingDatasetRepository
+	TrainingDatasetProgressRepository persistence.TrainingDatasetProgressRepository
+}
+
+func (uc *GetTrainingDatasetProgressUseCaseImpl) GetTrainingDatasetProgress(ctx context.Context, command in.GetTrainingDatasetProgressCommand) (*in.GetTrainingDatasetProgressResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	progress, err := uc.TrainingDatasetProgressRepository.GetByTrainingDatasetID(ctx, trainingDataset.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset progress: %w", err)
+	}
+
+	return &in.GetTrainingDatasetProgressResult{
+		Status:                 trainingDataset.Status,
+		GenerateExamplesNumber: trainingDataset.GenerateExamplesNumber,
+		Progress:               progress,
+		CompletedFraction:      uc.TrainingDatasetProgressService.CompletedFraction(progress, trainingDataset.GenerateExamplesNumber),
+	}, nil
+}
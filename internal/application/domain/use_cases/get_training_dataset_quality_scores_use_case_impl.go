@@ -0,0 +1,47 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type GetTrainingDatasetQualityScoresUseCaseImpl struct {
+	ProjectService              *services.ProjectService
+	TrainingDatasetService      *services.TrainingDatasetService
+	TrainingDataQualityService  *services.TrainingDataQualityService
+	TrainingDatasetRepository   persistence.TrainingDatasetRepository
+	QualityScoringJobRepository persistence.QualityScoringJobRepository
+}
+
+func (uc *GetTrainingDatasetQualityScoresUseCaseImpl) GetTrainingDatasetQualityScores(ctx context.Context, command in.GetTrainingDatasetQualityScoresCommand) (*in.GetTrainingDatasetQualityScoresResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	job, err := uc.QualityScoringJobRepository.GetLatestByTrainingDatasetID(ctx, trainingDataset.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quality scoring job: %w", err)
+	}
+
+	activeItems := uc.TrainingDatasetService.ActiveTrainingDataItems(trainingDataset.Data)
+
+	return &in.GetTrainingDatasetQualityScoresResult{
+		Histogram: uc.TrainingDataQualityService.QualityScoreHistogram(activeItems),
+		Job:       job,
+	}, nil
+}
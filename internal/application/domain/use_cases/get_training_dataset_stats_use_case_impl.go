@@ -0,0 +1,49 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type GetTrainingDatasetStatsUseCaseImpl struct {
+	ProjectService              *services.ProjectService
+	TrainingDatasetStatsService *services.TrainingDatasetStatsService
+	TrainingDatasetRepository   persistence.TrainingDatasetRepository
+}
+
+func (uc *GetTrainingDatasetStatsUseCaseImpl) GetTrainingDatasetStats(ctx context.Context, command in.GetTrainingDatasetStatsCommand) (*in.GetTrainingDatasetStatsResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	stats := uc.TrainingDatasetStatsService.ComputeTrainingDatasetStats(trainingDataset)
+
+	// Deleted items are not loaded with the dataset, they are counted in the database
+	deleted := true
+	deletedItems, err := uc.TrainingDatasetRepository.CountItems(ctx, trainingDataset.ID, entities.TrainingDataItemFilter{Deleted: &deleted})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count deleted training data items: %w", err)
+	}
+	stats.TotalItems += deletedItems - stats.DeletedItems
+	stats.DeletedItems = deletedItems
+
+	return &in.GetTrainingDatasetStatsResult{
+		Stats: stats,
+	}, nil
+}
@@ -31,6 +31,9 @@ func (uc *GetTrainingDatasetUseCaseImpl) GetTrainingDataset(command in.GetTraini
 		return nil, nil
 	}
 
+	// Corrected items are replaced by their corrections
+	trainingDataset.Data = uc.TrainingDatasetService.ActiveTrainingDataItems(trainingDataset.Data)
+
 	// Get the generate prompt
 	prompt, err := uc.PromptRepository.GetByID(context.Background(), trainingDataset.GeneratePromptID)
 	if err != nil {
@@ -38,8 +41,10 @@ func (uc *GetTrainingDatasetUseCaseImpl) GetTrainingDataset(command in.GetTraini
 	}
 
 	generatePromptText := ""
+	generatePromptVersion := 0
 	if prompt != nil {
 		generatePromptText = prompt.Text
+		generatePromptVersion = prompt.Version
 	}
 
 	// Get the corpus name
@@ -54,9 +59,16 @@ func (uc *GetTrainingDatasetUseCaseImpl) GetTrainingDataset(command in.GetTraini
 		}
 	}
 
+	previousVersionID, err := uc.TrainingDatasetRepository.GetPreviousVersionID(context.Background(), trainingDataset.ProjectID, trainingDataset.Version)
+	if err != nil {
+		return nil, err
+	}
+
 	return &in.GetTrainingDatasetResult{
-		TrainingDataset: trainingDataset,
-		GeneratePrompt:  generatePromptText,
-		CorpusName:      corpusName,
+		TrainingDataset:       trainingDataset,
+		GeneratePrompt:        generatePromptText,
+		GeneratePromptVersion: generatePromptVersion,
+		CorpusName:            corpusName,
+		PreviousVersionID:     previousVersionID,
 	}, nil
 }
\ No newline at end of file
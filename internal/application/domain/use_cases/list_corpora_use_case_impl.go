@@ -0,0 +1,31 @@
+package use_cases
+
+import (
+	"context"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type ListCorporaUseCaseImpl struct {
+	CorpusRepository persistence.CorpusRepository
+	ProjectService   *services.ProjectService
+}
+
+func (uc *ListCorporaUseCaseImpl) ListCorpora(ctx context.Context, command in.ListCorporaCommand) ([]*entities.Corpus, error) {
+	if command.ProjectID != nil {
+		if _, err := uc.ProjectService.GetProject(ctx, *command.ProjectID, command.OwnerID); err != nil {
+			return nil, err
+		}
+	}
+
+	corpora, err := uc.CorpusRepository.ListForOwner(ctx, command.OwnerID, command.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list corpora: %w", err)
+	}
+
+	return corpora, nil
+}
@@ -0,0 +1,39 @@
+package use_cases
+
+import (
+	"context"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type ListPromptsUseCaseImpl struct {
+	ProjectService            *services.ProjectService
+	PromptService             *services.PromptService
+	PromptRepository          persistence.PromptRepository
+	TrainingDatasetRepository persistence.TrainingDatasetRepository
+}
+
+func (uc *ListPromptsUseCaseImpl) ListPrompts(ctx context.Context, command in.ListPromptsCommand) (*in.ListPromptsResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	prompts, err := uc.PromptRepository.GetByProjectID(ctx, command.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prompts: %w", err)
+	}
+
+	trainingDatasets, err := uc.TrainingDatasetRepository.ListPromptItems(ctx, command.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prompt items: %w", err)
+	}
+
+	return &in.ListPromptsResult{
+		History: uc.PromptService.BuildPromptHistory(prompts, trainingDatasets),
+	}, nil
+}
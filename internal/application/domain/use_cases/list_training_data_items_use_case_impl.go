@@ -0,0 +1,89 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+const (
+	defaultTrainingDataItemsPageSize = 50
+	maxTrainingDataItemsPageSize     = 500
+)
+
+type ListTrainingDataItemsUseCaseImpl struct {
+	ProjectService            *services.ProjectService
+	TrainingDatasetService    *services.TrainingDatasetService
+	TrainingDatasetRepository persistence.TrainingDatasetRepository
+}
+
+func (uc *ListTrainingDataItemsUseCaseImpl) ListTrainingDataItems(ctx context.Context, command in.ListTrainingDataItemsCommand) (*in.ListTrainingDataItemsResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	pageSize := command.PageSize
+	if pageSize < 1 {
+		pageSize = defaultTrainingDataItemsPageSize
+	}
+	if pageSize > maxTrainingDataItemsPageSize {
+		pageSize = maxTrainingDataItemsPageSize
+	}
+
+	var after *entities.TrainingDataItemCursor
+	if command.Cursor != "" {
+		after, err = uc.TrainingDatasetService.DecodeTrainingDataItemCursor(command.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	filter := entities.TrainingDataItemFilter{
+		Query:            command.Query,
+		Deleted:          command.Deleted,
+		Corrected:        command.Corrected,
+		SourceDocument:   command.SourceDocument,
+		GeneratePromptID: command.GeneratePromptID,
+	}
+
+	total, err := uc.TrainingDatasetRepository.CountItems(ctx, trainingDataset.ID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count training data items: %w", err)
+	}
+
+	// One more item than requested tells whether there is a next page
+	items, err := uc.TrainingDatasetRepository.ListItems(ctx, trainingDataset.ID, filter, after, pageSize+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list training data items: %w", err)
+	}
+
+	var nextCursor *string
+	if len(items) > pageSize {
+		items = items[:pageSize]
+		cursor := uc.TrainingDatasetService.EncodeTrainingDataItemCursor(&items[pageSize-1])
+		nextCursor = &cursor
+	}
+
+	return &in.ListTrainingDataItemsResult{
+		FieldNames: trainingDataset.FieldNames,
+		Items:      items,
+		TotalItems: total,
+		PageSize:   pageSize,
+		NextCursor: nextCursor,
+	}, nil
+}
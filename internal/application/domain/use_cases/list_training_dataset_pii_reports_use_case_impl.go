@@ -0,0 +1,42 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type ListTrainingDatasetPIIReportsUseCaseImpl struct {
+	ProjectService            *services.ProjectService
+	TrainingDatasetRepository persistence.TrainingDatasetRepository
+	PIIReportRepository       persistence.PIIReportRepository
+}
+
+func (uc *ListTrainingDatasetPIIReportsUseCaseImpl) ListTrainingDatasetPIIReports(ctx context.Context, command in.ListTrainingDatasetPIIReportsCommand) (*in.ListTrainingDatasetPIIReportsResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	reports, err := uc.PIIReportRepository.GetByTrainingDatasetID(ctx, trainingDataset.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PII reports: %w", err)
+	}
+
+	return &in.ListTrainingDatasetPIIReportsResult{
+		Reports: reports,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+const (
+	defaultCorpusChunksPreviewLimit = 20
+	maxCorpusChunksPreviewLimit     = 100
+)
+
+type PreviewCorpusChunksUseCaseImpl struct {
+	CorpusService            *services.CorpusService
+	CorpusChunkingService    *services.CorpusChunkingService
+	CorpusDocumentRepository persistence.CorpusDocumentRepository
+}
+
+func (uc *PreviewCorpusChunksUseCaseImpl) PreviewCorpusChunks(ctx context.Context, command in.PreviewCorpusChunksCommand) (*in.PreviewCorpusChunksResult, error) {
+	corpus, err := uc.CorpusService.GetCorpus(ctx, command.CorpusID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.CorpusChunkingService.ValidateChunkingConfig(command.Config); err != nil {
+		return nil, err
+	}
+
+	document, err := uc.findPreviewDocument(ctx, corpus, command.FileName)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks, err := uc.CorpusChunkingService.ChunkDocument(ctx, corpus, document, command.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := command.Limit
+	if limit <= 0 {
+		limit = defaultCorpusChunksPreviewLimit
+	}
+	if limit > maxCorpusChunksPreviewLimit {
+		limit = maxCorpusChunksPreviewLimit
+	}
+
+	result := &in.PreviewCorpusChunksResult{
+		Config:      command.Config,
+		ConfigKey:   uc.CorpusChunkingService.ChunkingConfigKey(command.Config),
+		FileName:    document.FileName,
+		TotalChunks: len(chunks),
+		Chunks:      chunks,
+	}
+	if len(chunks) > limit {
+		result.Chunks = chunks[:limit]
+	}
+
+	return result, nil
+}
+
+func (uc *PreviewCorpusChunksUseCaseImpl) findPreviewDocument(ctx context.Context, corpus *entities.Corpus, fileName string) (*entities.CorpusDocument, error) {
+	if fileName == "" && corpus.FilesSubset != nil && len(*corpus.FilesSubset) > 0 {
+		fileName = (*corpus.FilesSubset)[0]
+	}
+
+	if fileName != "" {
+		document, err := uc.CorpusDocumentRepository.GetByFileName(ctx, corpus.ID, fileName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get corpus document: %w", err)
+		}
+		if document == nil {
+			return nil, errors.New("document not found")
+		}
+		return document, nil
+	}
+
+	documents, err := uc.CorpusDocumentRepository.GetByCorpusID(ctx, corpus.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get corpus documents: %w", err)
+	}
+	if len(documents) == 0 {
+		return nil, errors.New("document not found")
+	}
+	return documents[0], nil
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
 	"ai-platform/internal/application/port/in"
 	"ai-platform/internal/application/port/out/clients"
 	"ai-platform/internal/application/port/out/persistence"
@@ -16,6 +17,7 @@ import (
 type PublicChatCompletionUseCaseImpl struct {
 	OllamaLLMClient           clients.OllamaLLMClient
 	DeploymentLogsRepository  persistence.DeploymentLogsRepository
+	PIIService                *services.PIIService
 }
 
 func (uc *PublicChatCompletionUseCaseImpl) GenerateChatCompletion(ctx context.Context, command in.PublicChatCompletionCommand) (*in.PublicChatCompletionResult, error) {
@@ -72,6 +74,9 @@ func (uc *PublicChatCompletionUseCaseImpl) GenerateChatCompletion(ctx context.Co
 		ExecutionTime: result.ExecutionTime,
 		Source:        "api",
 	}
+	if command.RedactPII {
+		uc.PIIService.RedactDeploymentLog(log)
+	}
 
 	if err := uc.DeploymentLogsRepository.Create(log); err != nil {
 		return nil, fmt.Errorf("failed to log deployment request: %w", err)
@@ -162,6 +167,9 @@ func (uc *PublicChatCompletionUseCaseImpl) GenerateChatCompletionStream(ctx cont
 			ExecutionTime: 0,
 			Source:        "api",
 		}
+		if command.RedactPII {
+			uc.PIIService.RedactDeploymentLog(log)
+		}
 
 		_ = uc.DeploymentLogsRepository.Create(log)
 	}()
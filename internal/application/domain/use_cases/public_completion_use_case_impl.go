@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
 	"ai-platform/internal/application/port/in"
 	"ai-platform/internal/application/port/out/clients"
 	"ai-platform/internal/application/port/out/persistence"
@@ -15,6 +16,7 @@ import (
 type PublicCompletionUseCaseImpl struct {
 	OllamaLLMClient          clients.OllamaLLMClient
 	DeploymentLogsRepository persistence.DeploymentLogsRepository
+	PIIService               *services.PIIService
 }
 
 func (uc *PublicCompletionUseCaseImpl) GenerateCompletion(ctx context.Context, command in.PublicCompletionCommand) (*in.PublicCompletionResult, error) {
@@ -56,6 +58,9 @@ func (uc *PublicCompletionUseCaseImpl) GenerateCompletion(ctx context.Context, c
 		ExecutionTime: result.ExecutionTime,
 		Source:        "api",
 	}
+	if command.RedactPII {
+		uc.PIIService.RedactDeploymentLog(log)
+	}
 
 	if err := uc.DeploymentLogsRepository.Create(log); err != nil {
 		return nil, fmt.Errorf("failed to log deployment request: %w", err)
@@ -132,6 +137,9 @@ func (uc *PublicCompletionUseCaseImpl) GenerateCompletionStream(ctx context.Cont
 			ExecutionTime: 0,
 			Source:        "api",
 		}
+		if command.RedactPII {
+			uc.PIIService.RedactDeploymentLog(log)
+		}
 
 		_ = uc.DeploymentLogsRepository.Create(log)
 	}()
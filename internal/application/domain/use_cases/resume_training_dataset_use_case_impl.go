@@ -0,0 +1,135 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type ResumeTrainingDatasetUseCaseImpl struct {
+	ProjectService                    *services.ProjectService
+	StatusTransitionService           *services.StatusTransitionService
+	TrainingDatasetGenerationService  *services.TrainingDatasetGenerationService
+	TrainingDatasetRepository         persistence.TrainingDatasetRepository
+	TrainingDatasetProgressRepository persistence.TrainingDatasetProgressRepository
+	PromptRepository                  persistence.PromptRepository
+	CorpusRepository                  persistence.CorpusRepository
+	TrainingDatasetResultsClient      clients.TrainingDatasetResultsClient
+	TrainingDatasetJobClient          clients.TrainingDatasetJobClient
+}
+
+func (uc *ResumeTrainingDatasetUseCaseImpl) ResumeTrainingDataset(ctx context.Context, command in.ResumeTrainingDatasetCommand) (*in.ResumeTrainingDatasetResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	previousStatus := trainingDataset.Status
+	if err := uc.StatusTransitionService.ValidateTrainingDatasetStatusTransition(previousStatus, entities.TrainingDatasetStatusPlanning); err != nil {
+		return nil, err
+	}
+
+	prompt, err := uc.PromptRepository.GetByID(ctx, trainingDataset.GeneratePromptID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prompt: %w", err)
+	}
+	if prompt == nil {
+		return nil, errors.New("prompt not found")
+	}
+
+	corpusS3Path := ""
+	corpusFilesSubset := []string{}
+	if trainingDataset.CorpusID != nil {
+		corpus, err := uc.CorpusRepository.GetByID(ctx, *trainingDataset.CorpusID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get corpus: %w", err)
+		}
+		if corpus == nil {
+			return nil, errors.New("corpus not found")
+		}
+		corpusS3Path = corpus.S3Path
+		if corpus.FilesSubset != nil {
+			corpusFilesSubset = *corpus.FilesSubset
+		}
+	}
+
+	// The results of the earlier runs stay in S3, only the missing examples are generated again
+	results, err := uc.TrainingDatasetResultsClient.GetPartialTrainingDatasetResults(ctx, trainingDataset.ID, trainingDataset.FieldNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset results: %w", err)
+	}
+	generatedExamples := len(results.TrainingDataItems)
+	skipChunks := uc.TrainingDatasetGenerationService.CompletedChunks(results.TrainingDataItems)
+
+	generateModel := command.GenerateModel
+	if trainingDataset.GenerateModel != nil && *trainingDataset.GenerateModel != "" {
+		generateModel = *trainingDataset.GenerateModel
+	}
+	generateModelRunner := command.GenerateModelRunner
+	if trainingDataset.GenerateModelRunner != nil && *trainingDataset.GenerateModelRunner != "" {
+		generateModelRunner = *trainingDataset.GenerateModelRunner
+	}
+
+	job := entities.TrainingDatasetJob{
+		CorpusS3Path:            corpusS3Path,
+		CorpusFilesSubset:       corpusFilesSubset,
+		LanguageISO:             trainingDataset.LanguageISO,
+		UserID:                  command.OwnerID.String(),
+		TrainingDatasetID:       trainingDataset.ID.String(),
+		GeneratePrompt:          prompt.Text,
+		GenerateExamplesNumber:  max(trainingDataset.GenerateExamplesNumber-generatedExamples, 0),
+		GenerateModel:           generateModel,
+		GenerateModelRunner:     generateModelRunner,
+		InputField:              trainingDataset.InputField,
+		OutputField:             trainingDataset.OutputField,
+		JSONObjectFields:        trainingDataset.JSONObjectFields,
+		ExpectedOutputSizeChars: trainingDataset.ExpectedOutputSizeChars,
+		Chunking:                trainingDataset.ChunkingConfig,
+		Conversation:            trainingDataset.Conversation,
+		SkipChunks:              skipChunks,
+		ResumedExamplesNumber:   generatedExamples,
+	}
+
+	// The status is changed before the job is submitted, a runner picking up the job expects PLANNING
+	if err := uc.TrainingDatasetRepository.UpdateStatus(ctx, trainingDataset.ID, entities.TrainingDatasetStatusPlanning); err != nil {
+		return nil, fmt.Errorf("failed to update training dataset status: %w", err)
+	}
+
+	// The progress starts from the examples that are already generated
+	progress := &entities.TrainingDatasetProgress{
+		TrainingDatasetID: trainingDataset.ID,
+		ItemsGenerated:    generatedExamples,
+		TokensIn:          results.TokensIn,
+		TokensOut:         results.TokensOut,
+	}
+	if err := uc.TrainingDatasetProgressRepository.Save(ctx, progress); err != nil {
+		return nil, fmt.Errorf("failed to save training dataset progress: %w", err)
+	}
+
+	if err := uc.TrainingDatasetJobClient.SubmitJob(ctx, job); err != nil {
+		// Without a job nothing would ever leave PLANNING, so the dataset goes back to where it was
+		revertErr := uc.TrainingDatasetRepository.UpdateStatus(context.WithoutCancel(ctx), trainingDataset.ID, previousStatus)
+		return nil, errors.Join(fmt.Errorf("failed to submit training dataset job: %w", err), revertErr)
+	}
+
+	return &in.ResumeTrainingDatasetResult{
+		ResumedExamplesNumber:   generatedExamples,
+		RemainingExamplesNumber: job.GenerateExamplesNumber,
+		SkippedChunks:           len(skipChunks),
+	}, nil
+}
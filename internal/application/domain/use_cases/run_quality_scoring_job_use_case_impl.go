@@ -0,0 +1,160 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+const (
+	// qualityScoreBatchSize is the number of scores that are collected before they are saved
+	qualityScoreBatchSize = 20
+	// maxQualityScoringJobAttempts stops a job that keeps getting interrupted, e.g. because it crashes its worker
+	maxQualityScoringJobAttempts = 3
+)
+
+type RunQualityScoringJobUseCaseImpl struct {
+	TrainingDatasetService      *services.TrainingDatasetService
+	TrainingDataQualityService  *services.TrainingDataQualityService
+	TrainingDatasetRepository   persistence.TrainingDatasetRepository
+	QualityScoringJobRepository persistence.QualityScoringJobRepository
+}
+
+func (uc *RunQualityScoringJobUseCaseImpl) Execute(ctx context.Context, command in.RunQualityScoringJobCommand) (*in.RunQualityScoringJobResult, error) {
+	job, err := uc.QualityScoringJobRepository.ClaimNext(ctx, time.Now().Add(command.Lease))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim quality scoring job: %w", err)
+	}
+	result := &in.RunQualityScoringJobResult{Job: job}
+	if job == nil {
+		return result, nil
+	}
+
+	if job.Attempts > maxQualityScoringJobAttempts {
+		return result, uc.finish(ctx, job, fmt.Errorf("scoring was interrupted %d times", job.Attempts-1))
+	}
+
+	err = uc.score(ctx, job, command.Lease)
+	if ctx.Err() != nil {
+		// The worker is stopping, the job stays RUNNING and is resumed by the next worker once its lease expired
+		return result, ctx.Err()
+	}
+	return result, uc.finish(ctx, job, err)
+}
+
+func (uc *RunQualityScoringJobUseCaseImpl) score(ctx context.Context, job *entities.QualityScoringJob, lease time.Duration) error {
+	trainingDataset, err := uc.TrainingDatasetRepository.GetByID(ctx, job.TrainingDatasetID)
+	if err != nil {
+		return fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.Status != entities.TrainingDatasetStatusDone {
+		return errors.New("training dataset is not in DONE status anymore")
+	}
+
+	// Conversations have no fields, they are judged on their messages
+	inputIndex, outputIndex := 0, 0
+	if trainingDataset.Type != entities.TrainingDatasetTypeConversation {
+		inputIndex, outputIndex, err = uc.TrainingDatasetService.InputOutputFieldIndexes(trainingDataset)
+		if err != nil {
+			return err
+		}
+	}
+
+	// A resumed job only judges the items that are still unscored, unless it rescores all of them
+	items := uc.TrainingDataQualityService.ItemsToScore(uc.TrainingDatasetService.ActiveTrainingDataItems(trainingDataset.Data), job.Rescore)
+	job.ItemsTotal = len(items)
+	job.ItemsScored = 0
+	job.ItemsFailed = 0
+
+	scores := make(map[uuid.UUID]entities.TrainingDataItemQualityScore)
+	lastSave := time.Time{}
+	save := func(ctx context.Context) error {
+		if len(scores) > 0 {
+			if err := uc.TrainingDatasetRepository.UpdateItemQualityScores(ctx, job.TrainingDatasetID, scores); err != nil {
+				return fmt.Errorf("failed to save quality scores: %w", err)
+			}
+			scores = make(map[uuid.UUID]entities.TrainingDataItemQualityScore)
+		}
+
+		leaseUntil := time.Now().Add(lease)
+		job.LeaseUntil = &leaseUntil
+		lastSave = time.Now()
+		return uc.QualityScoringJobRepository.Update(ctx, job)
+	}
+	if err := save(ctx); err != nil {
+		return err
+	}
+
+	var lastErr error
+	for _, item := range items {
+		if ctx.Err() != nil {
+			// The scores judged so far are kept, they are not paid for again when the job is resumed
+			return save(context.WithoutCancel(ctx))
+		}
+
+		input, output := "", ""
+		if len(item.Messages) > 0 {
+			input, output = uc.TrainingDatasetService.ConversationInputOutput(item.Messages)
+		} else {
+			if inputIndex < len(item.Values) {
+				input = item.Values[inputIndex]
+			}
+			if outputIndex < len(item.Values) {
+				output = item.Values[outputIndex]
+			}
+		}
+
+		score, err := uc.TrainingDataQualityService.ScoreTrainingDataItem(ctx, job.Model, job.Rubric, input, output)
+		if err != nil {
+			// A single unparseable judgement should not stop the whole job, the item stays unscored
+			log.Printf("failed to score training data item %s: %v", item.ID, err)
+			job.ItemsFailed++
+			lastErr = err
+		} else {
+			scores[item.ID] = *score
+			job.ItemsScored++
+		}
+
+		// The lease is renewed well before it expires, so no other worker resumes the job while it runs
+		if len(scores) >= qualityScoreBatchSize || time.Since(lastSave) >= lease/2 {
+			if err := save(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := save(ctx); err != nil {
+		return err
+	}
+	if job.ItemsScored == 0 && lastErr != nil {
+		return fmt.Errorf("no item could be scored: %w", lastErr)
+	}
+	return nil
+}
+
+func (uc *RunQualityScoringJobUseCaseImpl) finish(ctx context.Context, job *entities.QualityScoringJob, err error) error {
+	now := time.Now()
+	job.Status = entities.QualityScoringJobStatusDone
+	job.LastError = nil
+	if err != nil {
+		message := err.Error()
+		job.Status = entities.QualityScoringJobStatusFailed
+		job.LastError = &message
+	}
+	job.LeaseUntil = nil
+	job.FinishedAt = &now
+
+	if updateErr := uc.QualityScoringJobRepository.Update(ctx, job); updateErr != nil {
+		return fmt.Errorf("failed to finish quality scoring job %s: %w", job.ID, updateErr)
+	}
+	return err
+}
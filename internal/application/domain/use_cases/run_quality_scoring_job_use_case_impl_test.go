@@ -0,0 +1,146 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type mockQualityScoringJobRepository struct {
+	job     *entities.QualityScoringJob
+	updated []entities.QualityScoringJob
+}
+
+func (m *mockQualityScoringJobRepository) Create(ctx context.Context, job *entities.QualityScoringJob) (bool, error) {
+	return true, nil
+}
+
+func (m *mockQualityScoringJobRepository) ClaimNext(ctx context.Context, leaseUntil time.Time) (*entities.QualityScoringJob, error) {
+	if m.job == nil {
+		return nil, nil
+	}
+	m.job.Status = entities.QualityScoringJobStatusRunning
+	m.job.Attempts++
+	m.job.LeaseUntil = &leaseUntil
+	return m.job, nil
+}
+
+func (m *mockQualityScoringJobRepository) Update(ctx context.Context, job *entities.QualityScoringJob) error {
+	m.updated = append(m.updated, *job)
+	return nil
+}
+
+func (m *mockQualityScoringJobRepository) GetLatestByTrainingDatasetID(ctx context.Context, trainingDatasetID uuid.UUID) (*entities.QualityScoringJob, error) {
+	return m.job, nil
+}
+
+// mockScoringTrainingDatasetRepository only implements what the scoring job uses, other calls panic
+type mockScoringTrainingDatasetRepository struct {
+	persistence.TrainingDatasetRepository
+	trainingDataset *entities.TrainingDataset
+	scores          map[uuid.UUID]entities.TrainingDataItemQualityScore
+}
+
+func (m *mockScoringTrainingDatasetRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.TrainingDataset, error) {
+	return m.trainingDataset, nil
+}
+
+func (m *mockScoringTrainingDatasetRepository) UpdateItemQualityScores(ctx context.Context, trainingDatasetID uuid.UUID, scores map[uuid.UUID]entities.TrainingDataItemQualityScore) error {
+	for id, score := range scores {
+		m.scores[id] = score
+	}
+	return nil
+}
+
+func newTestRunQualityScoringJobUseCase(llmClient clients.OllamaLLMClient, job *entities.QualityScoringJob) (*RunQualityScoringJobUseCaseImpl, *mockQualityScoringJobRepository, *mockScoringTrainingDatasetRepository) {
+	score := 3.0
+	jobRepo := &mockQualityScoringJobRepository{job: job}
+	trainingDatasetRepo := &mockScoringTrainingDatasetRepository{
+		trainingDataset: &entities.TrainingDataset{
+			ID:          job.TrainingDatasetID,
+			Status:      entities.TrainingDatasetStatusDone,
+			FieldNames:  []string{"question", "answer"},
+			InputField:  "question",
+			OutputField: "answer",
+			Data: []entities.TrainingDataItem{
+				{ID: uuid.New(), Values: []string{"What is AI?", "Artificial Intelligence"}},
+				{ID: uuid.New(), Values: []string{"What is ML?", "Machine Learning"}},
+				{ID: uuid.New(), Values: []string{"What is Go?", "A language"}, QualityScore: &score},
+			},
+		},
+		scores: make(map[uuid.UUID]entities.TrainingDataItemQualityScore),
+	}
+
+	return &RunQualityScoringJobUseCaseImpl{
+		TrainingDatasetService:      &services.TrainingDatasetService{},
+		TrainingDataQualityService:  &services.TrainingDataQualityService{OllamaLLMClient: llmClient},
+		TrainingDatasetRepository:   trainingDatasetRepo,
+		QualityScoringJobRepository: jobRepo,
+	}, jobRepo, trainingDatasetRepo
+}
+
+func TestRunQualityScoringJobUseCaseImpl_Execute(t *testing.T) {
+	job := &entities.QualityScoringJob{ID: uuid.New(), TrainingDatasetID: uuid.New(), Status: entities.QualityScoringJobStatusPending}
+	useCase, jobRepo, trainingDatasetRepo := newTestRunQualityScoringJobUseCase(&mockOllamaLLMClient{
+		result: &clients.OllamaLLMClientResult{Response: `{"score": 8, "rationale": "Correct."}`},
+	}, job)
+
+	result, err := useCase.Execute(context.Background(), in.RunQualityScoringJobCommand{Lease: time.Minute})
+
+	// The item that already has a score is not judged again
+	require.NoError(t, err)
+	assert.Equal(t, job.ID, result.Job.ID)
+	assert.Len(t, trainingDatasetRepo.scores, 2)
+	finished := jobRepo.updated[len(jobRepo.updated)-1]
+	assert.Equal(t, entities.QualityScoringJobStatusDone, finished.Status)
+	assert.Equal(t, 2, finished.ItemsTotal)
+	assert.Equal(t, 2, finished.ItemsScored)
+	assert.NotNil(t, finished.FinishedAt)
+	assert.Nil(t, finished.LeaseUntil)
+}
+
+func TestRunQualityScoringJobUseCaseImpl_Execute_JudgeUnavailable(t *testing.T) {
+	job := &entities.QualityScoringJob{ID: uuid.New(), TrainingDatasetID: uuid.New(), Status: entities.QualityScoringJobStatusPending}
+	useCase, jobRepo, _ := newTestRunQualityScoringJobUseCase(&mockOllamaLLMClient{err: errors.New("connection refused")}, job)
+
+	_, err := useCase.Execute(context.Background(), in.RunQualityScoringJobCommand{Lease: time.Minute})
+
+	require.Error(t, err)
+	finished := jobRepo.updated[len(jobRepo.updated)-1]
+	assert.Equal(t, entities.QualityScoringJobStatusFailed, finished.Status)
+	assert.Equal(t, 2, finished.ItemsFailed)
+	if assert.NotNil(t, finished.LastError) {
+		assert.Contains(t, *finished.LastError, "connection refused")
+	}
+}
+
+func TestRunQualityScoringJobUseCaseImpl_Execute_TooManyAttempts(t *testing.T) {
+	job := &entities.QualityScoringJob{ID: uuid.New(), TrainingDatasetID: uuid.New(), Status: entities.QualityScoringJobStatusRunning, Attempts: maxQualityScoringJobAttempts}
+	useCase, jobRepo, trainingDatasetRepo := newTestRunQualityScoringJobUseCase(&mockOllamaLLMClient{}, job)
+
+	_, err := useCase.Execute(context.Background(), in.RunQualityScoringJobCommand{Lease: time.Minute})
+
+	require.Error(t, err)
+	assert.Empty(t, trainingDatasetRepo.scores)
+	assert.Equal(t, entities.QualityScoringJobStatusFailed, jobRepo.updated[0].Status)
+}
+
+func TestRunQualityScoringJobUseCaseImpl_Execute_NoJob(t *testing.T) {
+	useCase := &RunQualityScoringJobUseCaseImpl{QualityScoringJobRepository: &mockQualityScoringJobRepository{}}
+
+	result, err := useCase.Execute(context.Background(), in.RunQualityScoringJobCommand{Lease: time.Minute})
+
+	require.NoError(t, err)
+	assert.Nil(t, result.Job)
+}
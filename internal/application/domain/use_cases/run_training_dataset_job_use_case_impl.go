@@ -0,0 +1,267 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type RunTrainingDatasetJobUseCaseImpl struct {
+	TrainingDatasetRepository            persistence.TrainingDatasetRepository
+	CorpusRepository                     persistence.CorpusRepository
+	CorpusChunkingService                *services.CorpusChunkingService
+	TrainingDatasetGenerationService     *services.TrainingDatasetGenerationService
+	TrainingDatasetResultsClient         clients.TrainingDatasetResultsClient
+	UpdateTrainingDatasetStatusUseCase   in.UpdateTrainingDatasetStatusUseCase
+	UpdateTrainingDatasetProgressUseCase in.UpdateTrainingDatasetProgressUseCase
+}
+
+func (uc *RunTrainingDatasetJobUseCaseImpl) Execute(ctx context.Context, command in.RunTrainingDatasetJobCommand) (*in.RunTrainingDatasetJobResult, error) {
+	trainingDatasetID, err := uuid.Parse(command.Job.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid training dataset ID in job: %w", err)
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, trainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil {
+		return nil, fmt.Errorf("training dataset not found")
+	}
+
+	// The worker deletes a job only after its run, a training dataset that is still RUNNING was left behind by a
+	// worker that stopped during the run. Running the job again would duplicate the examples written so far, the
+	// training dataset fails instead and a resume only generates the missing examples.
+	if trainingDataset.Status == entities.TrainingDatasetStatusRunning {
+		failErr := uc.UpdateTrainingDatasetStatusUseCase.Execute(ctx, in.UpdateTrainingDatasetStatusCommand{
+			TrainingDatasetID: trainingDatasetID,
+			Status:            entities.TrainingDatasetStatusFailed,
+		})
+		return nil, errors.Join(errors.New("the previous run of the training dataset was interrupted"), failErr)
+	}
+
+	err = uc.UpdateTrainingDatasetStatusUseCase.Execute(ctx, in.UpdateTrainingDatasetStatusCommand{
+		TrainingDatasetID: trainingDatasetID,
+		Status:            entities.TrainingDatasetStatusRunning,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := uc.generate(ctx, trainingDataset, command.Job)
+	if err != nil {
+		// The dataset is marked as failed even when the worker is shutting down
+		failErr := uc.UpdateTrainingDatasetStatusUseCase.Execute(context.WithoutCancel(ctx), in.UpdateTrainingDatasetStatusCommand{
+			TrainingDatasetID: trainingDatasetID,
+			Status:            entities.TrainingDatasetStatusFailed,
+		})
+		return nil, errors.Join(err, failErr)
+	}
+	// The status is already ABORTED, the results written so far are kept for a resume
+	if result.Aborted {
+		return result, nil
+	}
+
+	// Setting the status to DONE reads the results back from S3, the same as for the external runner
+	err = uc.UpdateTrainingDatasetStatusUseCase.Execute(ctx, in.UpdateTrainingDatasetStatusCommand{
+		TrainingDatasetID: trainingDatasetID,
+		Status:            entities.TrainingDatasetStatusDone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (uc *RunTrainingDatasetJobUseCaseImpl) generate(ctx context.Context, trainingDataset *entities.TrainingDataset, job entities.TrainingDatasetJob) (*in.RunTrainingDatasetJobResult, error) {
+	chunks, err := uc.chunkCorpus(ctx, trainingDataset, job)
+	if err != nil {
+		return nil, err
+	}
+
+	// A resumed job only generates from the chunks without results
+	if len(chunks) > 0 && len(job.SkipChunks) > 0 {
+		chunks = uc.TrainingDatasetGenerationService.RemainingChunks(chunks, job.SkipChunks)
+	}
+
+	fieldNames := uc.TrainingDatasetGenerationService.OrderedFieldNames(trainingDataset.FieldNames, job.JSONObjectFields)
+	requests := uc.TrainingDatasetGenerationService.PlanGenerationRequests(chunks, job.GenerateExamplesNumber)
+
+	// Chunks are counted once, even when they are used for more than one request
+	var chunksTotal *int
+	if len(chunks) > 0 {
+		planned := countDistinctChunks(requests)
+		chunksTotal = &planned
+	}
+	progress := in.UpdateTrainingDatasetProgressCommand{
+		TrainingDatasetID: trainingDataset.ID,
+		ItemsGenerated:    job.ResumedExamplesNumber,
+		ChunksTotal:       chunksTotal,
+	}
+	if err := uc.UpdateTrainingDatasetProgressUseCase.Execute(ctx, progress); err != nil {
+		return nil, fmt.Errorf("failed to report training dataset progress: %w", err)
+	}
+
+	// The results of every run get their own files, a resumed run must not overwrite the files of the earlier run
+	runID := time.Now().Format("060102150405")
+
+	result := &in.RunTrainingDatasetJobResult{}
+	for batchStart := 0; batchStart < len(requests); batchStart += services.GenerationBatchSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		aborted, err := uc.isAborted(ctx, trainingDataset.ID)
+		if err != nil {
+			return nil, err
+		}
+		if aborted {
+			result.Aborted = true
+			return result, nil
+		}
+
+		batch := requests[batchStart:min(batchStart+services.GenerationBatchSize, len(requests))]
+		parts := make([]*clients.TrainingDatasetResultsPart, len(batch))
+
+		var wg sync.WaitGroup
+		for i := range batch {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				part, err := uc.TrainingDatasetGenerationService.GenerateExamples(ctx, job, fieldNames, batch[i])
+				if err != nil {
+					log.Printf("generation request for training dataset %s failed: %v", trainingDataset.ID, err)
+					return
+				}
+				parts[i] = part
+			}(i)
+		}
+		wg.Wait()
+
+		// Every batch is written as its own results file, the results client sums them up
+		batchPart := clients.TrainingDatasetResultsPart{}
+		for _, part := range parts {
+			if part == nil {
+				result.FailedRequests++
+				continue
+			}
+			batchPart.TotalGenerationTimeSeconds += part.TotalGenerationTimeSeconds
+			batchPart.TokensIn += part.TokensIn
+			batchPart.TokensOut += part.TokensOut
+			batchPart.Annotations = append(batchPart.Annotations, part.Annotations...)
+		}
+		if len(batchPart.Annotations) > 0 {
+			partName := fmt.Sprintf("worker_%s_%05d", runID, batchStart/services.GenerationBatchSize)
+			if err := uc.TrainingDatasetResultsClient.PutTrainingDatasetResultsPart(ctx, trainingDataset.ID, partName, batchPart); err != nil {
+				return nil, fmt.Errorf("failed to write training dataset results: %w", err)
+			}
+			result.GeneratedExamples += len(batchPart.Annotations)
+		}
+
+		// Progress is informational, a failed report does not fail the generation
+		progress.ItemsGenerated = job.ResumedExamplesNumber + result.GeneratedExamples
+		progress.ChunksProcessed = countDistinctChunks(requests[:batchStart+len(batch)])
+		if chunksTotal == nil {
+			progress.ChunksProcessed = 0
+		}
+		progress.TokensIn += batchPart.TokensIn
+		progress.TokensOut += batchPart.TokensOut
+		if err := uc.UpdateTrainingDatasetProgressUseCase.Execute(ctx, progress); err != nil {
+			log.Printf("failed to report progress for training dataset %s: %v", trainingDataset.ID, err)
+		}
+	}
+
+	// A resume without missing examples only collects the results of the earlier runs
+	if result.GeneratedExamples == 0 && len(requests) > 0 {
+		return nil, fmt.Errorf("no examples were generated, %d of %d requests failed", result.FailedRequests, len(requests))
+	}
+
+	return result, nil
+}
+
+// chunkCorpus returns the chunks the examples are generated from, an empty list for datasets without a corpus
+func (uc *RunTrainingDatasetJobUseCaseImpl) chunkCorpus(ctx context.Context, trainingDataset *entities.TrainingDataset, job entities.TrainingDatasetJob) ([]entities.CorpusChunk, error) {
+	if trainingDataset.CorpusID == nil {
+		if job.CorpusS3Path != "" {
+			return nil, fmt.Errorf("training dataset has no corpus for the corpus path %s", job.CorpusS3Path)
+		}
+		return []entities.CorpusChunk{}, nil
+	}
+
+	corpus, err := uc.CorpusRepository.GetByID(ctx, *trainingDataset.CorpusID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get corpus: %w", err)
+	}
+	if corpus == nil {
+		return nil, fmt.Errorf("corpus not found")
+	}
+
+	// The job holds the files subset at submission time, the corpus may have changed since
+	if len(job.CorpusFilesSubset) > 0 {
+		filesSubset := job.CorpusFilesSubset
+		corpus.FilesSubset = &filesSubset
+	}
+
+	config := uc.CorpusChunkingService.DefaultChunkingConfig()
+	if job.Chunking != nil {
+		config = *job.Chunking
+	}
+
+	documentChunks, err := uc.CorpusChunkingService.ChunkCorpus(ctx, corpus, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// The chunking config is needed to trace the items back to their chunks
+	if trainingDataset.ChunkingConfig == nil {
+		trainingDataset.ChunkingConfig = &config
+		if err := uc.TrainingDatasetRepository.UpdateChunkingConfig(ctx, trainingDataset.ID, config); err != nil {
+			return nil, fmt.Errorf("failed to update training dataset: %w", err)
+		}
+	}
+
+	chunks := []entities.CorpusChunk{}
+	for _, document := range documentChunks {
+		chunks = append(chunks, document.Chunks...)
+	}
+	if len(chunks) == 0 {
+		return nil, fmt.Errorf("corpus has no text to generate from")
+	}
+
+	return chunks, nil
+}
+
+// isAborted reads the status again, the user may abort the training dataset while it is generated
+func (uc *RunTrainingDatasetJobUseCaseImpl) isAborted(ctx context.Context, trainingDatasetID uuid.UUID) (bool, error) {
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, trainingDatasetID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil {
+		return false, fmt.Errorf("training dataset not found")
+	}
+	return trainingDataset.Status == entities.TrainingDatasetStatusAborted, nil
+}
+
+func countDistinctChunks(requests []services.TrainingDatasetGenerationRequest) int {
+	chunks := map[*entities.CorpusChunk]bool{}
+	for _, request := range requests {
+		if request.Chunk != nil {
+			chunks[request.Chunk] = true
+		}
+	}
+	return len(chunks)
+}
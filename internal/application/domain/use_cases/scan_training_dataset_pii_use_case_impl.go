@@ -0,0 +1,47 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type ScanTrainingDatasetPIIUseCaseImpl struct {
+	ProjectService            *services.ProjectService
+	TrainingDatasetService    *services.TrainingDatasetService
+	PIIService                *services.PIIService
+	TrainingDatasetRepository persistence.TrainingDatasetRepository
+	PIIReportRepository       persistence.PIIReportRepository
+}
+
+func (uc *ScanTrainingDatasetPIIUseCaseImpl) ScanTrainingDatasetPII(ctx context.Context, command in.ScanTrainingDatasetPIICommand) (*in.ScanTrainingDatasetPIIResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+
+	// Only report on the data, the stored items are left untouched
+	activeItems := uc.TrainingDatasetService.ActiveTrainingDataItems(trainingDataset.Data)
+	_, report := uc.PIIService.ScanTrainingDataItems(trainingDataset.ID, activeItems, trainingDataset.FieldNames, false)
+
+	if err := uc.PIIReportRepository.Create(ctx, report); err != nil {
+		return nil, fmt.Errorf("failed to save PII report: %w", err)
+	}
+
+	return &in.ScanTrainingDatasetPIIResult{
+		Report: report,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package use_cases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type ScheduleFinetunesUseCaseImpl struct {
+	FinetuneQueueService *services.FinetuneQueueService
+	FinetuneRepository   persistence.FinetuneRepository
+}
+
+func (uc *ScheduleFinetunesUseCaseImpl) Execute(ctx context.Context, command in.ScheduleFinetunesCommand) (*in.ScheduleFinetunesResult, error) {
+	queued := 0
+	dispatched, err := uc.FinetuneRepository.DispatchScheduled(ctx, func(entries []entities.FinetuneQueueEntry) []*entities.FinetuneDispatchEvent {
+		queued = len(uc.FinetuneQueueService.QueuedInOrder(entries))
+
+		var events []*entities.FinetuneDispatchEvent
+		for _, entry := range uc.FinetuneQueueService.SelectDispatchable(entries, command.MaxConcurrent, command.MaxConcurrentPerUser) {
+			message := fmt.Sprintf("Dispatched with priority %s after %s in the queue", entry.Priority, time.Since(entry.CreatedAt).Round(time.Second))
+			events = append(events, &entities.FinetuneDispatchEvent{
+				FinetuneID: entry.FinetuneID,
+				Type:       entities.FinetuneDispatchEventDispatched,
+				Message:    &message,
+			})
+		}
+		return events
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to dispatch scheduled finetunes: %w", err)
+	}
+
+	return &in.ScheduleFinetunesResult{Dispatched: dispatched, Queued: queued - dispatched}, nil
+}
@@ -0,0 +1,65 @@
+package use_cases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+// mockSchedulerFinetuneRepository only implements what the scheduler uses, other calls panic
+type mockSchedulerFinetuneRepository struct {
+	persistence.FinetuneRepository
+	entries    []entities.FinetuneQueueEntry
+	dispatched []uuid.UUID
+	events     []*entities.FinetuneDispatchEvent
+	// gone are finetunes that left the queue after they were listed
+	gone map[uuid.UUID]bool
+}
+
+func (m *mockSchedulerFinetuneRepository) DispatchScheduled(ctx context.Context, selectDispatchable func(entries []entities.FinetuneQueueEntry) []*entities.FinetuneDispatchEvent) (int, error) {
+	for _, event := range selectDispatchable(m.entries) {
+		if m.gone[event.FinetuneID] {
+			continue
+		}
+		m.dispatched = append(m.dispatched, event.FinetuneID)
+		m.events = append(m.events, event)
+	}
+	return len(m.dispatched), nil
+}
+
+func TestScheduleFinetunesUseCaseImpl_Execute(t *testing.T) {
+	owner := uuid.New()
+	now := time.Now()
+	running := entities.FinetuneQueueEntry{FinetuneID: uuid.New(), OwnerID: owner, Status: entities.FinetuneStatusRunning, Priority: entities.FinetunePriorityNormal, CreatedAt: now}
+	aborted := entities.FinetuneQueueEntry{FinetuneID: uuid.New(), OwnerID: owner, Status: entities.FinetuneStatusQueued, Priority: entities.FinetunePriorityHigh, CreatedAt: now}
+	low := entities.FinetuneQueueEntry{FinetuneID: uuid.New(), OwnerID: owner, Status: entities.FinetuneStatusQueued, Priority: entities.FinetunePriorityLow, CreatedAt: now.Add(-time.Hour)}
+	normal := entities.FinetuneQueueEntry{FinetuneID: uuid.New(), OwnerID: owner, Status: entities.FinetuneStatusQueued, Priority: entities.FinetunePriorityNormal, CreatedAt: now}
+
+	finetuneRepo := &mockSchedulerFinetuneRepository{
+		entries: []entities.FinetuneQueueEntry{running, low, normal, aborted},
+		gone:    map[uuid.UUID]bool{aborted.FinetuneID: true},
+	}
+	useCase := &ScheduleFinetunesUseCaseImpl{
+		FinetuneQueueService: &services.FinetuneQueueService{},
+		FinetuneRepository:   finetuneRepo,
+	}
+
+	result, err := useCase.Execute(context.Background(), in.ScheduleFinetunesCommand{MaxConcurrent: 3, MaxConcurrentPerUser: 0})
+
+	// The aborted finetune takes no slot, the next one in the queue does not get it before the next run
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Dispatched)
+	assert.Equal(t, 2, result.Queued)
+	assert.Equal(t, []uuid.UUID{normal.FinetuneID}, finetuneRepo.dispatched)
+	assert.Equal(t, entities.FinetuneDispatchEventDispatched, finetuneRepo.events[0].Type)
+	assert.Contains(t, *finetuneRepo.events[0].Message, "priority NORMAL")
+}
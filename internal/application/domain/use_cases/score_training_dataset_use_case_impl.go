@@ -0,0 +1,82 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type ScoreTrainingDatasetUseCaseImpl struct {
+	ProjectService              *services.ProjectService
+	TrainingDatasetService      *services.TrainingDatasetService
+	TrainingDataQualityService  *services.TrainingDataQualityService
+	TrainingDatasetRepository   persistence.TrainingDatasetRepository
+	QualityScoringJobRepository persistence.QualityScoringJobRepository
+}
+
+func (uc *ScoreTrainingDatasetUseCaseImpl) ScoreTrainingDataset(ctx context.Context, command in.ScoreTrainingDatasetCommand) (*in.ScoreTrainingDatasetResult, error) {
+	// Verify project exists and user has access
+	_, err := uc.ProjectService.GetProject(ctx, command.ProjectID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil || trainingDataset.ProjectID != command.ProjectID {
+		return nil, errors.New("training dataset not found")
+	}
+	if trainingDataset.Status != entities.TrainingDatasetStatusDone {
+		return nil, errors.New("training dataset must be in DONE status")
+	}
+
+	// Conversations have no fields, they are judged on their messages. The fields are checked here so that a job
+	// that can not run is never created.
+	if trainingDataset.Type != entities.TrainingDatasetTypeConversation {
+		if _, _, err := uc.TrainingDatasetService.InputOutputFieldIndexes(trainingDataset); err != nil {
+			return nil, err
+		}
+	}
+
+	rubric := strings.TrimSpace(command.Rubric)
+	if rubric == "" {
+		rubric = services.DefaultQualityRubric
+	}
+	model := command.Model
+	if model == "" {
+		model = services.DefaultQualityJudgeModel
+	}
+
+	items := uc.TrainingDataQualityService.ItemsToScore(uc.TrainingDatasetService.ActiveTrainingDataItems(trainingDataset.Data), command.Rescore)
+
+	// Judging every item takes much longer than a request, so a worker scores them. The job is only created when no
+	// other job is waiting or running for the training dataset, so the judge is never paid twice for an item.
+	job := &entities.QualityScoringJob{
+		TrainingDatasetID: trainingDataset.ID,
+		Status:            entities.QualityScoringJobStatusPending,
+		Model:             model,
+		Rubric:            rubric,
+		Rescore:           command.Rescore,
+		ItemsTotal:        len(items),
+	}
+	created, err := uc.QualityScoringJobRepository.Create(ctx, job)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create quality scoring job: %w", err)
+	}
+	if !created {
+		return nil, errors.New("training dataset is already being scored")
+	}
+
+	return &in.ScoreTrainingDatasetResult{
+		Job:         job,
+		ItemsQueued: len(items),
+	}, nil
+}
@@ -0,0 +1,77 @@
+package use_cases
+
+import (
+	"context"
+	"fmt"
+
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type SyncCorpusUseCaseImpl struct {
+	CorpusRepository         persistence.CorpusRepository
+	CorpusService            *services.CorpusService
+	CorpusDocumentRepository persistence.CorpusDocumentRepository
+	CorpusStorageClient      clients.CorpusStorageClient
+}
+
+func (uc *SyncCorpusUseCaseImpl) SyncCorpus(ctx context.Context, command in.SyncCorpusCommand) (*in.SyncCorpusResult, error) {
+	corpus, err := uc.CorpusService.GetOwnedCorpus(ctx, command.CorpusID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := uc.CorpusStorageClient.ListDocuments(ctx, corpus.S3Path)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := uc.CorpusDocumentRepository.GetByCorpusID(ctx, corpus.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get corpus documents: %w", err)
+	}
+	known := make(map[string]bool, len(documents))
+	for _, document := range documents {
+		known[document.FileName] = true
+	}
+
+	changed, removed := uc.CorpusService.ReconcileDocuments(corpus.ID, objects, documents)
+
+	added := []string{}
+	for _, document := range changed {
+		if err := uc.CorpusDocumentRepository.Save(ctx, document); err != nil {
+			return nil, fmt.Errorf("failed to save corpus document: %w", err)
+		}
+		if !known[document.FileName] {
+			added = append(added, document.FileName)
+		}
+	}
+
+	subsetChanged := false
+	for _, fileName := range removed {
+		if err := uc.CorpusDocumentRepository.DeleteByFileName(ctx, corpus.ID, fileName); err != nil {
+			return nil, fmt.Errorf("failed to delete corpus document: %w", err)
+		}
+		if uc.CorpusService.RemoveFromFilesSubset(corpus, fileName) {
+			subsetChanged = true
+		}
+	}
+	if subsetChanged {
+		if err := uc.CorpusRepository.Update(ctx, corpus); err != nil {
+			return nil, fmt.Errorf("failed to update corpus: %w", err)
+		}
+	}
+
+	documents, err = uc.CorpusDocumentRepository.GetByCorpusID(ctx, corpus.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get corpus documents: %w", err)
+	}
+
+	return &in.SyncCorpusResult{
+		Documents: documents,
+		Added:     added,
+		Removed:   removed,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package use_cases
+
+import (
+	"context"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type UpdateCorpusFilesSubsetUseCaseImpl struct {
+	CorpusRepository         persistence.CorpusRepository
+	CorpusService            *services.CorpusService
+	CorpusDocumentRepository persistence.CorpusDocumentRepository
+}
+
+func (uc *UpdateCorpusFilesSubsetUseCaseImpl) UpdateCorpusFilesSubset(ctx context.Context, command in.UpdateCorpusFilesSubsetCommand) (*entities.Corpus, error) {
+	corpus, err := uc.CorpusService.GetOwnedCorpus(ctx, command.CorpusID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if command.FilesSubset != nil {
+		documents, err := uc.CorpusDocumentRepository.GetByCorpusID(ctx, corpus.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get corpus documents: %w", err)
+		}
+		if err := uc.CorpusService.ValidateFilesSubset(*command.FilesSubset, documents); err != nil {
+			return nil, err
+		}
+	}
+
+	corpus.FilesSubset = command.FilesSubset
+	if err := uc.CorpusRepository.Update(ctx, corpus); err != nil {
+		return nil, fmt.Errorf("failed to update corpus: %w", err)
+	}
+
+	return corpus, nil
+}
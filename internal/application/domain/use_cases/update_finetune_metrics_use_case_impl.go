@@ -0,0 +1,57 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type UpdateFinetuneMetricsUseCaseImpl struct {
+	FinetuneMetricsService   *services.FinetuneMetricsService
+	FinetuneRepository       persistence.FinetuneRepository
+	FinetuneMetricRepository persistence.FinetuneMetricRepository
+}
+
+func (uc *UpdateFinetuneMetricsUseCaseImpl) Execute(ctx context.Context, command in.UpdateFinetuneMetricsCommand) error {
+	metric := &entities.FinetuneMetric{
+		FinetuneID:   command.FinetuneID,
+		Step:         command.Step,
+		Epoch:        command.Epoch,
+		TrainLoss:    command.TrainLoss,
+		EvalLoss:     command.EvalLoss,
+		LearningRate: command.LearningRate,
+		GPUMemoryGB:  command.GPUMemoryGB,
+	}
+	if err := uc.FinetuneMetricsService.ValidateMetric(metric); err != nil {
+		return err
+	}
+
+	finetune, err := uc.FinetuneRepository.GetByID(ctx, command.FinetuneID)
+	if err != nil {
+		return fmt.Errorf("failed to get finetune: %w", err)
+	}
+	if finetune == nil {
+		return errors.New("finetune not found")
+	}
+	if !uc.FinetuneMetricsService.IsMetricsReportable(finetune.Status) {
+		return fmt.Errorf("finetune is not running, status is %s", finetune.Status)
+	}
+
+	if err := uc.FinetuneMetricRepository.Save(ctx, metric); err != nil {
+		return fmt.Errorf("failed to save finetune metric: %w", err)
+	}
+
+	if command.InferenceSamples != nil {
+		samples := uc.FinetuneMetricsService.MergeInferenceSamples(finetune.InferenceSamples, command.Step, command.InferenceSamples)
+		if err := uc.FinetuneRepository.UpdateInferenceSamples(ctx, finetune.ID, samples); err != nil {
+			return fmt.Errorf("failed to update inference samples: %w", err)
+		}
+	}
+
+	return nil
+}
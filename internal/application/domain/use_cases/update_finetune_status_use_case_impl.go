@@ -5,12 +5,17 @@ import (
 	"fmt"
 
 	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
 	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
 	"ai-platform/internal/application/port/out/persistence"
 )
 
 type UpdateFinetuneStatusUseCaseImpl struct {
-	FinetuneRepository persistence.FinetuneRepository
+	FinetuneRepository      persistence.FinetuneRepository
+	StatusTransitionService *services.StatusTransitionService
+	FinetuneService         *services.FinetuneService
+	FinetuneArtifactClient  clients.FinetuneArtifactClient
 }
 
 func (uc *UpdateFinetuneStatusUseCaseImpl) Execute(ctx context.Context, command in.UpdateFinetuneStatusCommand) error {
@@ -24,26 +29,67 @@ func (uc *UpdateFinetuneStatusUseCaseImpl) Execute(ctx context.Context, command
 	}
 
 	// Validate status transition
-	if !isValidFinetuneStatusTransition(finetune.Status, command.Status) {
-		return fmt.Errorf("invalid status transition from %s to %s", finetune.Status, command.Status)
+	if err := uc.StatusTransitionService.ValidateExternalFinetuneStatusTransition(finetune.Status, command.Status); err != nil {
+		return err
 	}
 
-	// Update the status
-	err = uc.FinetuneRepository.UpdateStatus(ctx, command.FinetuneID, command.Status)
+	if command.Status == entities.FinetuneStatusDone {
+		return uc.complete(ctx, finetune, command.Result)
+	}
+
+	// Only written while the finetune is in the status it was read with, so an abort that happened meanwhile is kept
+	updated, err := uc.FinetuneRepository.UpdateStatusFrom(ctx, command.FinetuneID, finetune.Status, command.Status)
 	if err != nil {
 		return fmt.Errorf("failed to update finetune status: %w", err)
 	}
+	if !updated {
+		return fmt.Errorf("status conflict, the finetune is not %s anymore", finetune.Status)
+	}
 
 	return nil
 }
 
-func isValidFinetuneStatusTransition(current, new entities.FinetuneStatus) bool {
-	// Only allow updates to RUNNING, FAILED, and DONE statuses
-	allowedStatuses := map[entities.FinetuneStatus]bool{
-		entities.FinetuneStatusRunning: true,
-		entities.FinetuneStatusFailed:  true,
-		entities.FinetuneStatusDone:    true,
+// complete records the metadata and the GGUF of the model together with the DONE status
+func (uc *UpdateFinetuneStatusUseCaseImpl) complete(ctx context.Context, finetune *entities.Finetune, reported *entities.FinetuneResult) error {
+	manifest, err := uc.FinetuneArtifactClient.GetResultManifest(ctx, finetune.ID, finetune.ModelName)
+	if err != nil {
+		return fmt.Errorf("failed to get result manifest: %w", err)
+	}
+
+	result := uc.FinetuneService.MergeFinetuneResults(reported, manifest)
+	if err := uc.FinetuneService.ValidateFinetuneResult(reported, manifest, result); err != nil {
+		return err
 	}
 
-	return allowedStatuses[new]
-}
\ No newline at end of file
+	fileSizeBytes, err := uc.FinetuneArtifactClient.GetModelSize(ctx, finetune.ID, finetune.ModelName)
+	if err != nil {
+		return fmt.Errorf("failed to get model artifact: %w", err)
+	}
+
+	// Hashing a GGUF takes a while, trainers should send the checksum they computed while uploading
+	var checksum string
+	if result.ModelSHA256 != nil {
+		checksum = *result.ModelSHA256
+	} else {
+		checksum, err = uc.FinetuneArtifactClient.ComputeModelChecksum(ctx, finetune.ID, finetune.ModelName)
+		if err != nil {
+			return fmt.Errorf("failed to compute model checksum: %w", err)
+		}
+	}
+
+	fromStatus := finetune.Status
+	uc.FinetuneService.ApplyFinetuneResult(finetune, result, fileSizeBytes, checksum)
+
+	// Only the columns of the result are written and only while the finetune is in the status it was read with, so
+	// an abort that happened meanwhile is kept
+	completed, err := uc.FinetuneRepository.Complete(ctx, finetune, fromStatus)
+	if err != nil {
+		return fmt.Errorf("failed to update finetune status: %w", err)
+	}
+	if !completed {
+		return fmt.Errorf("status conflict, the finetune is not %s anymore", fromStatus)
+	}
+	finetune.Status = entities.FinetuneStatusDone
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package use_cases
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+// mockCompletionFinetuneRepository only implements what the status update uses, other calls like Update panic
+type mockCompletionFinetuneRepository struct {
+	persistence.FinetuneRepository
+	finetune *entities.Finetune
+	// statusAfterRead is the status another request writes after the finetune was read
+	statusAfterRead entities.FinetuneStatus
+}
+
+func (m *mockCompletionFinetuneRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Finetune, error) {
+	finetune := *m.finetune
+	if m.statusAfterRead != "" {
+		m.finetune.Status = m.statusAfterRead
+	}
+	return &finetune, nil
+}
+
+func (m *mockCompletionFinetuneRepository) UpdateStatusFrom(ctx context.Context, id uuid.UUID, fromStatus entities.FinetuneStatus, status entities.FinetuneStatus) (bool, error) {
+	if m.finetune.Status != fromStatus {
+		return false, nil
+	}
+	m.finetune.Status = status
+	return true, nil
+}
+
+func (m *mockCompletionFinetuneRepository) Complete(ctx context.Context, finetune *entities.Finetune, fromStatus entities.FinetuneStatus) (bool, error) {
+	if m.finetune.Status != fromStatus {
+		return false, nil
+	}
+	m.finetune = finetune
+	m.finetune.Status = entities.FinetuneStatusDone
+	return true, nil
+}
+
+type mockFinetuneArtifactClient struct {
+	manifest *entities.FinetuneResult
+	// hashed tells if the GGUF was hashed by the API
+	hashed bool
+}
+
+func (m *mockFinetuneArtifactClient) GetResultManifest(ctx context.Context, finetuneID uuid.UUID, modelName string) (*entities.FinetuneResult, error) {
+	return m.manifest, nil
+}
+
+func (m *mockFinetuneArtifactClient) GetModelSize(ctx context.Context, finetuneID uuid.UUID, modelName string) (int64, error) {
+	return 3 << 30, nil
+}
+
+func (m *mockFinetuneArtifactClient) ComputeModelChecksum(ctx context.Context, finetuneID uuid.UUID, modelName string) (string, error) {
+	m.hashed = true
+	return strings.Repeat("c", 64), nil
+}
+
+func newTestUpdateFinetuneStatusUseCase(finetuneRepo *mockCompletionFinetuneRepository, manifest *entities.FinetuneResult) *UpdateFinetuneStatusUseCaseImpl {
+	return &UpdateFinetuneStatusUseCaseImpl{
+		FinetuneRepository:      finetuneRepo,
+		StatusTransitionService: &services.StatusTransitionService{},
+		FinetuneService:         &services.FinetuneService{},
+		FinetuneArtifactClient:  &mockFinetuneArtifactClient{manifest: manifest},
+	}
+}
+
+func TestUpdateFinetuneStatusUseCaseImpl_Done(t *testing.T) {
+	checksum := strings.Repeat("a", 64)
+	finetuneRepo := &mockCompletionFinetuneRepository{finetune: &entities.Finetune{ID: uuid.New(), Status: entities.FinetuneStatusRunning}}
+	useCase := newTestUpdateFinetuneStatusUseCase(finetuneRepo, &entities.FinetuneResult{ModelSHA256: &checksum})
+
+	err := useCase.Execute(context.Background(), in.UpdateFinetuneStatusCommand{FinetuneID: finetuneRepo.finetune.ID, Status: entities.FinetuneStatusDone})
+
+	require.NoError(t, err)
+	assert.False(t, useCase.FinetuneArtifactClient.(*mockFinetuneArtifactClient).hashed)
+	assert.Equal(t, entities.FinetuneStatusDone, finetuneRepo.finetune.Status)
+	assert.Equal(t, checksum, *finetuneRepo.finetune.ModelSHA256)
+	assert.Equal(t, int64(3<<30), *finetuneRepo.finetune.ModelFileSizeBytes)
+}
+
+func TestUpdateFinetuneStatusUseCaseImpl_DoneWithoutChecksum(t *testing.T) {
+	finetuneRepo := &mockCompletionFinetuneRepository{finetune: &entities.Finetune{ID: uuid.New(), Status: entities.FinetuneStatusRunning}}
+	useCase := newTestUpdateFinetuneStatusUseCase(finetuneRepo, nil)
+
+	err := useCase.Execute(context.Background(), in.UpdateFinetuneStatusCommand{FinetuneID: finetuneRepo.finetune.ID, Status: entities.FinetuneStatusDone})
+
+	// Trainers that report no checksum, like the Runpod trainer, get the checksum computed from the GGUF
+	require.NoError(t, err)
+	assert.True(t, useCase.FinetuneArtifactClient.(*mockFinetuneArtifactClient).hashed)
+	assert.Equal(t, entities.FinetuneStatusDone, finetuneRepo.finetune.Status)
+	assert.Equal(t, strings.Repeat("c", 64), *finetuneRepo.finetune.ModelSHA256)
+}
+
+func TestUpdateFinetuneStatusUseCaseImpl_DoneWithMismatchingChecksum(t *testing.T) {
+	reported, manifest := strings.Repeat("a", 64), strings.Repeat("b", 64)
+	finetuneRepo := &mockCompletionFinetuneRepository{finetune: &entities.Finetune{ID: uuid.New(), Status: entities.FinetuneStatusRunning}}
+	useCase := newTestUpdateFinetuneStatusUseCase(finetuneRepo, &entities.FinetuneResult{ModelSHA256: &manifest})
+
+	err := useCase.Execute(context.Background(), in.UpdateFinetuneStatusCommand{
+		FinetuneID: finetuneRepo.finetune.ID,
+		Status:     entities.FinetuneStatusDone,
+		Result:     &entities.FinetuneResult{ModelSHA256: &reported},
+	})
+
+	assert.EqualError(t, err, "model checksum does not match the checksum of the result manifest")
+	assert.Equal(t, entities.FinetuneStatusRunning, finetuneRepo.finetune.Status)
+}
+
+func TestUpdateFinetuneStatusUseCaseImpl_DoneAfterAbort(t *testing.T) {
+	checksum := strings.Repeat("a", 64)
+	finetuneRepo := &mockCompletionFinetuneRepository{
+		finetune:        &entities.Finetune{ID: uuid.New(), Status: entities.FinetuneStatusRunning},
+		statusAfterRead: entities.FinetuneStatusAborted,
+	}
+	useCase := newTestUpdateFinetuneStatusUseCase(finetuneRepo, nil)
+
+	err := useCase.Execute(context.Background(), in.UpdateFinetuneStatusCommand{
+		FinetuneID: finetuneRepo.finetune.ID,
+		Status:     entities.FinetuneStatusDone,
+		Result:     &entities.FinetuneResult{ModelSHA256: &checksum},
+	})
+
+	// The abort that happened while the result was recorded is kept
+	assert.EqualError(t, err, "status conflict, the finetune is not RUNNING anymore")
+	assert.Equal(t, entities.FinetuneStatusAborted, finetuneRepo.finetune.Status)
+}
+
+func TestUpdateFinetuneStatusUseCaseImpl_FailedAfterAbort(t *testing.T) {
+	finetuneRepo := &mockCompletionFinetuneRepository{
+		finetune:        &entities.Finetune{ID: uuid.New(), Status: entities.FinetuneStatusRunning},
+		statusAfterRead: entities.FinetuneStatusAborted,
+	}
+	useCase := newTestUpdateFinetuneStatusUseCase(finetuneRepo, nil)
+
+	err := useCase.Execute(context.Background(), in.UpdateFinetuneStatusCommand{FinetuneID: finetuneRepo.finetune.ID, Status: entities.FinetuneStatusFailed})
+
+	assert.EqualError(t, err, "status conflict, the finetune is not RUNNING anymore")
+	assert.Equal(t, entities.FinetuneStatusAborted, finetuneRepo.finetune.Status)
+}
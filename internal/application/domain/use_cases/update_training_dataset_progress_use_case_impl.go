@@ -0,0 +1,64 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type UpdateTrainingDatasetProgressUseCaseImpl struct {
+	TrainingDatasetProgressService    *services.TrainingDatasetProgressService
+	TrainingDatasetRepository         persistence.TrainingDatasetRepository
+	TrainingDatasetProgressRepository persistence.TrainingDatasetProgressRepository
+}
+
+func (uc *UpdateTrainingDatasetProgressUseCaseImpl) Execute(ctx context.Context, command in.UpdateTrainingDatasetProgressCommand) error {
+	progress := &entities.TrainingDatasetProgress{
+		TrainingDatasetID: command.TrainingDatasetID,
+		ItemsGenerated:    command.ItemsGenerated,
+		ChunksProcessed:   command.ChunksProcessed,
+		ChunksTotal:       command.ChunksTotal,
+		TokensIn:          command.TokensIn,
+		TokensOut:         command.TokensOut,
+		EtaSeconds:        command.EtaSeconds,
+	}
+	if err := uc.TrainingDatasetProgressService.ValidateProgress(progress); err != nil {
+		return err
+	}
+
+	trainingDataset, err := uc.TrainingDatasetRepository.GetMetadataByID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return fmt.Errorf("failed to get training dataset: %w", err)
+	}
+	if trainingDataset == nil {
+		return errors.New("training dataset not found")
+	}
+	if !uc.TrainingDatasetProgressService.IsProgressReportable(trainingDataset.Status) {
+		return fmt.Errorf("training dataset is not running, status is %s", trainingDataset.Status)
+	}
+
+	existing, err := uc.TrainingDatasetProgressRepository.GetByTrainingDatasetID(ctx, command.TrainingDatasetID)
+	if err != nil {
+		return fmt.Errorf("failed to get training dataset progress: %w", err)
+	}
+	// The start time is kept across the updates of a run, a report without anything processed starts a new run
+	if existing != nil && (progress.ItemsGenerated > 0 || progress.ChunksProcessed > 0) {
+		progress.StartedAt = existing.StartedAt
+	}
+
+	if progress.EtaSeconds == nil {
+		progress.EtaSeconds = uc.TrainingDatasetProgressService.EstimateRemainingSeconds(progress, trainingDataset.GenerateExamplesNumber, time.Now())
+	}
+
+	if err := uc.TrainingDatasetProgressRepository.Save(ctx, progress); err != nil {
+		return fmt.Errorf("failed to save training dataset progress: %w", err)
+	}
+
+	return nil
+}
@@ -5,14 +5,17 @@ import (
 	"fmt"
 
 	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
 	"ai-platform/internal/application/port/in"
 	"ai-platform/internal/application/port/out/clients"
 	"ai-platform/internal/application/port/out/persistence"
 )
 
 type UpdateTrainingDatasetStatusUseCaseImpl struct {
-	TrainingDatasetRepository     persistence.TrainingDatasetRepository
-	TrainingDatasetResultsClient clients.TrainingDatasetResultsClient
+	TrainingDatasetRepository        persistence.TrainingDatasetRepository
+	TrainingDatasetResultsClient     clients.TrainingDatasetResultsClient
+	TrainingDataDeduplicationService *services.TrainingDataDeduplicationService
+	StatusTransitionService          *services.StatusTransitionService
 }
 
 func (uc *UpdateTrainingDatasetStatusUseCaseImpl) Execute(ctx context.Context, command in.UpdateTrainingDatasetStatusCommand) error {
@@ -26,39 +29,33 @@ func (uc *UpdateTrainingDatasetStatusUseCaseImpl) Execute(ctx context.Context, c
 	}
 
 	// Validate status transition
-	if !isValidStatusTransition(trainingDataset.Status, command.Status) {
-		return fmt.Errorf("invalid status transition from %s to %s", trainingDataset.Status, command.Status)
+	fromStatus := trainingDataset.Status
+	if err := uc.StatusTransitionService.ValidateExternalTrainingDatasetStatusTransition(fromStatus, command.Status); err != nil {
+		return err
 	}
 
 	// If setting status to DONE, fetch results from S3 and update training data
 	if command.Status == entities.TrainingDatasetStatusDone {
-		err = uc.processCompletedTrainingDataset(ctx, trainingDataset)
+		err = uc.processCompletedTrainingDataset(ctx, trainingDataset, command)
 		if err != nil {
 			return fmt.Errorf("failed to process completed training dataset: %w", err)
 		}
 	}
 
-	// Update the status
-	err = uc.TrainingDatasetRepository.UpdateStatus(ctx, command.TrainingDatasetID, command.Status)
+	// Only written while the training dataset is in the status it was read with, so an abort that happened meanwhile
+	// is kept
+	updated, err := uc.TrainingDatasetRepository.UpdateStatusFrom(ctx, command.TrainingDatasetID, fromStatus, command.Status)
 	if err != nil {
 		return fmt.Errorf("failed to update training dataset status: %w", err)
 	}
-
-	return nil
-}
-
-func isValidStatusTransition(current, new entities.TrainingDatasetStatus) bool {
-	// Only allow updates to RUNNING, FAILED, and DONE statuses
-	allowedStatuses := map[entities.TrainingDatasetStatus]bool{
-		entities.TrainingDatasetStatusRunning: true,
-		entities.TrainingDatasetStatusFailed:  true,
-		entities.TrainingDatasetStatusDone:    true,
+	if !updated {
+		return fmt.Errorf("status conflict, the training dataset is not %s anymore", fromStatus)
 	}
 
-	return allowedStatuses[new]
+	return nil
 }
 
-func (uc *UpdateTrainingDatasetStatusUseCaseImpl) processCompletedTrainingDataset(ctx context.Context, trainingDataset *entities.TrainingDataset) error {
+func (uc *UpdateTrainingDatasetStatusUseCaseImpl) processCompletedTrainingDataset(ctx context.Context, trainingDataset *entities.TrainingDataset, command in.UpdateTrainingDatasetStatusCommand) error {
 	// Fetch results from S3
 	results, err := uc.TrainingDatasetResultsClient.GetTrainingDatasetResults(ctx, trainingDataset.ID, trainingDataset.FieldNames)
 	if err != nil {
@@ -69,7 +66,54 @@ func (uc *UpdateTrainingDatasetStatusUseCaseImpl) processCompletedTrainingDatase
 	trainingDataset.TotalGenerationTimeSeconds = &results.TotalGenerationTimeSeconds
 	trainingDataset.TokensIn = &results.TokensIn
 	trainingDataset.TokensOut = &results.TokensOut
-	trainingDataset.Data = results.TrainingDataItems
+
+	// The results were generated with the prompt of the dataset, copied items keep the prompt that generated them
+	generatePromptID := trainingDataset.GeneratePromptID
+	for i := range results.TrainingDataItems {
+		results.TrainingDataItems[i].GeneratePromptID = &generatePromptID
+	}
+
+	// A version created with "generate more" already holds the copied items, the results are appended to them
+	extended := trainingDataset.ExtendsTrainingDatasetID != nil
+	existingItems := 0
+	if extended {
+		existingItems = len(trainingDataset.Data)
+		trainingDataset.Data = append(trainingDataset.Data, results.TrainingDataItems...)
+	} else {
+		trainingDataset.Data = results.TrainingDataItems
+	}
+
+	// Mark near-duplicates as deleted before the items are stored. The existing items take part in the comparison,
+	// but only the new items are marked.
+	if command.Deduplicate {
+		threshold := command.SimilarityThreshold
+		if threshold == 0 {
+			threshold = services.DefaultDuplicateSimilarityThreshold
+		}
+		if err := uc.TrainingDataDeduplicationService.ValidateSimilarityThreshold(threshold); err != nil {
+			return err
+		}
+
+		clusters := uc.TrainingDataDeduplicationService.FindDuplicates(trainingDataset.Data, trainingDataset.FieldNames, trainingDataset.InputField, threshold)
+		reasons := uc.TrainingDataDeduplicationService.DuplicateReasons(clusters)
+		for i := existingItems; i < len(trainingDataset.Data); i++ {
+			if reason, isDuplicate := reasons[trainingDataset.Data[i].ID]; isDuplicate {
+				trainingDataset.Data[i].Deleted = true
+				trainingDataset.Data[i].DeletedReason = &reason
+			}
+		}
+	}
+
+	if extended {
+		// The existing items and their edits stay as they are, only the new items are added
+		if err := uc.TrainingDatasetRepository.UpdateMetadata(ctx, trainingDataset); err != nil {
+			return fmt.Errorf("failed to update training dataset with results: %w", err)
+		}
+		if err := uc.TrainingDatasetRepository.CreateItems(ctx, trainingDataset.ID, trainingDataset.Data[existingItems:]); err != nil {
+			return fmt.Errorf("failed to add training data items: %w", err)
+		}
+		return nil
+	}
 
 	// Save the updated training dataset
 	err = uc.TrainingDatasetRepository.Update(ctx, trainingDataset)
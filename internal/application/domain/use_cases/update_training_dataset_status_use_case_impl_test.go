@@ -0,0 +1,159 @@
+package use_cases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+// mockStatusTrainingDatasetRepository only implements what the status update uses, other calls panic
+type mockStatusTrainingDatasetRepository struct {
+	persistence.TrainingDatasetRepository
+	trainingDataset *entities.TrainingDataset
+	replacedItems   []entities.TrainingDataItem
+	createdItems    []entities.TrainingDataItem
+	// statusAfterRead is the status another request writes after the training dataset was read
+	statusAfterRead entities.TrainingDatasetStatus
+}
+
+func (m *mockStatusTrainingDatasetRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.TrainingDataset, error) {
+	if m.statusAfterRead != "" {
+		read := *m.trainingDataset
+		m.trainingDataset.Status = m.statusAfterRead
+		return &read, nil
+	}
+	return m.trainingDataset, nil
+}
+
+func (m *mockStatusTrainingDatasetRepository) Update(ctx context.Context, trainingDataset *entities.TrainingDataset) error {
+	m.replacedItems = trainingDataset.Data
+	return nil
+}
+
+func (m *mockStatusTrainingDatasetRepository) UpdateMetadata(ctx context.Context, trainingDataset *entities.TrainingDataset) error {
+	return nil
+}
+
+func (m *mockStatusTrainingDatasetRepository) CreateItems(ctx context.Context, trainingDatasetID uuid.UUID, items []entities.TrainingDataItem) error {
+	m.createdItems = items
+	return nil
+}
+
+func (m *mockStatusTrainingDatasetRepository) UpdateStatusFrom(ctx context.Context, id uuid.UUID, fromStatus entities.TrainingDatasetStatus, status entities.TrainingDatasetStatus) (bool, error) {
+	if m.trainingDataset.Status != fromStatus {
+		return false, nil
+	}
+	m.trainingDataset.Status = status
+	return true, nil
+}
+
+// mockStatusTrainingDatasetResultsClient only implements what the status update uses, other calls panic
+type mockStatusTrainingDatasetResultsClient struct {
+	clients.TrainingDatasetResultsClient
+	results *clients.TrainingDatasetResult
+}
+
+func (m *mockStatusTrainingDatasetResultsClient) GetTrainingDatasetResults(ctx context.Context, trainingDatasetID uuid.UUID, fieldNames []string) (*clients.TrainingDatasetResult, error) {
+	return m.results, nil
+}
+
+func TestUpdateTrainingDatasetStatusUseCase_Done(t *testing.T) {
+	existing := entities.TrainingDataItem{ID: uuid.New(), Values: []string{"What is the capital of France?", "Paris"}}
+	duplicate := entities.TrainingDataItem{ID: uuid.New(), Values: []string{"What is the capital of France?", "Paris."}}
+	added := entities.TrainingDataItem{ID: uuid.New(), Values: []string{"How many legs does a spider have?", "Eight"}}
+
+	newUseCase := func(extendsID *uuid.UUID) (*UpdateTrainingDatasetStatusUseCaseImpl, *mockStatusTrainingDatasetRepository) {
+		repo := &mockStatusTrainingDatasetRepository{
+			trainingDataset: &entities.TrainingDataset{
+				ID:                       uuid.New(),
+				Status:                   entities.TrainingDatasetStatusRunning,
+				InputField:               "question",
+				FieldNames:               []string{"question", "answer"},
+				ExtendsTrainingDatasetID: extendsID,
+				Data:                     []entities.TrainingDataItem{existing},
+			},
+		}
+		resultsClient := &mockStatusTrainingDatasetResultsClient{
+			results: &clients.TrainingDatasetResult{
+				TokensIn:          100,
+				TokensOut:         50,
+				TrainingDataItems: []entities.TrainingDataItem{duplicate, added},
+			},
+		}
+		return &UpdateTrainingDatasetStatusUseCaseImpl{
+			TrainingDatasetRepository:        repo,
+			TrainingDatasetResultsClient:     resultsClient,
+			TrainingDataDeduplicationService: &services.TrainingDataDeduplicationService{},
+			StatusTransitionService:          &services.StatusTransitionService{},
+		}, repo
+	}
+
+	t.Run("The results replace the items of a new training dataset", func(t *testing.T) {
+		useCase, repo := newUseCase(nil)
+
+		err := useCase.Execute(context.Background(), in.UpdateTrainingDatasetStatusCommand{
+			TrainingDatasetID: repo.trainingDataset.ID,
+			Status:            entities.TrainingDatasetStatusDone,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, repo.replacedItems, 2)
+		assert.Equal(t, added.Values, repo.replacedItems[1].Values)
+		assert.Equal(t, &repo.trainingDataset.GeneratePromptID, repo.replacedItems[1].GeneratePromptID)
+		assert.Nil(t, repo.createdItems)
+		assert.Equal(t, entities.TrainingDatasetStatusDone, repo.trainingDataset.Status)
+	})
+
+	t.Run("The results are appended to an extended training dataset", func(t *testing.T) {
+		sourceID := uuid.New()
+		useCase, repo := newUseCase(&sourceID)
+
+		err := useCase.Execute(context.Background(), in.UpdateTrainingDatasetStatusCommand{
+			TrainingDatasetID: repo.trainingDataset.ID,
+			Status:            entities.TrainingDatasetStatusDone,
+			Deduplicate:       true,
+		})
+		require.NoError(t, err)
+
+		assert.Nil(t, repo.replacedItems)
+		require.Len(t, repo.createdItems, 2)
+		// The new item that repeats an existing item is marked, the existing item is kept
+		assert.Equal(t, duplicate.ID, repo.createdItems[0].ID)
+		assert.True(t, repo.createdItems[0].Deleted)
+		assert.False(t, repo.createdItems[1].Deleted)
+		assert.False(t, repo.trainingDataset.Data[0].Deleted)
+		// Only the new items are attributed to the prompt of the extended version
+		assert.Nil(t, repo.trainingDataset.Data[0].GeneratePromptID)
+		assert.Equal(t, &repo.trainingDataset.GeneratePromptID, repo.createdItems[1].GeneratePromptID)
+		assert.Equal(t, 100, *repo.trainingDataset.TokensIn)
+	})
+}
+
+func TestUpdateTrainingDatasetStatusUseCase_AfterAbort(t *testing.T) {
+	repo := &mockStatusTrainingDatasetRepository{
+		trainingDataset: &entities.TrainingDataset{ID: uuid.New(), Status: entities.TrainingDatasetStatusPlanning},
+		statusAfterRead: entities.TrainingDatasetStatusAborted,
+	}
+	useCase := &UpdateTrainingDatasetStatusUseCaseImpl{
+		TrainingDatasetRepository: repo,
+		StatusTransitionService:   &services.StatusTransitionService{},
+	}
+
+	err := useCase.Execute(context.Background(), in.UpdateTrainingDatasetStatusCommand{
+		TrainingDatasetID: repo.trainingDataset.ID,
+		Status:            entities.TrainingDatasetStatusRunning,
+	})
+
+	// The abort that happened while the update was processed is kept
+	assert.EqualError(t, err, "status conflict, the training dataset is not PLANNING anymore")
+	assert.Equal(t, entities.TrainingDatasetStatusAborted, repo.trainingDataset.Status)
+}
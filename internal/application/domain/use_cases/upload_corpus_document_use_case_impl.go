@@ -0,0 +1,42 @@
+package use_cases
+
+import (
+	"context"
+	"fmt"
+
+	"ai-platform/internal/application/domain/entities"
+	"ai-platform/internal/application/domain/services"
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+	"ai-platform/internal/application/port/out/persistence"
+)
+
+type UploadCorpusDocumentUseCaseImpl struct {
+	CorpusService            *services.CorpusService
+	CorpusDocumentRepository persistence.CorpusDocumentRepository
+	CorpusStorageClient      clients.CorpusStorageClient
+}
+
+func (uc *UploadCorpusDocumentUseCaseImpl) UploadCorpusDocument(ctx context.Context, command in.UploadCorpusDocumentCommand) (*entities.CorpusDocument, error) {
+	corpus, err := uc.CorpusService.GetOwnedCorpus(ctx, command.CorpusID, command.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := uc.CorpusService.ExtractDocumentMetadata(corpus.ID, command.FileName, command.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	// The file is stored first, a failed metadata save is repaired by syncing the corpus
+	contentType := uc.CorpusService.DocumentContentType(document.Format)
+	if err := uc.CorpusStorageClient.UploadDocument(ctx, corpus.S3Path, document.FileName, contentType, command.Content); err != nil {
+		return nil, err
+	}
+
+	if err := uc.CorpusDocumentRepository.Save(ctx, document); err != nil {
+		return nil, fmt.Errorf("failed to save corpus document: %w", err)
+	}
+
+	return document, nil
+}
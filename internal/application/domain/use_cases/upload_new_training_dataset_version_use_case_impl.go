@@ -2,9 +2,7 @@ package use_cases
 
 import (
 	"context"
-	"encoding/csv"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,8 +14,9 @@ import (
 )
 
 type UploadNewTrainingDatasetVersionUseCaseImpl struct {
-	TrainingDatasetService    *services.TrainingDatasetService
-	TrainingDatasetRepository persistence.TrainingDatasetRepository
+	TrainingDatasetService       *services.TrainingDatasetService
+	TrainingDatasetImportService *services.TrainingDatasetImportService
+	TrainingDatasetRepository    persistence.TrainingDatasetRepository
 }
 
 func (uc *UploadNewTrainingDatasetVersionUseCaseImpl) UploadNewTrainingDatasetVersion(command in.UploadNewTrainingDatasetVersionCommand) (*in.UploadNewTrainingDatasetVersionResult, error) {
@@ -31,48 +30,18 @@ func (uc *UploadNewTrainingDatasetVersionUseCaseImpl) UploadNewTrainingDatasetVe
 		return nil, fmt.Errorf("no training dataset found for project")
 	}
 
-	// Parse CSV data
-	reader := csv.NewReader(strings.NewReader(string(command.CsvData)))
-	records, err := reader.ReadAll()
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse CSV: %w", err)
-	}
-
-	if len(records) == 0 {
-		return nil, fmt.Errorf("CSV file is empty")
-	}
-
-	// First row should be the header
-	header := records[0]
-
-	// Use the field names from the CSV header as the new field names
-	fieldNames := header
-
-	if len(fieldNames) == 0 {
-		return nil, fmt.Errorf("CSV header cannot be empty")
-	}
-
-	// Process data rows
+	// Parse the uploaded file, the field names of the new version are taken from the file. A new version of a
+	// conversation dataset is again a conversation dataset.
+	var fieldNames []string
 	var dataItems []entities.TrainingDataItem
-	for rowIndex, record := range records[1:] {
-		if len(record) != len(fieldNames) {
-			return nil, fmt.Errorf("row %d has %d columns but expected %d columns", rowIndex+2, len(record), len(fieldNames))
-		}
-
-		dataItem := entities.TrainingDataItem{
-			ID:                    uuid.New(),
-			Values:                record,
-			CorrectsID:            nil,
-			SourceDocument:        nil,
-			SourceDocumentStart:   nil,
-			SourceDocumentEnd:     nil,
-			GenerationTimeSeconds: 0,
-			Deleted:               false,
-			CreatedAt:             time.Now(),
-			UpdatedAt:             time.Now(),
-		}
-
-		dataItems = append(dataItems, dataItem)
+	if latestDataset.Type == entities.TrainingDatasetTypeConversation {
+		fieldNames = []string{}
+		dataItems, err = uc.TrainingDatasetImportService.ParseConversationItems(command.Format, command.FileData)
+	} else {
+		fieldNames, dataItems, err = uc.TrainingDatasetImportService.ParseTrainingDataItems(command.Format, command.FileData, nil)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	// Create new training dataset with incremented version
@@ -81,6 +50,8 @@ func (uc *UploadNewTrainingDatasetVersionUseCaseImpl) UploadNewTrainingDatasetVe
 		ID:                       uuid.New(),
 		ProjectID:                command.ProjectID,
 		Version:                  newVersion,
+		Type:                     latestDataset.Type,
+		Conversation:             latestDataset.Conversation,
 		GenerateModel:            latestDataset.GenerateModel,
 		GenerateModelRunner:      latestDataset.GenerateModelRunner,
 		GenerateGPUInfoCard:      latestDataset.GenerateGPUInfoCard,
@@ -0,0 +1,9 @@
+package in
+
+import "github.com/google/uuid"
+
+type AbortFinetuneCommand struct {
+	ProjectID  uuid.UUID
+	FinetuneID uuid.UUID
+	OwnerID    uuid.UUID
+}
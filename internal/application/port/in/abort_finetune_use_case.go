@@ -0,0 +1,8 @@
+package in
+
+import "context"
+
+// AbortFinetuneUseCase cancels the backend job of a finetune
+type AbortFinetuneUseCase interface {
+	AbortFinetune(ctx context.Context, command AbortFinetuneCommand) error
+}
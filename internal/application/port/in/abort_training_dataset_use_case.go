@@ -0,0 +1,8 @@
+package in
+
+import "context"
+
+// AbortTrainingDatasetUseCase stops the generation of a training dataset, the results written so far are kept
+type AbortTrainingDatasetUseCase interface {
+	AbortTrainingDataset(ctx context.Context, command AbortTrainingDatasetCommand) error
+}
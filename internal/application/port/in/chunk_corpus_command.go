@@ -0,0 +1,13 @@
+package in
+
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type ChunkCorpusCommand struct {
+	CorpusID uuid.UUID
+	OwnerID  uuid.UUID
+	Config   entities.CorpusChunkingConfig
+}
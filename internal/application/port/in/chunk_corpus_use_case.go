@@ -0,0 +1,23 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type ChunkCorpusDocumentResult struct {
+	FileName   string
+	ChunkCount int
+	TokenCount int
+}
+
+type ChunkCorpusResult struct {
+	Config    entities.CorpusChunkingConfig
+	ConfigKey string
+	Documents []ChunkCorpusDocumentResult
+}
+
+type ChunkCorpusUseCase interface {
+	ChunkCorpus(ctx context.Context, command ChunkCorpusCommand) (*ChunkCorpusResult, error)
+}
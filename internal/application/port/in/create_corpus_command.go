@@ -0,0 +1,9 @@
+package in
+
+import "github.com/google/uuid"
+
+type CreateCorpusCommand struct {
+	OwnerID   uuid.UUID
+	ProjectID *uuid.UUID
+	Name      string
+}
@@ -0,0 +1,11 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type CreateCorpusUseCase interface {
+	CreateCorpus(ctx context.Context, command CreateCorpusCommand) (*entities.Corpus, error)
+}
@@ -7,4 +7,6 @@ type CreateDeploymentCommand struct {
 	ProjectID  uuid.UUID
 	FinetuneID *uuid.UUID
 	OwnerID    uuid.UUID
+	// RedactPII replaces personal data in the stored request/response logs with placeholders.
+	RedactPII bool
 }
@@ -1,6 +1,10 @@
 package in
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
 
 type CreateFinetuneCommand struct {
 	UserID                           uuid.UUID `json:"user_id"`
@@ -9,4 +13,11 @@ type CreateFinetuneCommand struct {
 	TrainingDatasetID                uuid.UUID `json:"training_dataset_id"`
 	TrainingDatasetNumberExamples    *int      `json:"training_dataset_number_examples,omitempty"`
 	TrainingDatasetSelectRandom      bool      `json:"training_dataset_select_random"`
+	TrainingDatasetMinQualityScore   *float64  `json:"training_dataset_min_quality_score,omitempty"`
+	RedactPII                        bool      `json:"redact_pii"`
+	Hyperparameters                  *entities.FinetuneHyperparameterOverrides `json:"hyperparameters,omitempty"`
+	// Backend is optional, finetunes without one use the default backend of the environment
+	Backend                          *entities.FinetuneBackendType `json:"backend,omitempty"`
+	// Priority is optional, finetunes without one are queued with NORMAL priority
+	Priority                         *entities.FinetunePriority `json:"priority,omitempty"`
 }
\ No newline at end of file
@@ -0,0 +1,9 @@
+package in
+
+import "github.com/google/uuid"
+
+type CreatePromptVersionCommand struct {
+	ProjectID uuid.UUID
+	OwnerID   uuid.UUID
+	Text      string
+}
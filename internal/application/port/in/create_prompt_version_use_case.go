@@ -0,0 +1,18 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type CreatePromptVersionResult struct {
+	Prompt *entities.Prompt
+	// Created is false when the text equals the latest version, which is returned instead
+	Created bool
+}
+
+// CreatePromptVersionUseCase saves an edited prompt as the next prompt version of a project
+type CreatePromptVersionUseCase interface {
+	CreatePromptVersion(ctx context.Context, command CreatePromptVersionCommand) (*CreatePromptVersionResult, error)
+}
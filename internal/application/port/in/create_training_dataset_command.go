@@ -1,19 +1,29 @@
 package in
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
 
 type CreateTrainingDatasetCommand struct {
-	UserID                  uuid.UUID         `json:"user_id"`
-	ProjectID               uuid.UUID         `json:"project_id"`
-	CorpusName              string            `json:"corpus_name"`
-	InputField              string            `json:"input_field"`
-	OutputField             string            `json:"output_field"`
-	JSONObjectFields        map[string]string `json:"json_object_fields"`
-	ExpectedOutputSizeChars int               `json:"expected_output_size_chars"`
-	LanguageISO             string            `json:"language_iso"`
-	FieldNames              []string          `json:"field_names"`
-	GeneratePrompt          string            `json:"generate_prompt"`
-	GenerateExamplesNumber  int               `json:"generate_examples_number"`
-	GenerateModel           string            `json:"generate_model"`
-	GenerateModelRunner     string            `json:"generate_model_runner"`
-}
\ No newline at end of file
+	UserID                  uuid.UUID                    `json:"user_id"`
+	ProjectID               uuid.UUID                    `json:"project_id"`
+	CorpusID                *uuid.UUID                   `json:"corpus_id"`
+	CorpusName              string                       `json:"corpus_name"`
+	Type                    entities.TrainingDatasetType `json:"type"`
+	InputField              string                       `json:"input_field"`
+	OutputField             string                       `json:"output_field"`
+	JSONObjectFields        map[string]string            `json:"json_object_fields"`
+	ExpectedOutputSizeChars int                          `json:"expected_output_size_chars"`
+	LanguageISO             string                       `json:"language_iso"`
+	FieldNames              []string                     `json:"field_names"`
+	GeneratePrompt          string                       `json:"generate_prompt"`
+	GenerateExamplesNumber  int                          `json:"generate_examples_number"`
+	GenerateModel           string                       `json:"generate_model"`
+	GenerateModelRunner     string                       `json:"generate_model_runner"`
+	// Chunking splits the corpus with an explicit config before generation, nil leaves the chunking to the runner
+	Chunking *entities.CorpusChunkingConfig `json:"chunking"`
+	// Conversation configures the generated conversations of a conversation dataset
+	Conversation *entities.ConversationConfig `json:"conversation"`
+}
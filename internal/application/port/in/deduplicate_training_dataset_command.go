@@ -0,0 +1,12 @@
+package in
+
+import "github.com/google/uuid"
+
+type DeduplicateTrainingDatasetCommand struct {
+	ProjectID           uuid.UUID
+	TrainingDatasetID   uuid.UUID
+	OwnerID             uuid.UUID
+	SimilarityThreshold float64
+	// DryRun only reports the clusters without marking any items as deleted
+	DryRun bool
+}
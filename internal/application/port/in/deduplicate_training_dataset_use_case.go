@@ -0,0 +1,17 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type DeduplicateTrainingDatasetResult struct {
+	Clusters         []entities.DuplicateCluster
+	DuplicatesMarked int
+	ItemsChecked     int
+}
+
+type DeduplicateTrainingDatasetUseCase interface {
+	DeduplicateTrainingDataset(ctx context.Context, command DeduplicateTrainingDatasetCommand) (*DeduplicateTrainingDatasetResult, error)
+}
@@ -0,0 +1,9 @@
+package in
+
+import "github.com/google/uuid"
+
+type DeleteCorpusDocumentCommand struct {
+	CorpusID uuid.UUID
+	OwnerID  uuid.UUID
+	FileName string
+}
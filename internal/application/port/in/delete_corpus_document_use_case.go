@@ -0,0 +1,7 @@
+package in
+
+import "context"
+
+type DeleteCorpusDocumentUseCase interface {
+	DeleteCorpusDocument(ctx context.Context, command DeleteCorpusDocumentCommand) error
+}
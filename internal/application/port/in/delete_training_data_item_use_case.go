@@ -0,0 +1,7 @@
+package in
+
+import "context"
+
+type DeleteTrainingDataItemUseCase interface {
+	DeleteTrainingDataItem(ctx context.Context, command DeleteTrainingDataItemCommand) error
+}
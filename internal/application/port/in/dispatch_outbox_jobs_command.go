@@ -0,0 +1,10 @@
+package in
+
+import "time"
+
+type DispatchOutboxJobsCommand struct {
+	// Limit is the maximum number of jobs delivered in one run
+	Limit int
+	// Lease is how long a claimed job is hidden from other dispatchers while it is delivered
+	Lease time.Duration
+}
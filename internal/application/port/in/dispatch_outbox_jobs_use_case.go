@@ -0,0 +1,16 @@
+package in
+
+import "context"
+
+type DispatchOutboxJobsResult struct {
+	Delivered int
+	Retrying  int
+	Failed    int
+	// Cancelled counts the jobs whose training dataset or finetune left PLANNING before the job was delivered
+	Cancelled int
+}
+
+// DispatchOutboxJobsUseCase delivers the due outbox jobs to S3 and Runpod
+type DispatchOutboxJobsUseCase interface {
+	Execute(ctx context.Context, command DispatchOutboxJobsCommand) (*DispatchOutboxJobsResult, error)
+}
@@ -1,9 +1,16 @@
 package in
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
 
 type DownloadTrainingDatasetCommand struct {
 	ProjectID         uuid.UUID
 	TrainingDatasetID uuid.UUID
 	OwnerID           uuid.UUID
+	Format            entities.TrainingDatasetExportFormat
+	SystemPrompt      string
+	IncludeMetadata   bool
 }
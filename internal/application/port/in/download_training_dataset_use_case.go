@@ -4,6 +4,9 @@ type DownloadTrainingDatasetResult struct {
 	FieldNames []string
 	Data       [][]string
 	Filename   string
+	// Content and ContentType are set for the JSON based formats, CSV is built from FieldNames and Data
+	Content     []byte
+	ContentType string
 }
 
 type DownloadTrainingDatasetUseCase interface {
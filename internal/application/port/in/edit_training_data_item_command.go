@@ -0,0 +1,17 @@
+package in
+
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type EditTrainingDataItemCommand struct {
+	ProjectID          uuid.UUID
+	TrainingDatasetID  uuid.UUID
+	TrainingDataItemID uuid.UUID
+	OwnerID            uuid.UUID
+	Values             []string
+	// Messages replace the conversation of an item of a conversation dataset, Values are empty then
+	Messages []entities.ConversationMessage
+}
@@ -0,0 +1,11 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type EditTrainingDataItemUseCase interface {
+	EditTrainingDataItem(ctx context.Context, command EditTrainingDataItemCommand) (*entities.TrainingDataItem, error)
+}
@@ -0,0 +1,22 @@
+package in
+
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type EstimateTrainingDatasetCommand struct {
+	ProjectID               uuid.UUID
+	OwnerID                 uuid.UUID
+	CorpusID                *uuid.UUID
+	CorpusName              string
+	GeneratePrompt          string
+	JSONObjectFields        map[string]string
+	ExpectedOutputSizeChars int
+	GenerateExamplesNumber  int
+	// Chunking is the config the corpus will be split with, nil uses the default chunking
+	Chunking *entities.CorpusChunkingConfig
+	// GPUPricePerHour replaces the configured price when it is set
+	GPUPricePerHour *float64
+}
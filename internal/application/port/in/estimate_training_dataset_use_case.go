@@ -0,0 +1,15 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type EstimateTrainingDatasetResult struct {
+	Estimate *entities.TrainingDatasetEstimate
+}
+
+type EstimateTrainingDatasetUseCase interface {
+	EstimateTrainingDataset(ctx context.Context, command EstimateTrainingDatasetCommand) (*EstimateTrainingDatasetResult, error)
+}
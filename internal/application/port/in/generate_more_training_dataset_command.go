@@ -0,0 +1,15 @@
+package in
+
+import "github.com/google/uuid"
+
+type GenerateMoreTrainingDatasetCommand struct {
+	ProjectID              uuid.UUID
+	TrainingDatasetID      uuid.UUID
+	OwnerID                uuid.UUID
+	GenerateExamplesNumber int
+	// GeneratePrompt is an edited prompt for the new examples, empty keeps the prompt of the training dataset
+	GeneratePrompt string
+	// GenerateModel and GenerateModelRunner are used when the training dataset does not store the model
+	GenerateModel       string
+	GenerateModelRunner string
+}
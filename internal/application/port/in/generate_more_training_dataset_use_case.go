@@ -0,0 +1,22 @@
+package in
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type GenerateMoreTrainingDatasetResult struct {
+	TrainingDatasetID uuid.UUID
+	Version           int
+	CopiedItems       int
+	SkippedChunks     int
+	// PromptVersion is the prompt version the additional examples are generated with
+	PromptVersion int
+}
+
+// GenerateMoreTrainingDatasetUseCase creates a new version of a DONE training dataset that keeps its items and
+// generates additional examples from the chunks that were not used yet
+type GenerateMoreTrainingDatasetUseCase interface {
+	GenerateMoreTrainingDataset(ctx context.Context, command GenerateMoreTrainingDatasetCommand) (*GenerateMoreTrainingDatasetResult, error)
+}
@@ -0,0 +1,14 @@
+package in
+
+import "github.com/google/uuid"
+
+type GenerateTrainingDatasetSplitsCommand struct {
+	ProjectID                uuid.UUID
+	TrainingDatasetID        uuid.UUID
+	OwnerID                  uuid.UUID
+	TrainRatio               float64
+	ValidationRatio          float64
+	TestRatio                float64
+	Seed                     int64
+	StratifyBySourceDocument bool
+}
@@ -0,0 +1,13 @@
+package in
+
+import "context"
+
+type GenerateTrainingDatasetSplitsResult struct {
+	TrainItems      int
+	ValidationItems int
+	TestItems       int
+}
+
+type GenerateTrainingDatasetSplitsUseCase interface {
+	GenerateTrainingDatasetSplits(ctx context.Context, command GenerateTrainingDatasetSplitsCommand) (*GenerateTrainingDatasetSplitsResult, error)
+}
@@ -0,0 +1,16 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type GetCorpusResult struct {
+	Corpus    *entities.Corpus
+	Documents []*entities.CorpusDocument
+}
+
+type GetCorpusUseCase interface {
+	GetCorpus(ctx context.Context, command GetCorpusCommand) (*GetCorpusResult, error)
+}
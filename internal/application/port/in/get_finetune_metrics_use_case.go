@@ -0,0 +1,18 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type GetFinetuneMetricsResult struct {
+	Status entities.FinetuneStatus
+	// Metrics are ordered by step
+	Metrics          []*entities.FinetuneMetric
+	InferenceSamples []entities.InferenceSample
+}
+
+type GetFinetuneMetricsUseCase interface {
+	GetFinetuneMetrics(ctx context.Context, command GetFinetuneMetricsCommand) (*GetFinetuneMetricsResult, error)
+}
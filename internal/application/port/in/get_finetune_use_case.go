@@ -10,6 +10,9 @@ import (
 type GetFinetuneResult struct {
 	Finetune     *entities.Finetune
 	DeploymentID *uuid.UUID
+	// QueuePosition is the 1-based position in the queue of a QUEUED finetune, 0 otherwise
+	QueuePosition  int
+	DispatchEvents []*entities.FinetuneDispatchEvent
 }
 
 type GetFinetuneUseCase interface {
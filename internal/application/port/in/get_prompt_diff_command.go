@@ -0,0 +1,11 @@
+package in
+
+import "github.com/google/uuid"
+
+type GetPromptDiffCommand struct {
+	ProjectID uuid.UUID
+	// FromPromptID is the older version the diff starts from
+	FromPromptID uuid.UUID
+	ToPromptID   uuid.UUID
+	OwnerID      uuid.UUID
+}
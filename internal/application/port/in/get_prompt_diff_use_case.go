@@ -0,0 +1,15 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type GetPromptDiffResult struct {
+	Diff *entities.PromptDiff
+}
+
+type GetPromptDiffUseCase interface {
+	GetPromptDiff(ctx context.Context, command GetPromptDiffCommand) (*GetPromptDiffResult, error)
+}
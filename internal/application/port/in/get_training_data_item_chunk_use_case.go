@@ -0,0 +1,15 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type GetTrainingDataItemChunkResult struct {
+	Chunk *entities.CorpusChunk
+}
+
+type GetTrainingDataItemChunkUseCase interface {
+	GetTrainingDataItemChunk(ctx context.Context, command GetTrainingDataItemChunkCommand) (*GetTrainingDataItemChunkResult, error)
+}
@@ -0,0 +1,10 @@
+package in
+
+import "github.com/google/uuid"
+
+type GetTrainingDataItemCommand struct {
+	ProjectID          uuid.UUID
+	TrainingDatasetID  uuid.UUID
+	TrainingDataItemID uuid.UUID
+	OwnerID            uuid.UUID
+}
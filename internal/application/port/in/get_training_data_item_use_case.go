@@ -0,0 +1,17 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type GetTrainingDataItemResult struct {
+	FieldNames  []string
+	Item        *entities.TrainingDataItem
+	Corrections []entities.TrainingDataItem
+}
+
+type GetTrainingDataItemUseCase interface {
+	GetTrainingDataItem(ctx context.Context, command GetTrainingDataItemCommand) (*GetTrainingDataItemResult, error)
+}
@@ -0,0 +1,11 @@
+package in
+
+import "github.com/google/uuid"
+
+type GetTrainingDatasetDiffCommand struct {
+	ProjectID uuid.UUID
+	// FromTrainingDatasetID is the older version the diff starts from
+	FromTrainingDatasetID uuid.UUID
+	ToTrainingDatasetID   uuid.UUID
+	OwnerID               uuid.UUID
+}
@@ -0,0 +1,15 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type GetTrainingDatasetDiffResult struct {
+	Diff *entities.TrainingDatasetDiff
+}
+
+type GetTrainingDatasetDiffUseCase interface {
+	GetTrainingDatasetDiff(ctx context.Context, command GetTrainingDatasetDiffCommand) (*GetTrainingDatasetDiffResult, error)
+}
@@ -0,0 +1,10 @@
+package in
+
+import "github.com/google/uuid"
+
+type GetTrainingDatasetPartialResultsCommand struct {
+	ProjectID         uuid.UUID
+	TrainingDatasetID uuid.UUID
+	OwnerID           uuid.UUID
+	Limit             int
+}
@@ -0,0 +1,20 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type GetTrainingDatasetPartialResultsResult struct {
+	FieldNames     []string
+	ItemsGenerated int
+	TokensIn       int
+	TokensOut      int
+	// Items holds the first items up to the limit
+	Items []entities.TrainingDataItem
+}
+
+type GetTrainingDatasetPartialResultsUseCase interface {
+	GetTrainingDatasetPartialResults(ctx context.Context, command GetTrainingDatasetPartialResultsCommand) (*GetTrainingDatasetPartialResultsResult, error)
+}
@@ -0,0 +1,19 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type GetTrainingDatasetProgressResult struct {
+	Status                 entities.TrainingDatasetStatus
+	GenerateExamplesNumber int
+	// Progress is nil until the runner reports for the first time
+	Progress          *entities.TrainingDatasetProgress
+	CompletedFraction float64
+}
+
+type GetTrainingDatasetProgressUseCase interface {
+	GetTrainingDatasetProgress(ctx context.Context, command GetTrainingDatasetProgressCommand) (*GetTrainingDatasetProgressResult, error)
+}
@@ -0,0 +1,9 @@
+package in
+
+import "github.com/google/uuid"
+
+type GetTrainingDatasetQualityScoresCommand struct {
+	ProjectID         uuid.UUID
+	TrainingDatasetID uuid.UUID
+	OwnerID           uuid.UUID
+}
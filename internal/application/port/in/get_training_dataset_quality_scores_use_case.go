@@ -0,0 +1,17 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type GetTrainingDatasetQualityScoresResult struct {
+	Histogram *entities.QualityScoreHistogram
+	// Job is the latest quality scoring job, nil when the training dataset was never scored
+	Job *entities.QualityScoringJob
+}
+
+type GetTrainingDatasetQualityScoresUseCase interface {
+	GetTrainingDatasetQualityScores(ctx context.Context, command GetTrainingDatasetQualityScoresCommand) (*GetTrainingDatasetQualityScoresResult, error)
+}
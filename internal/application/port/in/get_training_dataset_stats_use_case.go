@@ -0,0 +1,15 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type GetTrainingDatasetStatsResult struct {
+	Stats *entities.TrainingDatasetStats
+}
+
+type GetTrainingDatasetStatsUseCase interface {
+	GetTrainingDatasetStats(ctx context.Context, command GetTrainingDatasetStatsCommand) (*GetTrainingDatasetStatsResult, error)
+}
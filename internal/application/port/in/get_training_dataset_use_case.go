@@ -1,15 +1,21 @@
 package in
 
 import (
+	"github.com/google/uuid"
+
 	"ai-platform/internal/application/domain/entities"
 )
 
 type GetTrainingDatasetResult struct {
 	TrainingDataset *entities.TrainingDataset
 	GeneratePrompt  string
-	CorpusName      string
+	// GeneratePromptVersion is the version of the generate prompt in the prompt history of the project
+	GeneratePromptVersion int
+	CorpusName            string
+	// PreviousVersionID is the ID of the previous version of the training dataset, if there is one
+	PreviousVersionID *uuid.UUID
 }
 
 type GetTrainingDatasetUseCase interface {
 	GetTrainingDataset(command GetTrainingDatasetCommand) (*GetTrainingDatasetResult, error)
-}
\ No newline at end of file
+}
@@ -0,0 +1,9 @@
+package in
+
+import "github.com/google/uuid"
+
+type ListCorporaCommand struct {
+	OwnerID uuid.UUID
+	// ProjectID limits the corpora of the owner to a project, shared corpora are always listed
+	ProjectID *uuid.UUID
+}
@@ -0,0 +1,11 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type ListCorporaUseCase interface {
+	ListCorpora(ctx context.Context, command ListCorporaCommand) ([]*entities.Corpus, error)
+}
@@ -0,0 +1,8 @@
+package in
+
+import "github.com/google/uuid"
+
+type ListPromptsCommand struct {
+	ProjectID uuid.UUID
+	OwnerID   uuid.UUID
+}
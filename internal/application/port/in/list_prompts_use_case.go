@@ -0,0 +1,17 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type ListPromptsResult struct {
+	// History holds the prompt versions of the project, the latest first
+	History []entities.PromptHistoryEntry
+}
+
+// ListPromptsUseCase lists the prompt versions of a project with the training datasets and items they generated
+type ListPromptsUseCase interface {
+	ListPrompts(ctx context.Context, command ListPromptsCommand) (*ListPromptsResult, error)
+}
@@ -0,0 +1,19 @@
+package in
+
+import "github.com/google/uuid"
+
+type ListTrainingDataItemsCommand struct {
+	ProjectID         uuid.UUID
+	TrainingDatasetID uuid.UUID
+	OwnerID           uuid.UUID
+	Query             string
+	// Deleted selects only deleted or only non-deleted items, nil returns both
+	Deleted        *bool
+	Corrected      *bool
+	SourceDocument *string
+	// GeneratePromptID selects the items generated by a prompt version
+	GeneratePromptID *uuid.UUID
+	// Cursor is the next_cursor of the previous page, empty for the first page
+	Cursor   string
+	PageSize int
+}
@@ -0,0 +1,19 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type ListTrainingDataItemsResult struct {
+	FieldNames []string
+	Items      []entities.TrainingDataItem
+	TotalItems int
+	PageSize   int
+	NextCursor *string
+}
+
+type ListTrainingDataItemsUseCase interface {
+	ListTrainingDataItems(ctx context.Context, command ListTrainingDataItemsCommand) (*ListTrainingDataItemsResult, error)
+}
@@ -0,0 +1,15 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type ListTrainingDatasetPIIReportsResult struct {
+	Reports []*entities.PIIReport
+}
+
+type ListTrainingDatasetPIIReportsUseCase interface {
+	ListTrainingDatasetPIIReports(ctx context.Context, command ListTrainingDatasetPIIReportsCommand) (*ListTrainingDatasetPIIReportsResult, error)
+}
@@ -0,0 +1,16 @@
+package in
+
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type PreviewCorpusChunksCommand struct {
+	CorpusID uuid.UUID
+	OwnerID  uuid.UUID
+	Config   entities.CorpusChunkingConfig
+	// FileName selects the document, the first document used for generation is previewed if it is empty
+	FileName string
+	Limit    int
+}
@@ -0,0 +1,19 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type PreviewCorpusChunksResult struct {
+	Config      entities.CorpusChunkingConfig
+	ConfigKey   string
+	FileName    string
+	TotalChunks int
+	Chunks      []entities.CorpusChunk
+}
+
+type PreviewCorpusChunksUseCase interface {
+	PreviewCorpusChunks(ctx context.Context, command PreviewCorpusChunksCommand) (*PreviewCorpusChunksResult, error)
+}
@@ -18,4 +18,6 @@ type PublicChatCompletionCommand struct {
 	Temperature  float64
 	TopP         float64
 	Stream       bool
+	// RedactPII removes personal data from the logged input and output
+	RedactPII bool
 }
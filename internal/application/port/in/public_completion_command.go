@@ -11,4 +11,6 @@ type PublicCompletionCommand struct {
 	Temperature  float64
 	TopP         float64
 	Stream       bool
+	// RedactPII removes personal data from the logged input and output
+	RedactPII bool
 }
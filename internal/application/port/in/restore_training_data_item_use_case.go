@@ -0,0 +1,7 @@
+package in
+
+import "context"
+
+type RestoreTrainingDataItemUseCase interface {
+	RestoreTrainingDataItem(ctx context.Context, command RestoreTrainingDataItemCommand) error
+}
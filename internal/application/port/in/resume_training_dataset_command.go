@@ -0,0 +1,12 @@
+package in
+
+import "github.com/google/uuid"
+
+type ResumeTrainingDatasetCommand struct {
+	ProjectID         uuid.UUID
+	TrainingDatasetID uuid.UUID
+	OwnerID           uuid.UUID
+	// GenerateModel and GenerateModelRunner are used when the training dataset does not store the model
+	GenerateModel       string
+	GenerateModelRunner string
+}
@@ -0,0 +1,15 @@
+package in
+
+import "context"
+
+type ResumeTrainingDatasetResult struct {
+	ResumedExamplesNumber   int
+	RemainingExamplesNumber int
+	SkippedChunks           int
+}
+
+// ResumeTrainingDatasetUseCase restarts the generation of an aborted or failed training dataset for the chunks that
+// have no results yet
+type ResumeTrainingDatasetUseCase interface {
+	ResumeTrainingDataset(ctx context.Context, command ResumeTrainingDatasetCommand) (*ResumeTrainingDatasetResult, error)
+}
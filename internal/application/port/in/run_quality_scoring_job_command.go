@@ -0,0 +1,8 @@
+package in
+
+import "time"
+
+type RunQualityScoringJobCommand struct {
+	// Lease is how long the job is held without progress, the lease is renewed while the items are scored
+	Lease time.Duration
+}
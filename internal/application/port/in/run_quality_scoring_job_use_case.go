@@ -0,0 +1,17 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type RunQualityScoringJobResult struct {
+	// Job is nil when no job was waiting
+	Job *entities.QualityScoringJob
+}
+
+// RunQualityScoringJobUseCase claims the next quality scoring job and judges its items
+type RunQualityScoringJobUseCase interface {
+	Execute(ctx context.Context, command RunQualityScoringJobCommand) (*RunQualityScoringJobResult, error)
+}
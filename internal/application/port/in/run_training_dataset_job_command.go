@@ -0,0 +1,9 @@
+package in
+
+import (
+	"ai-platform/internal/application/domain/entities"
+)
+
+type RunTrainingDatasetJobCommand struct {
+	Job entities.TrainingDatasetJob
+}
@@ -0,0 +1,15 @@
+package in
+
+import "context"
+
+type RunTrainingDatasetJobResult struct {
+	GeneratedExamples int
+	FailedRequests    int
+	// Aborted is set when the user aborted the training dataset during the generation
+	Aborted bool
+}
+
+// RunTrainingDatasetJobUseCase generates a training dataset in-process, as an alternative to the external runner
+type RunTrainingDatasetJobUseCase interface {
+	Execute(ctx context.Context, command RunTrainingDatasetJobCommand) (*RunTrainingDatasetJobResult, error)
+}
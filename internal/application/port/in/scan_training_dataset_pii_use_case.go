@@ -0,0 +1,15 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type ScanTrainingDatasetPIIResult struct {
+	Report *entities.PIIReport
+}
+
+type ScanTrainingDatasetPIIUseCase interface {
+	ScanTrainingDatasetPII(ctx context.Context, command ScanTrainingDatasetPIICommand) (*ScanTrainingDatasetPIIResult, error)
+}
@@ -0,0 +1,8 @@
+package in
+
+type ScheduleFinetunesCommand struct {
+	// MaxConcurrent is the number of finetunes that can be in PLANNING or RUNNING at the same time, 0 is unlimited
+	MaxConcurrent int
+	// MaxConcurrentPerUser is the same limit for the finetunes of one user, 0 is unlimited
+	MaxConcurrentPerUser int
+}
@@ -0,0 +1,14 @@
+package in
+
+import "context"
+
+type ScheduleFinetunesResult struct {
+	Dispatched int
+	// Queued is the number of finetunes still waiting for a slot
+	Queued int
+}
+
+// ScheduleFinetunesUseCase dispatches the queued finetunes that fit into the concurrency limits by priority
+type ScheduleFinetunesUseCase interface {
+	Execute(ctx context.Context, command ScheduleFinetunesCommand) (*ScheduleFinetunesResult, error)
+}
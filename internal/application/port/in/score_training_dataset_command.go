@@ -0,0 +1,14 @@
+package in
+
+import "github.com/google/uuid"
+
+type ScoreTrainingDatasetCommand struct {
+	ProjectID         uuid.UUID
+	TrainingDatasetID uuid.UUID
+	OwnerID           uuid.UUID
+	// Rubric describes what makes a good item, the default rubric is used if it is empty
+	Rubric string
+	Model  string
+	// Rescore also scores items that already have a quality score
+	Rescore bool
+}
@@ -0,0 +1,17 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type ScoreTrainingDatasetResult struct {
+	// Job is PENDING until a worker picks it up, its progress is returned with the quality scores
+	Job         *entities.QualityScoringJob
+	ItemsQueued int
+}
+
+type ScoreTrainingDatasetUseCase interface {
+	ScoreTrainingDataset(ctx context.Context, command ScoreTrainingDatasetCommand) (*ScoreTrainingDatasetResult, error)
+}
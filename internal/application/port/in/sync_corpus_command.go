@@ -0,0 +1,8 @@
+package in
+
+import "github.com/google/uuid"
+
+type SyncCorpusCommand struct {
+	CorpusID uuid.UUID
+	OwnerID  uuid.UUID
+}
@@ -0,0 +1,18 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type SyncCorpusResult struct {
+	Documents []*entities.CorpusDocument
+	// Added and Removed are the file names whose metadata was created or deleted by the sync
+	Added   []string
+	Removed []string
+}
+
+type SyncCorpusUseCase interface {
+	SyncCorpus(ctx context.Context, command SyncCorpusCommand) (*SyncCorpusResult, error)
+}
@@ -0,0 +1,10 @@
+package in
+
+import "github.com/google/uuid"
+
+type UpdateCorpusFilesSubsetCommand struct {
+	CorpusID uuid.UUID
+	OwnerID  uuid.UUID
+	// FilesSubset limits the documents used for generation, nil uses all documents of the corpus
+	FilesSubset *[]string
+}
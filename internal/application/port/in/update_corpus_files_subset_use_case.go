@@ -0,0 +1,11 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type UpdateCorpusFilesSubsetUseCase interface {
+	UpdateCorpusFilesSubset(ctx context.Context, command UpdateCorpusFilesSubsetCommand) (*entities.Corpus, error)
+}
@@ -0,0 +1,19 @@
+package in
+
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type UpdateFinetuneMetricsCommand struct {
+	FinetuneID   uuid.UUID
+	Step         int
+	Epoch        float64
+	TrainLoss    *float64
+	EvalLoss     *float64
+	LearningRate *float64
+	GPUMemoryGB  *float64
+	// InferenceSamples are the outputs of the model at this step, nil when the trainer did not run them
+	InferenceSamples []entities.InferenceSampleItem
+}
@@ -0,0 +1,7 @@
+package in
+
+import "context"
+
+type UpdateFinetuneMetricsUseCase interface {
+	Execute(ctx context.Context, command UpdateFinetuneMetricsCommand) error
+}
@@ -9,4 +9,6 @@ import (
 type UpdateFinetuneStatusCommand struct {
 	FinetuneID uuid.UUID              `json:"finetune_id"`
 	Status     entities.FinetuneStatus `json:"status"`
+	// Result is the metadata of the model, only used when the status is DONE
+	Result *entities.FinetuneResult `json:"result,omitempty"`
 }
\ No newline at end of file
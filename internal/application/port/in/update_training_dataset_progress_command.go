@@ -0,0 +1,14 @@
+package in
+
+import "github.com/google/uuid"
+
+type UpdateTrainingDatasetProgressCommand struct {
+	TrainingDatasetID uuid.UUID
+	ItemsGenerated    int
+	ChunksProcessed   int
+	ChunksTotal       *int
+	TokensIn          int
+	TokensOut         int
+	// EtaSeconds is estimated from the elapsed time when the runner does not send it
+	EtaSeconds *float64
+}
@@ -0,0 +1,7 @@
+package in
+
+import "context"
+
+type UpdateTrainingDatasetProgressUseCase interface {
+	Execute(ctx context.Context, command UpdateTrainingDatasetProgressCommand) error
+}
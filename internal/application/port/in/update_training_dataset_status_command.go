@@ -9,4 +9,7 @@ import (
 type UpdateTrainingDatasetStatusCommand struct {
 	TrainingDatasetID uuid.UUID                     `json:"training_dataset_id"`
 	Status            entities.TrainingDatasetStatus `json:"status"`
+	// Deduplicate runs a dedup pass over the results when the status is set to DONE
+	Deduplicate         bool    `json:"deduplicate"`
+	SimilarityThreshold float64 `json:"similarity_threshold"`
 }
\ No newline at end of file
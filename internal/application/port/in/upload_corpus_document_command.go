@@ -0,0 +1,10 @@
+package in
+
+import "github.com/google/uuid"
+
+type UploadCorpusDocumentCommand struct {
+	CorpusID uuid.UUID
+	OwnerID  uuid.UUID
+	FileName string
+	Content  []byte
+}
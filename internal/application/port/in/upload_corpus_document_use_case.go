@@ -0,0 +1,11 @@
+package in
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type UploadCorpusDocumentUseCase interface {
+	UploadCorpusDocument(ctx context.Context, command UploadCorpusDocumentCommand) (*entities.CorpusDocument, error)
+}
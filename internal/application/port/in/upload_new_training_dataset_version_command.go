@@ -1,9 +1,14 @@
 package in
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
 
 type UploadNewTrainingDatasetVersionCommand struct {
 	ProjectID uuid.UUID
 	OwnerID   uuid.UUID
-	CsvData   []byte
+	FileData  []byte
+	Format    entities.TrainingDatasetImportFormat
 }
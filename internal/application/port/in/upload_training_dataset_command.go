@@ -1,10 +1,15 @@
 package in
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
 
 type UploadTrainingDatasetCommand struct {
 	ProjectID         uuid.UUID
 	TrainingDatasetID uuid.UUID
 	OwnerID           uuid.UUID
-	CsvData           []byte
+	FileData          []byte
+	Format            entities.TrainingDatasetImportFormat
 }
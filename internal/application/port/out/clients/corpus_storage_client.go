@@ -0,0 +1,15 @@
+package clients
+
+import (
+	"context"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+// CorpusStorageClient stores the documents of a corpus below its S3 path
+type CorpusStorageClient interface {
+	UploadDocument(ctx context.Context, s3Path string, fileName string, contentType string, content []byte) error
+	DownloadDocument(ctx context.Context, s3Path string, fileName string) ([]byte, error)
+	DeleteDocument(ctx context.Context, s3Path string, fileName string) error
+	ListDocuments(ctx context.Context, s3Path string) ([]entities.CorpusStorageObject, error)
+}
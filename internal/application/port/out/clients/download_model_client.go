@@ -7,6 +7,14 @@ import (
 	"github.com/google/uuid"
 )
 
+// ModelArtifact is the GGUF recorded when the finetune was done, downloads are verified against it
+type ModelArtifact struct {
+	SizeBytes int64
+	SHA256    string
+}
+
 type DownloadModelClient interface {
-	DownloadModel(ctx context.Context, finetuneID uuid.UUID, modelName string) (io.ReadCloser, int64, error)
-}
\ No newline at end of file
+	// DownloadModel streams the GGUF, with an artifact the size is checked before and the checksum while streaming.
+	// Finetunes done before checksums were recorded have no artifact.
+	DownloadModel(ctx context.Context, finetuneID uuid.UUID, modelName string, artifact *ModelArtifact) (io.ReadCloser, int64, error)
+}
@@ -0,0 +1,18 @@
+package clients
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type FinetuneArtifactClient interface {
+	// GetResultManifest reads the manifest the trainer writes next to the GGUF, it returns nil without a manifest
+	GetResultManifest(ctx context.Context, finetuneID uuid.UUID, modelName string) (*entities.FinetuneResult, error)
+	// GetModelSize returns the size of the GGUF in bytes
+	GetModelSize(ctx context.Context, finetuneID uuid.UUID, modelName string) (int64, error)
+	// ComputeModelChecksum streams the GGUF and returns its hex encoded SHA-256
+	ComputeModelChecksum(ctx context.Context, finetuneID uuid.UUID, modelName string) (string, error)
+}
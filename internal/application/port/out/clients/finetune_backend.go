@@ -0,0 +1,53 @@
+package clients
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+// FinetuneBackendJob is what a backend needs to train a finetune, the training data is in the job file on S3
+type FinetuneBackendJob struct {
+	FinetuneID      uuid.UUID
+	JobS3Key        string
+	DocumentsS3Path string
+	BaseModelName   string
+	ModelName       string
+	Hyperparameters entities.FinetuneHyperparameters
+}
+
+type FinetuneBackendJobStatus string
+
+const (
+	FinetuneBackendJobStatusQueued    FinetuneBackendJobStatus = "QUEUED"
+	FinetuneBackendJobStatusRunning   FinetuneBackendJobStatus = "RUNNING"
+	FinetuneBackendJobStatusCompleted FinetuneBackendJobStatus = "COMPLETED"
+	FinetuneBackendJobStatusFailed    FinetuneBackendJobStatus = "FAILED"
+	FinetuneBackendJobStatusCancelled FinetuneBackendJobStatus = "CANCELLED"
+	// FinetuneBackendJobStatusUnknown is returned for jobs the backend does not know (anymore)
+	FinetuneBackendJobStatusUnknown FinetuneBackendJobStatus = "UNKNOWN"
+)
+
+// IsFinal tells if the job has ended and can not be cancelled anymore
+func (s FinetuneBackendJobStatus) IsFinal() bool {
+	return s == FinetuneBackendJobStatusCompleted || s == FinetuneBackendJobStatusFailed || s == FinetuneBackendJobStatusCancelled
+}
+
+// FinetuneBackend trains finetunes. The trainer reports the progress of a job to the external API, the backend only
+// starts, cancels and looks up jobs.
+type FinetuneBackend interface {
+	// Submit starts the job and returns the ID of the job in the backend
+	Submit(ctx context.Context, job FinetuneBackendJob) (string, error)
+	Cancel(ctx context.Context, jobID string) error
+	Status(ctx context.Context, jobID string) (FinetuneBackendJobStatus, error)
+}
+
+// FinetuneBackends are the backends enabled in this environment
+type FinetuneBackends interface {
+	// Get returns an error when the backend is not enabled
+	Get(backendType entities.FinetuneBackendType) (FinetuneBackend, error)
+	// Default is the backend of finetunes that do not choose one
+	Default() entities.FinetuneBackendType
+}
@@ -1,9 +0,0 @@
-package clients
-
-import (
-	"context"
-)
-
-type RunpodClient interface {
-	StartFinetuneJob(ctx context.Context, s3Key string, documentsS3Path string, baseModelName string, modelName string, finetuneID string) error
-}
\ No newline at end of file
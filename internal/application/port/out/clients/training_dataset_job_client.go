@@ -6,6 +6,22 @@ import (
 	"ai-platform/internal/application/domain/entities"
 )
 
+// ReceivedTrainingDatasetJob is a submitted job together with the key it is stored under
+type ReceivedTrainingDatasetJob struct {
+	Key string
+	Job entities.TrainingDatasetJob
+}
+
 type TrainingDatasetJobClient interface {
+	// SubmitJob also clears the cancel marker of an earlier abort of the training dataset
 	SubmitJob(ctx context.Context, job entities.TrainingDatasetJob) error
-}
\ No newline at end of file
+	// CancelJob removes the pending jobs of the training dataset and signals a running job to stop
+	CancelJob(ctx context.Context, trainingDatasetID string) error
+}
+
+// TrainingDatasetJobQueueClient is the consumer side of the submitted jobs, used by the generation worker
+type TrainingDatasetJobQueueClient interface {
+	// ReceiveJobs returns the pending jobs, oldest first
+	ReceiveJobs(ctx context.Context) ([]ReceivedTrainingDatasetJob, error)
+	DeleteJob(ctx context.Context, key string) error
+}
@@ -15,6 +15,30 @@ type TrainingDatasetResult struct {
 	TrainingDataItems          []entities.TrainingDataItem `json:"training_data_items"`
 }
 
+// TrainingDatasetAnnotation is a single generated example, Fields holds the value of every field name and Messages
+// the conversation of a conversation dataset
+type TrainingDatasetAnnotation struct {
+	DocumentID           string
+	WithinStart          int
+	WithinEnd            int
+	InferenceTimeSeconds float64
+	Fields               map[string]string
+	Messages             []entities.ConversationMessage
+}
+
+// TrainingDatasetResultsPart is one results file of a training dataset, the results are the sum of all parts
+type TrainingDatasetResultsPart struct {
+	TotalGenerationTimeSeconds float64
+	TokensIn                   int
+	TokensOut                  int
+	Annotations                []TrainingDatasetAnnotation
+}
+
 type TrainingDatasetResultsClient interface {
+	// GetTrainingDatasetResults reads all results files, without field names the annotations are read as conversations
 	GetTrainingDatasetResults(ctx context.Context, trainingDatasetID uuid.UUID, fieldNames []string) (*TrainingDatasetResult, error)
+	// GetPartialTrainingDatasetResults reads the results files written so far, it returns empty results before the
+	// first file is written and skips incomplete annotations
+	GetPartialTrainingDatasetResults(ctx context.Context, trainingDatasetID uuid.UUID, fieldNames []string) (*TrainingDatasetResult, error)
+	PutTrainingDatasetResultsPart(ctx context.Context, trainingDatasetID uuid.UUID, partName string, part TrainingDatasetResultsPart) error
 }
\ No newline at end of file
@@ -0,0 +1,16 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type CorpusChunkRepository interface {
+	// ReplaceForDocument replaces the chunks of a document for one chunking config
+	ReplaceForDocument(ctx context.Context, corpusID uuid.UUID, configKey string, fileName string, chunks []entities.CorpusChunk) error
+	// FindByRange returns the chunk containing the character range of a document, or the chunk overlapping it the most
+	FindByRange(ctx context.Context, corpusID uuid.UUID, configKey string, fileName string, start int, end int) (*entities.CorpusChunk, error)
+}
@@ -0,0 +1,17 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type CorpusDocumentRepository interface {
+	// Save creates the document or replaces the metadata of the document with the same file name
+	Save(ctx context.Context, document *entities.CorpusDocument) error
+	GetByCorpusID(ctx context.Context, corpusID uuid.UUID) ([]*entities.CorpusDocument, error)
+	GetByFileName(ctx context.Context, corpusID uuid.UUID, fileName string) (*entities.CorpusDocument, error)
+	DeleteByFileName(ctx context.Context, corpusID uuid.UUID, fileName string) error
+}
@@ -12,7 +12,11 @@ type CorpusRepository interface {
 	Create(ctx context.Context, corpus *entities.Corpus) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Corpus, error)
 	GetByName(ctx context.Context, name string) (*entities.Corpus, error)
+	// GetByNameForOwner returns the corpus of the owner with the name, or the shared corpus with the name
+	GetByNameForOwner(ctx context.Context, name string, ownerID uuid.UUID) (*entities.Corpus, error)
 	Update(ctx context.Context, corpus *entities.Corpus) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context) ([]*entities.Corpus, error)
-}
\ No newline at end of file
+	// ListForOwner returns the shared corpora and the corpora of the owner, optionally limited to a project
+	ListForOwner(ctx context.Context, ownerID uuid.UUID, projectID *uuid.UUID) ([]*entities.Corpus, error)
+}
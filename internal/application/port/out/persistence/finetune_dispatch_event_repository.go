@@ -0,0 +1,17 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+// FinetuneDispatchEventRepository stores the dispatch history of the finetunes, the events of the scheduler are
+// created together with the status change by FinetuneRepository.Dispatch and FinetuneRepository.CancelQueued
+type FinetuneDispatchEventRepository interface {
+	Create(ctx context.Context, event *entities.FinetuneDispatchEvent) error
+	// ListByFinetuneID returns the events ordered by time
+	ListByFinetuneID(ctx context.Context, finetuneID uuid.UUID) ([]*entities.FinetuneDispatchEvent, error)
+}
@@ -0,0 +1,16 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type FinetuneMetricRepository interface {
+	// Save creates or replaces the metric of the step
+	Save(ctx context.Context, metric *entities.FinetuneMetric) error
+	// ListByFinetuneID returns the metrics ordered by step
+	ListByFinetuneID(ctx context.Context, finetuneID uuid.UUID) ([]*entities.FinetuneMetric, error)
+}
@@ -10,11 +10,36 @@ import (
 
 type FinetuneRepository interface {
 	Create(ctx context.Context, finetune *entities.Finetune) error
+	// CreateWithOutboxJob creates the finetune, its job, the PII report of its training data and the event that it was
+	// queued in one transaction. The PII report can be nil.
+	CreateWithOutboxJob(ctx context.Context, finetune *entities.Finetune, job *entities.OutboxJob, piiReport *entities.PIIReport, event *entities.FinetuneDispatchEvent) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Finetune, error)
 	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entities.Finetune, error)
 	GetLatestByProjectID(ctx context.Context, projectID uuid.UUID) (*entities.Finetune, error)
 	Update(ctx context.Context, finetune *entities.Finetune) error
+	// UpdateStatus clears the failure reason, UpdateStatusWithReason sets it
 	UpdateStatus(ctx context.Context, id uuid.UUID, status entities.FinetuneStatus) error
+	UpdateStatusWithReason(ctx context.Context, id uuid.UUID, status entities.FinetuneStatus, reason string) error
+	// UpdateStatusFrom is UpdateStatus for a finetune that is still in fromStatus. It returns false when the status
+	// changed meanwhile, e.g. because the finetune was aborted.
+	UpdateStatusFrom(ctx context.Context, id uuid.UUID, fromStatus entities.FinetuneStatus, status entities.FinetuneStatus) (bool, error)
+	UpdateInferenceSamples(ctx context.Context, id uuid.UUID, samples []entities.InferenceSample) error
+	// Complete stores the result of the model and sets the finetune to DONE, as long as it is still in fromStatus. It
+	// returns false when the status changed meanwhile, e.g. because the finetune was aborted.
+	Complete(ctx context.Context, finetune *entities.Finetune, fromStatus entities.FinetuneStatus) (bool, error)
+	// SetBackendJobID only stores the job ID when the finetune has none yet and leaves the other columns untouched, so
+	// a status the trainer or an abort wrote meanwhile is kept. It returns false when a job ID was already stored.
+	SetBackendJobID(ctx context.Context, id uuid.UUID, backendJobID string) (bool, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetNextVersion(ctx context.Context, projectID uuid.UUID) (int, error)
+	// ListScheduled returns the finetunes in QUEUED, PLANNING and RUNNING with the owner of their project
+	ListScheduled(ctx context.Context) ([]entities.FinetuneQueueEntry, error)
+	// DispatchScheduled lists the scheduled finetunes and dispatches the ones selectDispatchable returns an event for,
+	// in one transaction that holds a lock shared by all instances so their schedulers can not exceed the limits
+	// together. A dispatched finetune moves from QUEUED to PLANNING and its outbox job is released, a finetune that is
+	// not queued anymore is skipped. It returns the number of dispatched finetunes.
+	DispatchScheduled(ctx context.Context, selectDispatchable func(entries []entities.FinetuneQueueEntry) []*entities.FinetuneDispatchEvent) (int, error)
+	// CancelQueued moves a QUEUED finetune to ABORTED, cancels its outbox job and records the event in one
+	// transaction. It returns false when the finetune is not queued anymore.
+	CancelQueued(ctx context.Context, id uuid.UUID, event *entities.FinetuneDispatchEvent) (bool, error)
 }
\ No newline at end of file
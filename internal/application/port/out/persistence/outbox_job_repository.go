@@ -0,0 +1,17 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+// OutboxJobRepository is used by the dispatcher, the jobs are created together with their entity by
+// TrainingDatasetRepository.CreateWithOutboxJob and FinetuneRepository.CreateWithOutboxJob
+type OutboxJobRepository interface {
+	// ClaimDue returns up to limit pending jobs that are due and moves their next attempt to leaseUntil, so a job is
+	// not delivered by two dispatchers at the same time and is picked up again when a dispatcher dies while delivering
+	ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]entities.OutboxJob, error)
+	Update(ctx context.Context, job *entities.OutboxJob) error
+}
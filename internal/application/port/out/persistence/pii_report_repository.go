@@ -0,0 +1,14 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type PIIReportRepository interface {
+	Create(ctx context.Context, report *entities.PIIReport) error
+	GetByTrainingDatasetID(ctx context.Context, trainingDatasetID uuid.UUID) ([]*entities.PIIReport, error)
+}
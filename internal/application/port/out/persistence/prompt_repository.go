@@ -9,8 +9,12 @@ import (
 )
 
 type PromptRepository interface {
+	// Create stores a new prompt version, the same version with the same text stored concurrently is reused
 	Create(ctx context.Context, prompt *entities.Prompt) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Prompt, error)
+	// GetByProjectID returns the prompt versions of a project, the latest first
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entities.Prompt, error)
+	GetLatestByProjectID(ctx context.Context, projectID uuid.UUID) (*entities.Prompt, error)
 	Update(ctx context.Context, prompt *entities.Prompt) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
\ No newline at end of file
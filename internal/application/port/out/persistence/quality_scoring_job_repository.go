@@ -0,0 +1,22 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type QualityScoringJobRepository interface {
+	// Create inserts the job unless the training dataset already has a PENDING or RUNNING job, created is false then
+	Create(ctx context.Context, job *entities.QualityScoringJob) (bool, error)
+	// ClaimNext moves the oldest PENDING job, or a RUNNING job whose lease expired because its worker stopped, to
+	// RUNNING until leaseUntil and counts the attempt. It returns nil when there is no job to run.
+	ClaimNext(ctx context.Context, leaseUntil time.Time) (*entities.QualityScoringJob, error)
+	// Update saves the progress and status of a claimed job
+	Update(ctx context.Context, job *entities.QualityScoringJob) error
+	// GetLatestByTrainingDatasetID returns the newest job of the training dataset or nil
+	GetLatestByTrainingDatasetID(ctx context.Context, trainingDatasetID uuid.UUID) (*entities.QualityScoringJob, error)
+}
@@ -0,0 +1,15 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"ai-platform/internal/application/domain/entities"
+)
+
+type TrainingDatasetProgressRepository interface {
+	// Save creates or replaces the progress of the training dataset, a zero start time is set to now
+	Save(ctx context.Context, progress *entities.TrainingDatasetProgress) error
+	GetByTrainingDatasetID(ctx context.Context, trainingDatasetID uuid.UUID) (*entities.TrainingDatasetProgress, error)
+}
@@ -10,10 +10,40 @@ import (
 
 type TrainingDatasetRepository interface {
 	Create(ctx context.Context, trainingDataset *entities.TrainingDataset) error
+	// CreateWithOutboxJob creates the training dataset and its job in one transaction. A new prompt version the
+	// dataset is generated with is stored in the same transaction, nil when an existing version is reused.
+	CreateWithOutboxJob(ctx context.Context, trainingDataset *entities.TrainingDataset, job *entities.OutboxJob, prompt *entities.Prompt) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.TrainingDataset, error)
+	// GetMetadataByID returns the training dataset without loading its items
+	GetMetadataByID(ctx context.Context, id uuid.UUID) (*entities.TrainingDataset, error)
 	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entities.TrainingDataset, error)
 	GetLatestByProjectID(ctx context.Context, projectID uuid.UUID) (*entities.TrainingDataset, error)
+	GetPreviousVersionID(ctx context.Context, projectID uuid.UUID, version int) (*uuid.UUID, error)
+	// ListGenerationHistory returns the usage of the newest DONE generations of the project, newest first
+	ListGenerationHistory(ctx context.Context, projectID uuid.UUID, limit int) ([]entities.TrainingDatasetGenerationHistory, error)
+	// ListPromptItems returns the non-deleted training datasets of the project with their item counts per prompt version
+	ListPromptItems(ctx context.Context, projectID uuid.UUID) ([]entities.TrainingDatasetPromptItems, error)
 	Update(ctx context.Context, trainingDataset *entities.TrainingDataset) error
+	// UpdateMetadata leaves the status untouched, it changes through UpdateStatus
+	UpdateMetadata(ctx context.Context, trainingDataset *entities.TrainingDataset) error
+	// UpdateChunkingConfig only writes the chunking config, so a status the user changed meanwhile is kept
+	UpdateChunkingConfig(ctx context.Context, id uuid.UUID, config entities.CorpusChunkingConfig) error
+	// UpdateStatus clears the failure reason, UpdateStatusWithReason sets it
 	UpdateStatus(ctx context.Context, id uuid.UUID, status entities.TrainingDatasetStatus) error
+	UpdateStatusWithReason(ctx context.Context, id uuid.UUID, status entities.TrainingDatasetStatus, reason string) error
+	// UpdateStatusFrom is UpdateStatus for a training dataset that is still in fromStatus. It returns false when the
+	// status changed meanwhile, e.g. because the training dataset was aborted.
+	UpdateStatusFrom(ctx context.Context, id uuid.UUID, fromStatus entities.TrainingDatasetStatus, status entities.TrainingDatasetStatus) (bool, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	ListItems(ctx context.Context, trainingDatasetID uuid.UUID, filter entities.TrainingDataItemFilter, after *entities.TrainingDataItemCursor, limit int) ([]entities.TrainingDataItem, error)
+	CountItems(ctx context.Context, trainingDatasetID uuid.UUID, filter entities.TrainingDataItemFilter) (int, error)
+	GetItemByID(ctx context.Context, trainingDatasetID uuid.UUID, itemID uuid.UUID) (*entities.TrainingDataItem, error)
+	GetItemCorrections(ctx context.Context, trainingDatasetID uuid.UUID, itemID uuid.UUID) ([]entities.TrainingDataItem, error)
+	// GetAllItems returns the items including deleted and corrected items, GetByID only loads the non-deleted items
+	GetAllItems(ctx context.Context, trainingDatasetID uuid.UUID) ([]entities.TrainingDataItem, error)
+	CreateItems(ctx context.Context, trainingDatasetID uuid.UUID, items []entities.TrainingDataItem) error
+	UpdateItemDeleted(ctx context.Context, trainingDatasetID uuid.UUID, itemID uuid.UUID, deleted bool) error
+	MarkItemsDeleted(ctx context.Context, trainingDatasetID uuid.UUID, reasons map[uuid.UUID]string) error
+	UpdateItemSplits(ctx context.Context, trainingDatasetID uuid.UUID, splits map[uuid.UUID]entities.TrainingDataItemSplit) error
+	UpdateItemQualityScores(ctx context.Context, trainingDatasetID uuid.UUID, scores map[uuid.UUID]entities.TrainingDataItemQualityScore) error
 }
\ No newline at end of file
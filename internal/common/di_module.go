@@ -1,13 +1,18 @@
 package common
 
 import (
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.uber.org/fx"
 
 	"ai-platform/internal/adapter/in/web"
 	"ai-platform/internal/adapter/out/clients"
 	"ai-platform/internal/adapter/out/persistence"
+	"ai-platform/internal/application/domain/entities"
 	"ai-platform/internal/application/domain/services"
 	"ai-platform/internal/application/domain/use_cases"
 	"ai-platform/internal/application/port/in"
@@ -15,6 +20,7 @@ import (
 	persistencePort "ai-platform/internal/application/port/out/persistence"
 	"ai-platform/internal/database"
 	"ai-platform/internal/server"
+	"ai-platform/internal/worker"
 )
 
 func NewUserRepository(dbService database.Service) persistencePort.UserRepository {
@@ -41,6 +47,36 @@ func NewCorpusRepository(dbService database.Service) persistencePort.CorpusRepos
 	}
 }
 
+func NewCorpusDocumentRepository(dbService database.Service) persistencePort.CorpusDocumentRepository {
+	return &persistence.CorpusDocumentRepositoryImpl{
+		Db: dbService.GetDB(),
+	}
+}
+
+func NewCorpusChunkRepository(dbService database.Service) persistencePort.CorpusChunkRepository {
+	return &persistence.CorpusChunkRepositoryImpl{
+		Db: dbService.GetDB(),
+	}
+}
+
+func NewTrainingDatasetProgressRepository(dbService database.Service) persistencePort.TrainingDatasetProgressRepository {
+	return &persistence.TrainingDatasetProgressRepositoryImpl{
+		Db: dbService.GetDB(),
+	}
+}
+
+func NewOutboxJobRepository(dbService database.Service) persistencePort.OutboxJobRepository {
+	return &persistence.OutboxJobRepositoryImpl{
+		Db: dbService.GetDB(),
+	}
+}
+
+func NewQualityScoringJobRepository(dbService database.Service) persistencePort.QualityScoringJobRepository {
+	return &persistence.QualityScoringJobRepositoryImpl{
+		Db: dbService.GetDB(),
+	}
+}
+
 func NewPromptRepository(dbService database.Service) persistencePort.PromptRepository {
 	return &persistence.PromptRepositoryImpl{
 		Db: dbService.GetDB(),
@@ -53,6 +89,18 @@ func NewFinetuneRepository(dbService database.Service) persistencePort.FinetuneR
 	}
 }
 
+func NewFinetuneDispatchEventRepository(dbService database.Service) persistencePort.FinetuneDispatchEventRepository {
+	return &persistence.FinetuneDispatchEventRepositoryImpl{
+		Db: dbService.GetDB(),
+	}
+}
+
+func NewFinetuneMetricRepository(dbService database.Service) persistencePort.FinetuneMetricRepository {
+	return &persistence.FinetuneMetricRepositoryImpl{
+		Db: dbService.GetDB(),
+	}
+}
+
 func NewDeploymentRepository(dbService database.Service) persistencePort.DeploymentRepository {
 	return &persistence.DeploymentRepositoryImpl{
 		Db: dbService.GetDB(),
@@ -65,6 +113,12 @@ func NewDeploymentLogsRepository(dbService database.Service) persistencePort.Dep
 	}
 }
 
+func NewPIIReportRepository(dbService database.Service) persistencePort.PIIReportRepository {
+	return &persistence.PIIReportRepositoryImpl{
+		Db: dbService.GetDB(),
+	}
+}
+
 func NewUserService() *services.UserService {
 	return &services.UserService{}
 }
@@ -78,14 +132,85 @@ func NewProjectService(projectRepo persistencePort.ProjectRepository, trainingDa
 	}
 }
 
+func NewCorpusService(corpusRepo persistencePort.CorpusRepository) *services.CorpusService {
+	return &services.CorpusService{
+		CorpusRepository: corpusRepo,
+	}
+}
+
+func NewCorpusChunkingService(
+	corpusDocumentRepo persistencePort.CorpusDocumentRepository,
+	corpusChunkRepo persistencePort.CorpusChunkRepository,
+	corpusStorageClient clientsPort.CorpusStorageClient,
+) *services.CorpusChunkingService {
+	return &services.CorpusChunkingService{
+		CorpusDocumentRepository: corpusDocumentRepo,
+		CorpusChunkRepository:    corpusChunkRepo,
+		CorpusStorageClient:      corpusStorageClient,
+	}
+}
+
 func NewTrainingDatasetService() *services.TrainingDatasetService {
 	return &services.TrainingDatasetService{}
 }
 
+func NewTrainingDatasetImportService() *services.TrainingDatasetImportService {
+	return &services.TrainingDatasetImportService{}
+}
+
+func NewTrainingDataDeduplicationService() *services.TrainingDataDeduplicationService {
+	return &services.TrainingDataDeduplicationService{}
+}
+
+func NewTrainingDatasetDiffService() *services.TrainingDatasetDiffService {
+	return &services.TrainingDatasetDiffService{}
+}
+
+func NewTrainingDatasetStatsService(trainingDatasetService *services.TrainingDatasetService) *services.TrainingDatasetStatsService {
+	return &services.TrainingDatasetStatsService{
+		TrainingDatasetService: trainingDatasetService,
+	}
+}
+
+func NewTrainingDatasetEstimateService() *services.TrainingDatasetEstimateService {
+	defaultGPUPricePerHour := services.DefaultGenerationGPUPricePerHour
+	if value := os.Getenv("GENERATION_GPU_PRICE_PER_HOUR"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			panic(err)
+		}
+		defaultGPUPricePerHour = parsed
+	}
+
+	return &services.TrainingDatasetEstimateService{
+		DefaultGPUPricePerHour: defaultGPUPricePerHour,
+	}
+}
+
+func NewTrainingDataQualityService(ollamaLLMClient clientsPort.OllamaLLMClient) *services.TrainingDataQualityService {
+	return &services.TrainingDataQualityService{
+		OllamaLLMClient: ollamaLLMClient,
+	}
+}
+
+func NewPIIService() *services.PIIService {
+	return &services.PIIService{
+		Detectors: services.DefaultPIIDetectors(),
+	}
+}
+
 func NewFinetuneService() *services.FinetuneService {
 	return &services.FinetuneService{}
 }
 
+func NewFinetuneMetricsService() *services.FinetuneMetricsService {
+	return &services.FinetuneMetricsService{}
+}
+
+func NewFinetuneQueueService() *services.FinetuneQueueService {
+	return &services.FinetuneQueueService{}
+}
+
 func NewFinetuneCompletionService(
 	finetuneRepo persistencePort.FinetuneRepository,
 	projectRepo persistencePort.ProjectRepository,
@@ -94,6 +219,30 @@ func NewFinetuneCompletionService(
 	return services.NewFinetuneCompletionService(finetuneRepo, projectRepo, ollamaLLMClient)
 }
 
+func NewTrainingDatasetProgressService() *services.TrainingDatasetProgressService {
+	return &services.TrainingDatasetProgressService{}
+}
+
+func NewStatusTransitionService() *services.StatusTransitionService {
+	return &services.StatusTransitionService{}
+}
+
+func NewPromptService(promptRepo persistencePort.PromptRepository) *services.PromptService {
+	return &services.PromptService{
+		PromptRepository: promptRepo,
+	}
+}
+
+func NewOutboxService() *services.OutboxService {
+	return &services.OutboxService{}
+}
+
+func NewTrainingDatasetGenerationService(ollamaLLMClient clientsPort.OllamaLLMClient) *services.TrainingDatasetGenerationService {
+	return &services.TrainingDatasetGenerationService{
+		OllamaLLMClient: ollamaLLMClient,
+	}
+}
+
 func NewPromptAnalysisService(ollamaLLMClient clientsPort.OllamaLLMClient) *services.PromptAnalysisService {
 	return &services.PromptAnalysisService{
 		OllamaLLMClient: ollamaLLMClient,
@@ -149,17 +298,23 @@ func NewCreateTrainingDatasetUseCase(
 	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
 	projectRepo persistencePort.ProjectRepository,
 	corpusRepo persistencePort.CorpusRepository,
+	corpusService *services.CorpusService,
+	corpusChunkingService *services.CorpusChunkingService,
 	promptRepo persistencePort.PromptRepository,
+	promptService *services.PromptService,
 	trainingDatasetService *services.TrainingDatasetService,
-	trainingDatasetJobClient clientsPort.TrainingDatasetJobClient,
+	outboxService *services.OutboxService,
 ) in.CreateTrainingDatasetUseCase {
 	return &use_cases.CreateTrainingDatasetUseCaseImpl{
 		TrainingDatasetRepository: trainingDatasetRepo,
 		ProjectRepository:         projectRepo,
 		CorpusRepository:          corpusRepo,
+		CorpusService:             corpusService,
+		CorpusChunkingService:     corpusChunkingService,
 		PromptRepository:          promptRepo,
+		PromptService:             promptService,
 		TrainingDatasetService:    trainingDatasetService,
-		TrainingDatasetJobClient:  trainingDatasetJobClient,
+		OutboxService:             outboxService,
 	}
 }
 
@@ -170,8 +325,10 @@ func NewCreateFinetuneUseCase(
 	corpusRepo persistencePort.CorpusRepository,
 	finetuneService *services.FinetuneService,
 	trainingDatasetService *services.TrainingDatasetService,
-	finetuneJobClient clientsPort.FinetuneJobClient,
-	runpodClient clientsPort.RunpodClient,
+	piiService *services.PIIService,
+	outboxService *services.OutboxService,
+	finetuneBackends clientsPort.FinetuneBackends,
+	finetuneQueueService *services.FinetuneQueueService,
 ) in.CreateFinetuneUseCase {
 	return &use_cases.CreateFinetuneUseCaseImpl{
 		FinetuneRepository:        finetuneRepo,
@@ -180,8 +337,10 @@ func NewCreateFinetuneUseCase(
 		CorpusRepository:          corpusRepo,
 		FinetuneService:           finetuneService,
 		TrainingDatasetService:    trainingDatasetService,
-		FinetuneJobClient:         finetuneJobClient,
-		RunpodClient:              runpodClient,
+		PIIService:                piiService,
+		OutboxService:             outboxService,
+		FinetuneBackends:          finetuneBackends,
+		FinetuneQueueService:      finetuneQueueService,
 	}
 }
 
@@ -220,10 +379,14 @@ func NewCreateTrainingDatasetController(
 func NewUpdateTrainingDatasetStatusUseCase(
 	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
 	trainingDatasetResultsClient clientsPort.TrainingDatasetResultsClient,
+	trainingDataDeduplicationService *services.TrainingDataDeduplicationService,
+	statusTransitionService *services.StatusTransitionService,
 ) in.UpdateTrainingDatasetStatusUseCase {
 	return &use_cases.UpdateTrainingDatasetStatusUseCaseImpl{
-		TrainingDatasetRepository:     trainingDatasetRepo,
-		TrainingDatasetResultsClient: trainingDatasetResultsClient,
+		TrainingDatasetRepository:        trainingDatasetRepo,
+		TrainingDatasetResultsClient:     trainingDatasetResultsClient,
+		TrainingDataDeduplicationService: trainingDataDeduplicationService,
+		StatusTransitionService:          statusTransitionService,
 	}
 }
 
@@ -241,17 +404,228 @@ func NewGetTrainingDatasetUseCase(
 	}
 }
 
+func NewUpdateTrainingDatasetProgressUseCase(
+	trainingDatasetProgressService *services.TrainingDatasetProgressService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	trainingDatasetProgressRepo persistencePort.TrainingDatasetProgressRepository,
+) in.UpdateTrainingDatasetProgressUseCase {
+	return &use_cases.UpdateTrainingDatasetProgressUseCaseImpl{
+		TrainingDatasetProgressService:    trainingDatasetProgressService,
+		TrainingDatasetRepository:         trainingDatasetRepo,
+		TrainingDatasetProgressRepository: trainingDatasetProgressRepo,
+	}
+}
+
+func NewUpdateTrainingDatasetProgressController(updateTrainingDatasetProgressUseCase in.UpdateTrainingDatasetProgressUseCase) *web.UpdateTrainingDatasetProgressController {
+	return &web.UpdateTrainingDatasetProgressController{
+		UpdateTrainingDatasetProgressUseCase: updateTrainingDatasetProgressUseCase,
+	}
+}
+
+func NewGetTrainingDatasetProgressUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetProgressService *services.TrainingDatasetProgressService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	trainingDatasetProgressRepo persistencePort.TrainingDatasetProgressRepository,
+) in.GetTrainingDatasetProgressUseCase {
+	return &use_cases.GetTrainingDatasetProgressUseCaseImpl{
+		ProjectService:                    projectService,
+		TrainingDatasetProgressService:    trainingDatasetProgressService,
+		TrainingDatasetRepository:         trainingDatasetRepo,
+		TrainingDatasetProgressRepository: trainingDatasetProgressRepo,
+	}
+}
+
+func NewGetTrainingDatasetProgressController(getTrainingDatasetProgressUseCase in.GetTrainingDatasetProgressUseCase) *web.GetTrainingDatasetProgressController {
+	return &web.GetTrainingDatasetProgressController{
+		GetTrainingDatasetProgressUseCase: getTrainingDatasetProgressUseCase,
+	}
+}
+
+func NewGetTrainingDatasetPartialResultsUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	trainingDatasetResultsClient clientsPort.TrainingDatasetResultsClient,
+) in.GetTrainingDatasetPartialResultsUseCase {
+	return &use_cases.GetTrainingDatasetPartialResultsUseCaseImpl{
+		ProjectService:               projectService,
+		TrainingDatasetRepository:    trainingDatasetRepo,
+		TrainingDatasetResultsClient: trainingDatasetResultsClient,
+	}
+}
+
+func NewGetTrainingDatasetPartialResultsController(getTrainingDatasetPartialResultsUseCase in.GetTrainingDatasetPartialResultsUseCase) *web.GetTrainingDatasetPartialResultsController {
+	return &web.GetTrainingDatasetPartialResultsController{
+		GetTrainingDatasetPartialResultsUseCase: getTrainingDatasetPartialResultsUseCase,
+	}
+}
+
+func NewRunTrainingDatasetJobUseCase(
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	corpusRepo persistencePort.CorpusRepository,
+	corpusChunkingService *services.CorpusChunkingService,
+	trainingDatasetGenerationService *services.TrainingDatasetGenerationService,
+	trainingDatasetResultsClient clientsPort.TrainingDatasetResultsClient,
+	updateTrainingDatasetStatusUseCase in.UpdateTrainingDatasetStatusUseCase,
+	updateTrainingDatasetProgressUseCase in.UpdateTrainingDatasetProgressUseCase,
+) in.RunTrainingDatasetJobUseCase {
+	return &use_cases.RunTrainingDatasetJobUseCaseImpl{
+		TrainingDatasetRepository:            trainingDatasetRepo,
+		CorpusRepository:                     corpusRepo,
+		CorpusChunkingService:                corpusChunkingService,
+		TrainingDatasetGenerationService:     trainingDatasetGenerationService,
+		TrainingDatasetResultsClient:         trainingDatasetResultsClient,
+		UpdateTrainingDatasetStatusUseCase:   updateTrainingDatasetStatusUseCase,
+		UpdateTrainingDatasetProgressUseCase: updateTrainingDatasetProgressUseCase,
+	}
+}
+
 func NewUpdateTrainingDatasetStatusController(updateTrainingDatasetStatusUseCase in.UpdateTrainingDatasetStatusUseCase) *web.UpdateTrainingDatasetStatusController {
 	return &web.UpdateTrainingDatasetStatusController{
 		UpdateTrainingDatasetStatusUseCase: updateTrainingDatasetStatusUseCase,
 	}
 }
 
+func NewAbortTrainingDatasetUseCase(
+	projectService *services.ProjectService,
+	statusTransitionService *services.StatusTransitionService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	trainingDatasetJobClient clientsPort.TrainingDatasetJobClient,
+) in.AbortTrainingDatasetUseCase {
+	return &use_cases.AbortTrainingDatasetUseCaseImpl{
+		ProjectService:            projectService,
+		StatusTransitionService:   statusTransitionService,
+		TrainingDatasetRepository: trainingDatasetRepo,
+		TrainingDatasetJobClient:  trainingDatasetJobClient,
+	}
+}
+
+func NewAbortTrainingDatasetController(abortTrainingDatasetUseCase in.AbortTrainingDatasetUseCase) *web.AbortTrainingDatasetController {
+	return &web.AbortTrainingDatasetController{
+		AbortTrainingDatasetUseCase: abortTrainingDatasetUseCase,
+	}
+}
+
+func NewResumeTrainingDatasetUseCase(
+	projectService *services.ProjectService,
+	statusTransitionService *services.StatusTransitionService,
+	trainingDatasetGenerationService *services.TrainingDatasetGenerationService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	trainingDatasetProgressRepo persistencePort.TrainingDatasetProgressRepository,
+	promptRepo persistencePort.PromptRepository,
+	corpusRepo persistencePort.CorpusRepository,
+	trainingDatasetResultsClient clientsPort.TrainingDatasetResultsClient,
+	trainingDatasetJobClient clientsPort.TrainingDatasetJobClient,
+) in.ResumeTrainingDatasetUseCase {
+	return &use_cases.ResumeTrainingDatasetUseCaseImpl{
+		ProjectService:                    projectService,
+		StatusTransitionService:           statusTransitionService,
+		TrainingDatasetGenerationService:  trainingDatasetGenerationService,
+		TrainingDatasetRepository:         trainingDatasetRepo,
+		TrainingDatasetProgressRepository: trainingDatasetProgressRepo,
+		PromptRepository:                  promptRepo,
+		CorpusRepository:                  corpusRepo,
+		TrainingDatasetResultsClient:      trainingDatasetResultsClient,
+		TrainingDatasetJobClient:          trainingDatasetJobClient,
+	}
+}
+
+func NewResumeTrainingDatasetController(resumeTrainingDatasetUseCase in.ResumeTrainingDatasetUseCase) *web.ResumeTrainingDatasetController {
+	return &web.ResumeTrainingDatasetController{
+		ResumeTrainingDatasetUseCase: resumeTrainingDatasetUseCase,
+	}
+}
+
+func NewGenerateMoreTrainingDatasetUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetService *services.TrainingDatasetService,
+	trainingDatasetGenerationService *services.TrainingDatasetGenerationService,
+	outboxService *services.OutboxService,
+	promptService *services.PromptService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	promptRepo persistencePort.PromptRepository,
+	corpusRepo persistencePort.CorpusRepository,
+) in.GenerateMoreTrainingDatasetUseCase {
+	return &use_cases.GenerateMoreTrainingDatasetUseCaseImpl{
+		ProjectService:                   projectService,
+		TrainingDatasetService:           trainingDatasetService,
+		TrainingDatasetGenerationService: trainingDatasetGenerationService,
+		OutboxService:                    outboxService,
+		PromptService:                    promptService,
+		TrainingDatasetRepository:        trainingDatasetRepo,
+		PromptRepository:                 promptRepo,
+		CorpusRepository:                 corpusRepo,
+	}
+}
+
+func NewListPromptsUseCase(
+	projectService *services.ProjectService,
+	promptService *services.PromptService,
+	promptRepo persistencePort.PromptRepository,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+) in.ListPromptsUseCase {
+	return &use_cases.ListPromptsUseCaseImpl{
+		ProjectService:            projectService,
+		PromptService:             promptService,
+		PromptRepository:          promptRepo,
+		TrainingDatasetRepository: trainingDatasetRepo,
+	}
+}
+
+func NewCreatePromptVersionUseCase(projectService *services.ProjectService, promptService *services.PromptService) in.CreatePromptVersionUseCase {
+	return &use_cases.CreatePromptVersionUseCaseImpl{
+		ProjectService: projectService,
+		PromptService:  promptService,
+	}
+}
+
+func NewGetPromptDiffUseCase(
+	projectService *services.ProjectService,
+	promptService *services.PromptService,
+	promptRepo persistencePort.PromptRepository,
+) in.GetPromptDiffUseCase {
+	return &use_cases.GetPromptDiffUseCaseImpl{
+		ProjectService:   projectService,
+		PromptService:    promptService,
+		PromptRepository: promptRepo,
+	}
+}
+
+func NewListPromptsController(listPromptsUseCase in.ListPromptsUseCase) *web.ListPromptsController {
+	return &web.ListPromptsController{
+		ListPromptsUseCase: listPromptsUseCase,
+	}
+}
+
+func NewCreatePromptVersionController(createPromptVersionUseCase in.CreatePromptVersionUseCase) *web.CreatePromptVersionController {
+	return &web.CreatePromptVersionController{
+		CreatePromptVersionUseCase: createPromptVersionUseCase,
+	}
+}
+
+func NewGetPromptDiffController(getPromptDiffUseCase in.GetPromptDiffUseCase) *web.GetPromptDiffController {
+	return &web.GetPromptDiffController{
+		GetPromptDiffUseCase: getPromptDiffUseCase,
+	}
+}
+
+func NewGenerateMoreTrainingDatasetController(generateMoreTrainingDatasetUseCase in.GenerateMoreTrainingDatasetUseCase) *web.GenerateMoreTrainingDatasetController {
+	return &web.GenerateMoreTrainingDatasetController{
+		GenerateMoreTrainingDatasetUseCase: generateMoreTrainingDatasetUseCase,
+	}
+}
+
 func NewUpdateFinetuneStatusUseCase(
 	finetuneRepo persistencePort.FinetuneRepository,
+	statusTransitionService *services.StatusTransitionService,
+	finetuneService *services.FinetuneService,
+	finetuneArtifactClient clientsPort.FinetuneArtifactClient,
 ) in.UpdateFinetuneStatusUseCase {
 	return &use_cases.UpdateFinetuneStatusUseCaseImpl{
-		FinetuneRepository: finetuneRepo,
+		FinetuneRepository:      finetuneRepo,
+		StatusTransitionService: statusTransitionService,
+		FinetuneService:         finetuneService,
+		FinetuneArtifactClient:  finetuneArtifactClient,
 	}
 }
 
@@ -261,10 +635,99 @@ func NewUpdateFinetuneStatusController(updateFinetuneStatusUseCase in.UpdateFine
 	}
 }
 
-func NewGetFinetuneUseCase(finetuneRepo persistencePort.FinetuneRepository, deploymentRepo persistencePort.DeploymentRepository) in.GetFinetuneUseCase {
+func NewUpdateFinetuneMetricsUseCase(
+	finetuneMetricsService *services.FinetuneMetricsService,
+	finetuneRepo persistencePort.FinetuneRepository,
+	finetuneMetricRepo persistencePort.FinetuneMetricRepository,
+) in.UpdateFinetuneMetricsUseCase {
+	return &use_cases.UpdateFinetuneMetricsUseCaseImpl{
+		FinetuneMetricsService:   finetuneMetricsService,
+		FinetuneRepository:       finetuneRepo,
+		FinetuneMetricRepository: finetuneMetricRepo,
+	}
+}
+
+func NewUpdateFinetuneMetricsController(updateFinetuneMetricsUseCase in.UpdateFinetuneMetricsUseCase) *web.UpdateFinetuneMetricsController {
+	return &web.UpdateFinetuneMetricsController{
+		UpdateFinetuneMetricsUseCase: updateFinetuneMetricsUseCase,
+	}
+}
+
+func NewGetFinetuneMetricsUseCase(
+	projectService *services.ProjectService,
+	finetuneRepo persistencePort.FinetuneRepository,
+	finetuneMetricRepo persistencePort.FinetuneMetricRepository,
+) in.GetFinetuneMetricsUseCase {
+	return &use_cases.GetFinetuneMetricsUseCaseImpl{
+		ProjectService:           projectService,
+		FinetuneRepository:       finetuneRepo,
+		FinetuneMetricRepository: finetuneMetricRepo,
+	}
+}
+
+func NewGetFinetuneMetricsController(getFinetuneMetricsUseCase in.GetFinetuneMetricsUseCase) *web.GetFinetuneMetricsController {
+	return &web.GetFinetuneMetricsController{
+		GetFinetuneMetricsUseCase: getFinetuneMetricsUseCase,
+	}
+}
+
+func NewAbortFinetuneUseCase(
+	projectService *services.ProjectService,
+	statusTransitionService *services.StatusTransitionService,
+	finetuneRepo persistencePort.FinetuneRepository,
+	finetuneBackends clientsPort.FinetuneBackends,
+	finetuneDispatchEventRepo persistencePort.FinetuneDispatchEventRepository,
+) in.AbortFinetuneUseCase {
+	return &use_cases.AbortFinetuneUseCaseImpl{
+		ProjectService:                  projectService,
+		StatusTransitionService:         statusTransitionService,
+		FinetuneRepository:              finetuneRepo,
+		FinetuneBackends:                finetuneBackends,
+		FinetuneDispatchEventRepository: finetuneDispatchEventRepo,
+	}
+}
+
+func NewAbortFinetuneController(abortFinetuneUseCase in.AbortFinetuneUseCase) *web.AbortFinetuneController {
+	return &web.AbortFinetuneController{
+		AbortFinetuneUseCase: abortFinetuneUseCase,
+	}
+}
+
+func NewDispatchOutboxJobsUseCase(
+	outboxService *services.OutboxService,
+	statusTransitionService *services.StatusTransitionService,
+	outboxJobRepo persistencePort.OutboxJobRepository,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	finetuneRepo persistencePort.FinetuneRepository,
+	trainingDatasetJobClient clientsPort.TrainingDatasetJobClient,
+	finetuneJobClient clientsPort.FinetuneJobClient,
+	finetuneBackends clientsPort.FinetuneBackends,
+	finetuneDispatchEventRepo persistencePort.FinetuneDispatchEventRepository,
+) in.DispatchOutboxJobsUseCase {
+	return &use_cases.DispatchOutboxJobsUseCaseImpl{
+		OutboxService:                   outboxService,
+		StatusTransitionService:         statusTransitionService,
+		OutboxJobRepository:             outboxJobRepo,
+		TrainingDatasetRepository:       trainingDatasetRepo,
+		FinetuneRepository:              finetuneRepo,
+		TrainingDatasetJobClient:        trainingDatasetJobClient,
+		FinetuneJobClient:               finetuneJobClient,
+		FinetuneBackends:                finetuneBackends,
+		FinetuneDispatchEventRepository: finetuneDispatchEventRepo,
+	}
+}
+
+func NewGetFinetuneUseCase(
+	finetuneRepo persistencePort.FinetuneRepository,
+	deploymentRepo persistencePort.DeploymentRepository,
+	finetuneDispatchEventRepo persistencePort.FinetuneDispatchEventRepository,
+	finetuneQueueService *services.FinetuneQueueService,
+) in.GetFinetuneUseCase {
 	return &use_cases.GetFinetuneUseCaseImpl{
-		FinetuneRepository:   finetuneRepo,
-		DeploymentRepository: deploymentRepo,
+		FinetuneRepository:              finetuneRepo,
+		DeploymentRepository:            deploymentRepo,
+		FinetuneDispatchEventRepository: finetuneDispatchEventRepo,
+		FinetuneQueueService:            finetuneQueueService,
 	}
 }
 
@@ -329,13 +792,299 @@ func NewDownloadTrainingDatasetController(downloadTrainingDatasetUseCase in.Down
 	}
 }
 
+func NewListTrainingDataItemsUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetService *services.TrainingDatasetService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+) in.ListTrainingDataItemsUseCase {
+	return &use_cases.ListTrainingDataItemsUseCaseImpl{
+		ProjectService:            projectService,
+		TrainingDatasetService:    trainingDatasetService,
+		TrainingDatasetRepository: trainingDatasetRepo,
+	}
+}
+
+func NewListTrainingDataItemsController(listTrainingDataItemsUseCase in.ListTrainingDataItemsUseCase) *web.ListTrainingDataItemsController {
+	return &web.ListTrainingDataItemsController{
+		ListTrainingDataItemsUseCase: listTrainingDataItemsUseCase,
+	}
+}
+
+func NewGetTrainingDataItemUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+) in.GetTrainingDataItemUseCase {
+	return &use_cases.GetTrainingDataItemUseCaseImpl{
+		ProjectService:            projectService,
+		TrainingDatasetRepository: trainingDatasetRepo,
+	}
+}
+
+func NewGetTrainingDataItemController(getTrainingDataItemUseCase in.GetTrainingDataItemUseCase) *web.GetTrainingDataItemController {
+	return &web.GetTrainingDataItemController{
+		GetTrainingDataItemUseCase: getTrainingDataItemUseCase,
+	}
+}
+
+func NewEditTrainingDataItemUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetService *services.TrainingDatasetService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+) in.EditTrainingDataItemUseCase {
+	return &use_cases.EditTrainingDataItemUseCaseImpl{
+		ProjectService:            projectService,
+		TrainingDatasetService:    trainingDatasetService,
+		TrainingDatasetRepository: trainingDatasetRepo,
+	}
+}
+
+func NewEditTrainingDataItemController(editTrainingDataItemUseCase in.EditTrainingDataItemUseCase) *web.EditTrainingDataItemController {
+	return &web.EditTrainingDataItemController{
+		EditTrainingDataItemUseCase: editTrainingDataItemUseCase,
+	}
+}
+
+func NewDeleteTrainingDataItemUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+) in.DeleteTrainingDataItemUseCase {
+	return &use_cases.DeleteTrainingDataItemUseCaseImpl{
+		ProjectService:            projectService,
+		TrainingDatasetRepository: trainingDatasetRepo,
+	}
+}
+
+func NewDeleteTrainingDataItemController(deleteTrainingDataItemUseCase in.DeleteTrainingDataItemUseCase) *web.DeleteTrainingDataItemController {
+	return &web.DeleteTrainingDataItemController{
+		DeleteTrainingDataItemUseCase: deleteTrainingDataItemUseCase,
+	}
+}
+
+func NewRestoreTrainingDataItemUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+) in.RestoreTrainingDataItemUseCase {
+	return &use_cases.RestoreTrainingDataItemUseCaseImpl{
+		ProjectService:            projectService,
+		TrainingDatasetRepository: trainingDatasetRepo,
+	}
+}
+
+func NewRestoreTrainingDataItemController(restoreTrainingDataItemUseCase in.RestoreTrainingDataItemUseCase) *web.RestoreTrainingDataItemController {
+	return &web.RestoreTrainingDataItemController{
+		RestoreTrainingDataItemUseCase: restoreTrainingDataItemUseCase,
+	}
+}
+
+func NewGenerateTrainingDatasetSplitsUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetService *services.TrainingDatasetService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+) in.GenerateTrainingDatasetSplitsUseCase {
+	return &use_cases.GenerateTrainingDatasetSplitsUseCaseImpl{
+		ProjectService:            projectService,
+		TrainingDatasetService:    trainingDatasetService,
+		TrainingDatasetRepository: trainingDatasetRepo,
+	}
+}
+
+func NewGenerateTrainingDatasetSplitsController(generateTrainingDatasetSplitsUseCase in.GenerateTrainingDatasetSplitsUseCase) *web.GenerateTrainingDatasetSplitsController {
+	return &web.GenerateTrainingDatasetSplitsController{
+		GenerateTrainingDatasetSplitsUseCase: generateTrainingDatasetSplitsUseCase,
+	}
+}
+
+func NewDeduplicateTrainingDatasetUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetService *services.TrainingDatasetService,
+	trainingDataDeduplicationService *services.TrainingDataDeduplicationService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+) in.DeduplicateTrainingDatasetUseCase {
+	return &use_cases.DeduplicateTrainingDatasetUseCaseImpl{
+		ProjectService:                   projectService,
+		TrainingDatasetService:           trainingDatasetService,
+		TrainingDataDeduplicationService: trainingDataDeduplicationService,
+		TrainingDatasetRepository:        trainingDatasetRepo,
+	}
+}
+
+func NewDeduplicateTrainingDatasetController(deduplicateTrainingDatasetUseCase in.DeduplicateTrainingDatasetUseCase) *web.DeduplicateTrainingDatasetController {
+	return &web.DeduplicateTrainingDatasetController{
+		DeduplicateTrainingDatasetUseCase: deduplicateTrainingDatasetUseCase,
+	}
+}
+
+func NewGetTrainingDatasetDiffUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetService *services.TrainingDatasetService,
+	trainingDatasetDiffService *services.TrainingDatasetDiffService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+) in.GetTrainingDatasetDiffUseCase {
+	return &use_cases.GetTrainingDatasetDiffUseCaseImpl{
+		ProjectService:             projectService,
+		TrainingDatasetService:     trainingDatasetService,
+		TrainingDatasetDiffService: trainingDatasetDiffService,
+		TrainingDatasetRepository:  trainingDatasetRepo,
+	}
+}
+
+func NewGetTrainingDatasetDiffController(getTrainingDatasetDiffUseCase in.GetTrainingDatasetDiffUseCase) *web.GetTrainingDatasetDiffController {
+	return &web.GetTrainingDatasetDiffController{
+		GetTrainingDatasetDiffUseCase: getTrainingDatasetDiffUseCase,
+	}
+}
+
+func NewScoreTrainingDatasetUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetService *services.TrainingDatasetService,
+	trainingDataQualityService *services.TrainingDataQualityService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	qualityScoringJobRepo persistencePort.QualityScoringJobRepository,
+) in.ScoreTrainingDatasetUseCase {
+	return &use_cases.ScoreTrainingDatasetUseCaseImpl{
+		ProjectService:              projectService,
+		TrainingDatasetService:      trainingDatasetService,
+		TrainingDataQualityService:  trainingDataQualityService,
+		TrainingDatasetRepository:   trainingDatasetRepo,
+		QualityScoringJobRepository: qualityScoringJobRepo,
+	}
+}
+
+func NewRunQualityScoringJobUseCase(
+	trainingDatasetService *services.TrainingDatasetService,
+	trainingDataQualityService *services.TrainingDataQualityService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	qualityScoringJobRepo persistencePort.QualityScoringJobRepository,
+) in.RunQualityScoringJobUseCase {
+	return &use_cases.RunQualityScoringJobUseCaseImpl{
+		TrainingDatasetService:      trainingDatasetService,
+		TrainingDataQualityService:  trainingDataQualityService,
+		TrainingDatasetRepository:   trainingDatasetRepo,
+		QualityScoringJobRepository: qualityScoringJobRepo,
+	}
+}
+
+func NewScoreTrainingDatasetController(scoreTrainingDatasetUseCase in.ScoreTrainingDatasetUseCase) *web.ScoreTrainingDatasetController {
+	return &web.ScoreTrainingDatasetController{
+		ScoreTrainingDatasetUseCase: scoreTrainingDatasetUseCase,
+	}
+}
+
+func NewGetTrainingDatasetQualityScoresUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetService *services.TrainingDatasetService,
+	trainingDataQualityService *services.TrainingDataQualityService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	qualityScoringJobRepo persistencePort.QualityScoringJobRepository,
+) in.GetTrainingDatasetQualityScoresUseCase {
+	return &use_cases.GetTrainingDatasetQualityScoresUseCaseImpl{
+		ProjectService:              projectService,
+		TrainingDatasetService:      trainingDatasetService,
+		TrainingDataQualityService:  trainingDataQualityService,
+		TrainingDatasetRepository:   trainingDatasetRepo,
+		QualityScoringJobRepository: qualityScoringJobRepo,
+	}
+}
+
+func NewGetTrainingDatasetQualityScoresController(getTrainingDatasetQualityScoresUseCase in.GetTrainingDatasetQualityScoresUseCase) *web.GetTrainingDatasetQualityScoresController {
+	return &web.GetTrainingDatasetQualityScoresController{
+		GetTrainingDatasetQualityScoresUseCase: getTrainingDatasetQualityScoresUseCase,
+	}
+}
+
+func NewGetTrainingDatasetStatsUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetStatsService *services.TrainingDatasetStatsService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+) in.GetTrainingDatasetStatsUseCase {
+	return &use_cases.GetTrainingDatasetStatsUseCaseImpl{
+		ProjectService:              projectService,
+		TrainingDatasetStatsService: trainingDatasetStatsService,
+		TrainingDatasetRepository:   trainingDatasetRepo,
+	}
+}
+
+func NewGetTrainingDatasetStatsController(getTrainingDatasetStatsUseCase in.GetTrainingDatasetStatsUseCase) *web.GetTrainingDatasetStatsController {
+	return &web.GetTrainingDatasetStatsController{
+		GetTrainingDatasetStatsUseCase: getTrainingDatasetStatsUseCase,
+	}
+}
+
+func NewEstimateTrainingDatasetUseCase(
+	projectService *services.ProjectService,
+	corpusService *services.CorpusService,
+	corpusChunkingService *services.CorpusChunkingService,
+	trainingDatasetEstimateService *services.TrainingDatasetEstimateService,
+	corpusRepo persistencePort.CorpusRepository,
+	corpusDocumentRepo persistencePort.CorpusDocumentRepository,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+) in.EstimateTrainingDatasetUseCase {
+	return &use_cases.EstimateTrainingDatasetUseCaseImpl{
+		ProjectService:                 projectService,
+		CorpusService:                  corpusService,
+		CorpusChunkingService:          corpusChunkingService,
+		TrainingDatasetEstimateService: trainingDatasetEstimateService,
+		CorpusRepository:               corpusRepo,
+		CorpusDocumentRepository:       corpusDocumentRepo,
+		TrainingDatasetRepository:      trainingDatasetRepo,
+	}
+}
+
+func NewEstimateTrainingDatasetController(estimateTrainingDatasetUseCase in.EstimateTrainingDatasetUseCase) *web.EstimateTrainingDatasetController {
+	return &web.EstimateTrainingDatasetController{
+		EstimateTrainingDatasetUseCase: estimateTrainingDatasetUseCase,
+	}
+}
+
+func NewScanTrainingDatasetPIIUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetService *services.TrainingDatasetService,
+	piiService *services.PIIService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	piiReportRepo persistencePort.PIIReportRepository,
+) in.ScanTrainingDatasetPIIUseCase {
+	return &use_cases.ScanTrainingDatasetPIIUseCaseImpl{
+		ProjectService:            projectService,
+		TrainingDatasetService:    trainingDatasetService,
+		PIIService:                piiService,
+		TrainingDatasetRepository: trainingDatasetRepo,
+		PIIReportRepository:       piiReportRepo,
+	}
+}
+
+func NewScanTrainingDatasetPIIController(scanTrainingDatasetPIIUseCase in.ScanTrainingDatasetPIIUseCase) *web.ScanTrainingDatasetPIIController {
+	return &web.ScanTrainingDatasetPIIController{
+		ScanTrainingDatasetPIIUseCase: scanTrainingDatasetPIIUseCase,
+	}
+}
+
+func NewListTrainingDatasetPIIReportsUseCase(
+	projectService *services.ProjectService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	piiReportRepo persistencePort.PIIReportRepository,
+) in.ListTrainingDatasetPIIReportsUseCase {
+	return &use_cases.ListTrainingDatasetPIIReportsUseCaseImpl{
+		ProjectService:            projectService,
+		TrainingDatasetRepository: trainingDatasetRepo,
+		PIIReportRepository:       piiReportRepo,
+	}
+}
+
+func NewListTrainingDatasetPIIReportsController(listTrainingDatasetPIIReportsUseCase in.ListTrainingDatasetPIIReportsUseCase) *web.ListTrainingDatasetPIIReportsController {
+	return &web.ListTrainingDatasetPIIReportsController{
+		ListTrainingDatasetPIIReportsUseCase: listTrainingDatasetPIIReportsUseCase,
+	}
+}
+
 func NewUploadTrainingDatasetUseCase(
 	trainingDatasetService *services.TrainingDatasetService,
+	trainingDatasetImportService *services.TrainingDatasetImportService,
 	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
 ) in.UploadTrainingDatasetUseCase {
 	return &use_cases.UploadTrainingDatasetUseCaseImpl{
-		TrainingDatasetService:    trainingDatasetService,
-		TrainingDatasetRepository: trainingDatasetRepo,
+		TrainingDatasetService:       trainingDatasetService,
+		TrainingDatasetImportService: trainingDatasetImportService,
+		TrainingDatasetRepository:    trainingDatasetRepo,
 	}
 }
 
@@ -347,11 +1096,13 @@ func NewUploadTrainingDatasetController(uploadTrainingDatasetUseCase in.UploadTr
 
 func NewUploadNewTrainingDatasetVersionUseCase(
 	trainingDatasetService *services.TrainingDatasetService,
+	trainingDatasetImportService *services.TrainingDatasetImportService,
 	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
 ) in.UploadNewTrainingDatasetVersionUseCase {
 	return &use_cases.UploadNewTrainingDatasetVersionUseCaseImpl{
-		TrainingDatasetService:    trainingDatasetService,
-		TrainingDatasetRepository: trainingDatasetRepo,
+		TrainingDatasetService:       trainingDatasetService,
+		TrainingDatasetImportService: trainingDatasetImportService,
+		TrainingDatasetRepository:    trainingDatasetRepo,
 	}
 }
 
@@ -394,17 +1145,19 @@ func NewGetDeploymentUseCase(deploymentRepo persistencePort.DeploymentRepository
 	}
 }
 
-func NewPublicCompletionUseCase(ollamaLLMClient clientsPort.OllamaLLMClient, deploymentLogsRepo persistencePort.DeploymentLogsRepository) in.PublicCompletionUseCase {
+func NewPublicCompletionUseCase(ollamaLLMClient clientsPort.OllamaLLMClient, deploymentLogsRepo persistencePort.DeploymentLogsRepository, piiService *services.PIIService) in.PublicCompletionUseCase {
 	return &use_cases.PublicCompletionUseCaseImpl{
 		OllamaLLMClient:          ollamaLLMClient,
 		DeploymentLogsRepository: deploymentLogsRepo,
+		PIIService:               piiService,
 	}
 }
 
-func NewPublicChatCompletionUseCase(ollamaLLMClient clientsPort.OllamaLLMClient, deploymentLogsRepo persistencePort.DeploymentLogsRepository) in.PublicChatCompletionUseCase {
+func NewPublicChatCompletionUseCase(ollamaLLMClient clientsPort.OllamaLLMClient, deploymentLogsRepo persistencePort.DeploymentLogsRepository, piiService *services.PIIService) in.PublicChatCompletionUseCase {
 	return &use_cases.PublicChatCompletionUseCaseImpl{
 		OllamaLLMClient:          ollamaLLMClient,
 		DeploymentLogsRepository: deploymentLogsRepo,
+		PIIService:               piiService,
 	}
 }
 
@@ -462,6 +1215,173 @@ func NewExternalAPIMiddleware() *server.ExternalAPIMiddleware {
 	return &server.ExternalAPIMiddleware{}
 }
 
+func NewCreateCorpusUseCase(corpusRepo persistencePort.CorpusRepository, corpusService *services.CorpusService, projectService *services.ProjectService) in.CreateCorpusUseCase {
+	return &use_cases.CreateCorpusUseCaseImpl{
+		CorpusRepository: corpusRepo,
+		CorpusService:    corpusService,
+		ProjectService:   projectService,
+	}
+}
+
+func NewCreateCorpusController(createCorpusUseCase in.CreateCorpusUseCase) *web.CreateCorpusController {
+	return &web.CreateCorpusController{
+		CreateCorpusUseCase: createCorpusUseCase,
+	}
+}
+
+func NewListCorporaUseCase(corpusRepo persistencePort.CorpusRepository, projectService *services.ProjectService) in.ListCorporaUseCase {
+	return &use_cases.ListCorporaUseCaseImpl{
+		CorpusRepository: corpusRepo,
+		ProjectService:   projectService,
+	}
+}
+
+func NewListCorporaController(listCorporaUseCase in.ListCorporaUseCase) *web.ListCorporaController {
+	return &web.ListCorporaController{
+		ListCorporaUseCase: listCorporaUseCase,
+	}
+}
+
+func NewGetCorpusUseCase(corpusService *services.CorpusService, corpusDocumentRepo persistencePort.CorpusDocumentRepository) in.GetCorpusUseCase {
+	return &use_cases.GetCorpusUseCaseImpl{
+		CorpusService:            corpusService,
+		CorpusDocumentRepository: corpusDocumentRepo,
+	}
+}
+
+func NewGetCorpusController(getCorpusUseCase in.GetCorpusUseCase) *web.GetCorpusController {
+	return &web.GetCorpusController{
+		GetCorpusUseCase: getCorpusUseCase,
+	}
+}
+
+func NewUploadCorpusDocumentUseCase(
+	corpusService *services.CorpusService,
+	corpusDocumentRepo persistencePort.CorpusDocumentRepository,
+	corpusStorageClient clientsPort.CorpusStorageClient,
+) in.UploadCorpusDocumentUseCase {
+	return &use_cases.UploadCorpusDocumentUseCaseImpl{
+		CorpusService:            corpusService,
+		CorpusDocumentRepository: corpusDocumentRepo,
+		CorpusStorageClient:      corpusStorageClient,
+	}
+}
+
+func NewUploadCorpusDocumentController(uploadCorpusDocumentUseCase in.UploadCorpusDocumentUseCase) *web.UploadCorpusDocumentController {
+	return &web.UploadCorpusDocumentController{
+		UploadCorpusDocumentUseCase: uploadCorpusDocumentUseCase,
+	}
+}
+
+func NewDeleteCorpusDocumentUseCase(
+	corpusRepo persistencePort.CorpusRepository,
+	corpusService *services.CorpusService,
+	corpusDocumentRepo persistencePort.CorpusDocumentRepository,
+	corpusStorageClient clientsPort.CorpusStorageClient,
+) in.DeleteCorpusDocumentUseCase {
+	return &use_cases.DeleteCorpusDocumentUseCaseImpl{
+		CorpusRepository:         corpusRepo,
+		CorpusService:            corpusService,
+		CorpusDocumentRepository: corpusDocumentRepo,
+		CorpusStorageClient:      corpusStorageClient,
+	}
+}
+
+func NewDeleteCorpusDocumentController(deleteCorpusDocumentUseCase in.DeleteCorpusDocumentUseCase) *web.DeleteCorpusDocumentController {
+	return &web.DeleteCorpusDocumentController{
+		DeleteCorpusDocumentUseCase: deleteCorpusDocumentUseCase,
+	}
+}
+
+func NewUpdateCorpusFilesSubsetUseCase(
+	corpusRepo persistencePort.CorpusRepository,
+	corpusService *services.CorpusService,
+	corpusDocumentRepo persistencePort.CorpusDocumentRepository,
+) in.UpdateCorpusFilesSubsetUseCase {
+	return &use_cases.UpdateCorpusFilesSubsetUseCaseImpl{
+		CorpusRepository:         corpusRepo,
+		CorpusService:            corpusService,
+		CorpusDocumentRepository: corpusDocumentRepo,
+	}
+}
+
+func NewUpdateCorpusFilesSubsetController(updateCorpusFilesSubsetUseCase in.UpdateCorpusFilesSubsetUseCase) *web.UpdateCorpusFilesSubsetController {
+	return &web.UpdateCorpusFilesSubsetController{
+		UpdateCorpusFilesSubsetUseCase: updateCorpusFilesSubsetUseCase,
+	}
+}
+
+func NewSyncCorpusUseCase(
+	corpusRepo persistencePort.CorpusRepository,
+	corpusService *services.CorpusService,
+	corpusDocumentRepo persistencePort.CorpusDocumentRepository,
+	corpusStorageClient clientsPort.CorpusStorageClient,
+) in.SyncCorpusUseCase {
+	return &use_cases.SyncCorpusUseCaseImpl{
+		CorpusRepository:         corpusRepo,
+		CorpusService:            corpusService,
+		CorpusDocumentRepository: corpusDocumentRepo,
+		CorpusStorageClient:      corpusStorageClient,
+	}
+}
+
+func NewSyncCorpusController(syncCorpusUseCase in.SyncCorpusUseCase) *web.SyncCorpusController {
+	return &web.SyncCorpusController{
+		SyncCorpusUseCase: syncCorpusUseCase,
+	}
+}
+
+func NewPreviewCorpusChunksUseCase(
+	corpusService *services.CorpusService,
+	corpusChunkingService *services.CorpusChunkingService,
+	corpusDocumentRepo persistencePort.CorpusDocumentRepository,
+) in.PreviewCorpusChunksUseCase {
+	return &use_cases.PreviewCorpusChunksUseCaseImpl{
+		CorpusService:            corpusService,
+		CorpusChunkingService:    corpusChunkingService,
+		CorpusDocumentRepository: corpusDocumentRepo,
+	}
+}
+
+func NewPreviewCorpusChunksController(previewCorpusChunksUseCase in.PreviewCorpusChunksUseCase) *web.PreviewCorpusChunksController {
+	return &web.PreviewCorpusChunksController{
+		PreviewCorpusChunksUseCase: previewCorpusChunksUseCase,
+	}
+}
+
+func NewChunkCorpusUseCase(corpusService *services.CorpusService, corpusChunkingService *services.CorpusChunkingService) in.ChunkCorpusUseCase {
+	return &use_cases.ChunkCorpusUseCaseImpl{
+		CorpusService:         corpusService,
+		CorpusChunkingService: corpusChunkingService,
+	}
+}
+
+func NewChunkCorpusController(chunkCorpusUseCase in.ChunkCorpusUseCase) *web.ChunkCorpusController {
+	return &web.ChunkCorpusController{
+		ChunkCorpusUseCase: chunkCorpusUseCase,
+	}
+}
+
+func NewGetTrainingDataItemChunkUseCase(
+	projectService *services.ProjectService,
+	corpusChunkingService *services.CorpusChunkingService,
+	trainingDatasetRepo persistencePort.TrainingDatasetRepository,
+	corpusChunkRepo persistencePort.CorpusChunkRepository,
+) in.GetTrainingDataItemChunkUseCase {
+	return &use_cases.GetTrainingDataItemChunkUseCaseImpl{
+		ProjectService:            projectService,
+		CorpusChunkingService:     corpusChunkingService,
+		TrainingDatasetRepository: trainingDatasetRepo,
+		CorpusChunkRepository:     corpusChunkRepo,
+	}
+}
+
+func NewGetTrainingDataItemChunkController(getTrainingDataItemChunkUseCase in.GetTrainingDataItemChunkUseCase) *web.GetTrainingDataItemChunkController {
+	return &web.GetTrainingDataItemChunkController{
+		GetTrainingDataItemChunkUseCase: getTrainingDataItemChunkUseCase,
+	}
+}
+
 func NewTrainingDatasetJobClient() clientsPort.TrainingDatasetJobClient {
 	client, err := clients.NewTrainingDatasetJobClientImpl()
 	if err != nil {
@@ -470,6 +1390,14 @@ func NewTrainingDatasetJobClient() clientsPort.TrainingDatasetJobClient {
 	return client
 }
 
+func NewTrainingDatasetJobQueueClient() clientsPort.TrainingDatasetJobQueueClient {
+	client, err := clients.NewTrainingDatasetJobClientImpl()
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
 func NewTrainingDatasetResultsClient() clientsPort.TrainingDatasetResultsClient {
 	client, err := clients.NewTrainingDatasetResultsClientImpl()
 	if err != nil {
@@ -478,6 +1406,14 @@ func NewTrainingDatasetResultsClient() clientsPort.TrainingDatasetResultsClient
 	return client
 }
 
+func NewCorpusStorageClient() clientsPort.CorpusStorageClient {
+	client, err := clients.NewCorpusStorageClientImpl()
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
 func NewFinetuneJobClient() clientsPort.FinetuneJobClient {
 	client, err := clients.NewFinetuneJobClientImpl()
 	if err != nil {
@@ -486,12 +1422,45 @@ func NewFinetuneJobClient() clientsPort.FinetuneJobClient {
 	return client
 }
 
-func NewRunpodClient() clientsPort.RunpodClient {
-	client, err := clients.NewRunpodClientImpl()
+// NewFinetuneBackends only builds the backends enabled in FINETUNE_BACKENDS, so environments without Runpod
+// credentials can train with the local backend
+func NewFinetuneBackends() clientsPort.FinetuneBackends {
+	defaultBackend := entities.FinetuneBackendType(strings.ToUpper(os.Getenv("FINETUNE_BACKEND")))
+	if defaultBackend == "" {
+		defaultBackend = entities.FinetuneBackendRunpod
+	}
+
+	enabled := []entities.FinetuneBackendType{defaultBackend}
+	if value := os.Getenv("FINETUNE_BACKENDS"); value != "" {
+		enabled = nil
+		for _, name := range strings.Split(value, ",") {
+			enabled = append(enabled, entities.FinetuneBackendType(strings.ToUpper(strings.TrimSpace(name))))
+		}
+	}
+
+	backends := make(map[entities.FinetuneBackendType]clientsPort.FinetuneBackend)
+	for _, backendType := range enabled {
+		var backend clientsPort.FinetuneBackend
+		var err error
+		switch backendType {
+		case entities.FinetuneBackendRunpod:
+			backend, err = clients.NewRunpodClientImpl()
+		case entities.FinetuneBackendLocal:
+			backend, err = clients.NewLocalFinetuneBackendImpl()
+		default:
+			err = fmt.Errorf("unknown finetune backend '%s'", backendType)
+		}
+		if err != nil {
+			panic(err)
+		}
+		backends[backendType] = backend
+	}
+
+	finetuneBackends, err := clients.NewFinetuneBackendsImpl(backends, defaultBackend)
 	if err != nil {
 		panic(err)
 	}
-	return client
+	return finetuneBackends
 }
 
 func NewDownloadModelClient() clientsPort.DownloadModelClient {
@@ -502,7 +1471,24 @@ func NewDownloadModelClient() clientsPort.DownloadModelClient {
 	return client
 }
 
+func NewFinetuneArtifactClient() clientsPort.FinetuneArtifactClient {
+	client, err := clients.NewFinetuneArtifactClientImpl()
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
 func NewOllamaLLMClient() clientsPort.OllamaLLMClient {
+	// A local OpenAI-compatible server replaces Runpod when it is configured
+	if os.Getenv("OPENAI_COMPATIBLE_BASE_URL") != "" {
+		client, err := clients.NewOpenAICompatibleLLMClientImpl()
+		if err != nil {
+			panic(err)
+		}
+		return client
+	}
+
 	client, err := clients.NewOllamaLLMClientImpl()
 	if err != nil {
 		panic(err)
@@ -510,6 +1496,93 @@ func NewOllamaLLMClient() clientsPort.OllamaLLMClient {
 	return client
 }
 
+func NewTrainingDatasetWorker(trainingDatasetJobQueueClient clientsPort.TrainingDatasetJobQueueClient, runTrainingDatasetJobUseCase in.RunTrainingDatasetJobUseCase) *worker.TrainingDatasetWorker {
+	pollInterval := worker.DefaultPollInterval
+	if value := os.Getenv("TRAINING_DATASET_WORKER_POLL_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			panic(err)
+		}
+		pollInterval = parsed
+	}
+
+	return &worker.TrainingDatasetWorker{
+		TrainingDatasetJobQueueClient: trainingDatasetJobQueueClient,
+		RunTrainingDatasetJobUseCase:  runTrainingDatasetJobUseCase,
+		PollInterval:                  pollInterval,
+	}
+}
+
+func NewScheduleFinetunesUseCase(finetuneQueueService *services.FinetuneQueueService, finetuneRepo persistencePort.FinetuneRepository) in.ScheduleFinetunesUseCase {
+	return &use_cases.ScheduleFinetunesUseCaseImpl{
+		FinetuneQueueService: finetuneQueueService,
+		FinetuneRepository:   finetuneRepo,
+	}
+}
+
+// NewFinetuneScheduler reads the concurrency limits, 0 disables a limit
+func NewFinetuneScheduler(scheduleFinetunesUseCase in.ScheduleFinetunesUseCase) *worker.FinetuneScheduler {
+	pollInterval := worker.DefaultFinetuneSchedulerPollInterval
+	if value := os.Getenv("FINETUNE_SCHEDULER_POLL_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			panic(err)
+		}
+		pollInterval = parsed
+	}
+
+	limit := func(name string, defaultValue int) int {
+		value := os.Getenv(name)
+		if value == "" {
+			return defaultValue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			panic(fmt.Sprintf("%s must be a number of finetunes, 0 disables the limit", name))
+		}
+		return parsed
+	}
+
+	return &worker.FinetuneScheduler{
+		ScheduleFinetunesUseCase: scheduleFinetunesUseCase,
+		PollInterval:             pollInterval,
+		MaxConcurrent:            limit("FINETUNE_MAX_CONCURRENT", worker.DefaultFinetuneMaxConcurrent),
+		MaxConcurrentPerUser:     limit("FINETUNE_MAX_CONCURRENT_PER_USER", worker.DefaultFinetuneMaxConcurrentPerUser),
+	}
+}
+
+func NewQualityScoringWorker(runQualityScoringJobUseCase in.RunQualityScoringJobUseCase) *worker.QualityScoringWorker {
+	pollInterval := worker.DefaultQualityScoringPollInterval
+	if value := os.Getenv("QUALITY_SCORING_WORKER_POLL_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			panic(err)
+		}
+		pollInterval = parsed
+	}
+
+	return &worker.QualityScoringWorker{
+		RunQualityScoringJobUseCase: runQualityScoringJobUseCase,
+		PollInterval:                pollInterval,
+	}
+}
+
+func NewOutboxDispatcher(dispatchOutboxJobsUseCase in.DispatchOutboxJobsUseCase) *worker.OutboxDispatcher {
+	pollInterval := worker.DefaultOutboxPollInterval
+	if value := os.Getenv("OUTBOX_DISPATCHER_POLL_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			panic(err)
+		}
+		pollInterval = parsed
+	}
+
+	return &worker.OutboxDispatcher{
+		DispatchOutboxJobsUseCase: dispatchOutboxJobsUseCase,
+		PollInterval:              pollInterval,
+	}
+}
+
 func NewAuthMiddleware(jwtService *services.JWTService) *server.AuthMiddleware {
 	return &server.AuthMiddleware{
 		JwtService: jwtService,
@@ -521,21 +1594,48 @@ var Module = fx.Options(
 	fx.Provide(NewProjectRepository),
 	fx.Provide(NewTrainingDatasetRepository),
 	fx.Provide(NewCorpusRepository),
+	fx.Provide(NewCorpusDocumentRepository),
+	fx.Provide(NewCorpusChunkRepository),
+	fx.Provide(NewTrainingDatasetProgressRepository),
+	fx.Provide(NewOutboxJobRepository),
+	fx.Provide(NewQualityScoringJobRepository),
 	fx.Provide(NewPromptRepository),
 	fx.Provide(NewFinetuneRepository),
+	fx.Provide(NewFinetuneMetricRepository),
+	fx.Provide(NewFinetuneDispatchEventRepository),
 	fx.Provide(NewDeploymentRepository),
 	fx.Provide(NewDeploymentLogsRepository),
+	fx.Provide(NewPIIReportRepository),
 	fx.Provide(NewTrainingDatasetJobClient),
+	fx.Provide(NewTrainingDatasetJobQueueClient),
 	fx.Provide(NewTrainingDatasetResultsClient),
+	fx.Provide(NewCorpusStorageClient),
 	fx.Provide(NewFinetuneJobClient),
-	fx.Provide(NewRunpodClient),
+	fx.Provide(NewFinetuneBackends),
 	fx.Provide(NewDownloadModelClient),
+	fx.Provide(NewFinetuneArtifactClient),
 	fx.Provide(NewOllamaLLMClient),
 	fx.Provide(NewUserService),
 	fx.Provide(NewProjectService),
+	fx.Provide(NewCorpusService),
+	fx.Provide(NewCorpusChunkingService),
 	fx.Provide(NewTrainingDatasetService),
+	fx.Provide(NewTrainingDatasetImportService),
+	fx.Provide(NewTrainingDataDeduplicationService),
+	fx.Provide(NewTrainingDatasetDiffService),
+	fx.Provide(NewTrainingDataQualityService),
+	fx.Provide(NewPIIService),
+	fx.Provide(NewTrainingDatasetStatsService),
+	fx.Provide(NewTrainingDatasetEstimateService),
 	fx.Provide(NewFinetuneService),
+	fx.Provide(NewFinetuneMetricsService),
+	fx.Provide(NewFinetuneQueueService),
 	fx.Provide(NewFinetuneCompletionService),
+	fx.Provide(NewTrainingDatasetProgressService),
+	fx.Provide(NewStatusTransitionService),
+	fx.Provide(NewOutboxService),
+	fx.Provide(NewPromptService),
+	fx.Provide(NewTrainingDatasetGenerationService),
 	fx.Provide(NewPromptAnalysisService),
 	fx.Provide(NewDeploymentService),
 	fx.Provide(NewJWTService),
@@ -543,14 +1643,54 @@ var Module = fx.Options(
 	fx.Provide(NewCreateProjectUseCase),
 	fx.Provide(NewGetProjectUseCase),
 	fx.Provide(NewListProjectsUseCase),
+	fx.Provide(NewCreateCorpusUseCase),
+	fx.Provide(NewListCorporaUseCase),
+	fx.Provide(NewGetCorpusUseCase),
+	fx.Provide(NewUploadCorpusDocumentUseCase),
+	fx.Provide(NewDeleteCorpusDocumentUseCase),
+	fx.Provide(NewUpdateCorpusFilesSubsetUseCase),
+	fx.Provide(NewSyncCorpusUseCase),
+	fx.Provide(NewPreviewCorpusChunksUseCase),
+	fx.Provide(NewChunkCorpusUseCase),
 	fx.Provide(NewCreateTrainingDatasetUseCase),
 	fx.Provide(NewCreateFinetuneUseCase),
 	fx.Provide(NewGetTrainingDatasetUseCase),
 	fx.Provide(NewDownloadTrainingDatasetUseCase),
+	fx.Provide(NewListTrainingDataItemsUseCase),
+	fx.Provide(NewGetTrainingDataItemUseCase),
+	fx.Provide(NewGetTrainingDataItemChunkUseCase),
+	fx.Provide(NewEditTrainingDataItemUseCase),
+	fx.Provide(NewDeleteTrainingDataItemUseCase),
+	fx.Provide(NewRestoreTrainingDataItemUseCase),
+	fx.Provide(NewGenerateTrainingDatasetSplitsUseCase),
+	fx.Provide(NewDeduplicateTrainingDatasetUseCase),
+	fx.Provide(NewGetTrainingDatasetDiffUseCase),
+	fx.Provide(NewScoreTrainingDatasetUseCase),
+	fx.Provide(NewRunQualityScoringJobUseCase),
+	fx.Provide(NewGetTrainingDatasetQualityScoresUseCase),
+	fx.Provide(NewGetTrainingDatasetStatsUseCase),
+	fx.Provide(NewEstimateTrainingDatasetUseCase),
+	fx.Provide(NewScanTrainingDatasetPIIUseCase),
+	fx.Provide(NewListTrainingDatasetPIIReportsUseCase),
 	fx.Provide(NewUploadTrainingDatasetUseCase),
 	fx.Provide(NewUploadNewTrainingDatasetVersionUseCase),
 	fx.Provide(NewUpdateTrainingDatasetStatusUseCase),
+	fx.Provide(NewUpdateTrainingDatasetProgressUseCase),
+	fx.Provide(NewGetTrainingDatasetProgressUseCase),
+	fx.Provide(NewGetTrainingDatasetPartialResultsUseCase),
+	fx.Provide(NewRunTrainingDatasetJobUseCase),
+	fx.Provide(NewAbortTrainingDatasetUseCase),
+	fx.Provide(NewResumeTrainingDatasetUseCase),
+	fx.Provide(NewGenerateMoreTrainingDatasetUseCase),
+	fx.Provide(NewListPromptsUseCase),
+	fx.Provide(NewCreatePromptVersionUseCase),
+	fx.Provide(NewGetPromptDiffUseCase),
 	fx.Provide(NewUpdateFinetuneStatusUseCase),
+	fx.Provide(NewUpdateFinetuneMetricsUseCase),
+	fx.Provide(NewGetFinetuneMetricsUseCase),
+	fx.Provide(NewAbortFinetuneUseCase),
+	fx.Provide(NewDispatchOutboxJobsUseCase),
+	fx.Provide(NewScheduleFinetunesUseCase),
 	fx.Provide(NewGetFinetuneUseCase),
 	fx.Provide(NewFinetuneCompletionUseCase),
 	fx.Provide(NewDownloadModelUseCase),
@@ -565,14 +1705,50 @@ var Module = fx.Options(
 	fx.Provide(NewCreateProjectController),
 	fx.Provide(NewGetProjectController),
 	fx.Provide(NewListProjectsController),
+	fx.Provide(NewCreateCorpusController),
+	fx.Provide(NewListCorporaController),
+	fx.Provide(NewGetCorpusController),
+	fx.Provide(NewUploadCorpusDocumentController),
+	fx.Provide(NewDeleteCorpusDocumentController),
+	fx.Provide(NewUpdateCorpusFilesSubsetController),
+	fx.Provide(NewSyncCorpusController),
+	fx.Provide(NewPreviewCorpusChunksController),
+	fx.Provide(NewChunkCorpusController),
 	fx.Provide(NewCreateTrainingDatasetController),
 	fx.Provide(NewCreateFinetuneController),
 	fx.Provide(NewGetTrainingDatasetController),
 	fx.Provide(NewDownloadTrainingDatasetController),
+	fx.Provide(NewListTrainingDataItemsController),
+	fx.Provide(NewGetTrainingDataItemController),
+	fx.Provide(NewGetTrainingDataItemChunkController),
+	fx.Provide(NewEditTrainingDataItemController),
+	fx.Provide(NewDeleteTrainingDataItemController),
+	fx.Provide(NewRestoreTrainingDataItemController),
+	fx.Provide(NewGenerateTrainingDatasetSplitsController),
+	fx.Provide(NewDeduplicateTrainingDatasetController),
+	fx.Provide(NewGetTrainingDatasetDiffController),
+	fx.Provide(NewScoreTrainingDatasetController),
+	fx.Provide(NewGetTrainingDatasetQualityScoresController),
+	fx.Provide(NewGetTrainingDatasetStatsController),
+	fx.Provide(NewEstimateTrainingDatasetController),
+	fx.Provide(NewScanTrainingDatasetPIIController),
+	fx.Provide(NewListTrainingDatasetPIIReportsController),
 	fx.Provide(NewUploadTrainingDatasetController),
 	fx.Provide(NewUploadNewTrainingDatasetVersionController),
 	fx.Provide(NewUpdateTrainingDatasetStatusController),
+	fx.Provide(NewUpdateTrainingDatasetProgressController),
+	fx.Provide(NewGetTrainingDatasetProgressController),
+	fx.Provide(NewGetTrainingDatasetPartialResultsController),
+	fx.Provide(NewAbortTrainingDatasetController),
+	fx.Provide(NewResumeTrainingDatasetController),
+	fx.Provide(NewGenerateMoreTrainingDatasetController),
+	fx.Provide(NewListPromptsController),
+	fx.Provide(NewCreatePromptVersionController),
+	fx.Provide(NewGetPromptDiffController),
 	fx.Provide(NewUpdateFinetuneStatusController),
+	fx.Provide(NewUpdateFinetuneMetricsController),
+	fx.Provide(NewGetFinetuneMetricsController),
+	fx.Provide(NewAbortFinetuneController),
 	fx.Provide(NewGetFinetuneController),
 	fx.Provide(NewFinetuneCompletionController),
 	fx.Provide(NewDownloadModelController),
@@ -583,6 +1759,10 @@ var Module = fx.Options(
 	fx.Provide(NewPublicCompletionController),
 	fx.Provide(NewPublicChatCompletionController),
 	fx.Provide(NewPublicListModelsController),
+	fx.Provide(NewTrainingDatasetWorker),
+	fx.Provide(NewOutboxDispatcher),
+	fx.Provide(NewFinetuneScheduler),
+	fx.Provide(NewQualityScoringWorker),
 	fx.Provide(NewAuthMiddleware),
 	fx.Provide(NewAPIKeyMiddleware),
 	fx.Provide(NewExternalAPIMiddleware),
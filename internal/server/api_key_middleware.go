@@ -83,6 +83,7 @@ func (m *APIKeyMiddleware) AuthenticateAPIKey() gin.HandlerFunc {
 			c.Set("finetune_id", *deployment.FinetuneID)
 		}
 		c.Set("project_id", deployment.ProjectID)
+		c.Set("redact_pii", deployment.RedactPII)
 
 		c.Next()
 	}
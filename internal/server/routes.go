@@ -10,6 +10,7 @@ import (
 	"ai-platform/cmd/web/training_datasets"
 	"ai-platform/cmd/web/finetunes"
 	"ai-platform/cmd/web/deployments"
+	"ai-platform/cmd/web/prompts"
 	"io/fs"
 )
 
@@ -37,15 +38,49 @@ func (s *Server) RegisterRoutes() http.Handler {
 	protected.POST("/projects", s.createProjectController.CreateProject)
 	protected.GET("/projects", s.listProjectsController.ListProjects)
 	protected.GET("/projects/:project_id", s.getProjectController.GetProject)
+	protected.POST("/corpora", s.createCorpusController.CreateCorpus)
+	protected.GET("/corpora", s.listCorporaController.ListCorpora)
+	protected.GET("/corpora/:corpus_id", s.getCorpusController.GetCorpus)
+	protected.PUT("/corpora/:corpus_id/files-subset", s.updateCorpusFilesSubsetController.UpdateCorpusFilesSubset)
+	protected.POST("/corpora/:corpus_id/documents", s.uploadCorpusDocumentController.UploadCorpusDocument)
+	protected.DELETE("/corpora/:corpus_id/documents/:file_name", s.deleteCorpusDocumentController.DeleteCorpusDocument)
+	protected.POST("/corpora/:corpus_id/sync", s.syncCorpusController.SyncCorpus)
+	protected.POST("/corpora/:corpus_id/chunks/preview", s.previewCorpusChunksController.PreviewCorpusChunks)
+	protected.POST("/corpora/:corpus_id/chunks", s.chunkCorpusController.ChunkCorpus)
 	protected.POST("/projects/:project_id/training-datasets", s.createTrainingDatasetController.CreateTrainingDataset)
+	protected.POST("/projects/:project_id/training-datasets/estimate", s.estimateTrainingDatasetController.EstimateTrainingDataset)
 	protected.POST("/projects/:project_id/training-datasets/upload", s.uploadNewTrainingDatasetVersionController.UploadNewTrainingDatasetVersion)
 	protected.GET("/projects/:project_id/training-datasets/:training_dataset_id", s.getTrainingDatasetController.GetTrainingDataset)
 	protected.GET("/projects/:project_id/training-datasets/:training_dataset_id/download", s.downloadTrainingDatasetController.DownloadTrainingDataset)
+	protected.GET("/projects/:project_id/training-datasets/:training_dataset_id/items", s.listTrainingDataItemsController.ListTrainingDataItems)
+	protected.GET("/projects/:project_id/training-datasets/:training_dataset_id/items/:item_id", s.getTrainingDataItemController.GetTrainingDataItem)
+	protected.GET("/projects/:project_id/training-datasets/:training_dataset_id/items/:item_id/chunk", s.getTrainingDataItemChunkController.GetTrainingDataItemChunk)
+	protected.PUT("/projects/:project_id/training-datasets/:training_dataset_id/items/:item_id", s.editTrainingDataItemController.EditTrainingDataItem)
+	protected.DELETE("/projects/:project_id/training-datasets/:training_dataset_id/items/:item_id", s.deleteTrainingDataItemController.DeleteTrainingDataItem)
+	protected.POST("/projects/:project_id/training-datasets/:training_dataset_id/items/:item_id/restore", s.restoreTrainingDataItemController.RestoreTrainingDataItem)
+	protected.POST("/projects/:project_id/training-datasets/:training_dataset_id/splits", s.generateTrainingDatasetSplitsController.GenerateTrainingDatasetSplits)
+	protected.POST("/projects/:project_id/training-datasets/:training_dataset_id/deduplicate", s.deduplicateTrainingDatasetController.DeduplicateTrainingDataset)
+	protected.GET("/projects/:project_id/training-datasets/:training_dataset_id/diff/:other_training_dataset_id", s.getTrainingDatasetDiffController.GetTrainingDatasetDiff)
+	protected.POST("/projects/:project_id/training-datasets/:training_dataset_id/quality-scores", s.scoreTrainingDatasetController.ScoreTrainingDataset)
+	protected.GET("/projects/:project_id/training-datasets/:training_dataset_id/quality-scores", s.getTrainingDatasetQualityScoresController.GetTrainingDatasetQualityScores)
+	protected.GET("/projects/:project_id/training-datasets/:training_dataset_id/stats", s.getTrainingDatasetStatsController.GetTrainingDatasetStats)
+	protected.GET("/projects/:project_id/training-datasets/:training_dataset_id/progress", s.getTrainingDatasetProgressController.GetTrainingDatasetProgress)
+	protected.GET("/projects/:project_id/training-datasets/:training_dataset_id/partial-results", s.getTrainingDatasetPartialResultsController.GetTrainingDatasetPartialResults)
+	protected.POST("/projects/:project_id/training-datasets/:training_dataset_id/abort", s.abortTrainingDatasetController.AbortTrainingDataset)
+	protected.POST("/projects/:project_id/training-datasets/:training_dataset_id/resume", s.resumeTrainingDatasetController.ResumeTrainingDataset)
+	protected.POST("/projects/:project_id/training-datasets/:training_dataset_id/generate-more", s.generateMoreTrainingDatasetController.GenerateMoreTrainingDataset)
+	protected.GET("/projects/:project_id/prompts", s.listPromptsController.ListPrompts)
+	protected.POST("/projects/:project_id/prompts", s.createPromptVersionController.CreatePromptVersion)
+	protected.GET("/projects/:project_id/prompts/:prompt_id/diff/:other_prompt_id", s.getPromptDiffController.GetPromptDiff)
+	protected.POST("/projects/:project_id/training-datasets/:training_dataset_id/pii-scan", s.scanTrainingDatasetPIIController.ScanTrainingDatasetPII)
+	protected.GET("/projects/:project_id/training-datasets/:training_dataset_id/pii-reports", s.listTrainingDatasetPIIReportsController.ListTrainingDatasetPIIReports)
 	protected.POST("/projects/:project_id/training-datasets/:training_dataset_id/upload", s.uploadTrainingDatasetController.UploadTrainingDataset)
 	protected.POST("/projects/:project_id/finetunes", s.createFinetuneController.CreateFinetune)
 	protected.GET("/projects/:project_id/finetunes/:finetune_id", s.getFinetuneController.GetFinetune)
+	protected.GET("/projects/:project_id/finetunes/:finetune_id/metrics", s.getFinetuneMetricsController.GetFinetuneMetrics)
 	protected.POST("/projects/:project_id/finetunes/:finetune_id/completion", s.finetuneCompletionController.GenerateCompletion)
 	protected.GET("/projects/:project_id/finetunes/:finetune_id/download", s.downloadModelController.DownloadModel)
+	protected.POST("/projects/:project_id/finetunes/:finetune_id/abort", s.abortFinetuneController.AbortFinetune)
 	protected.POST("/projects/:project_id/deployments", s.createDeploymentController.CreateDeployment)
 	protected.GET("/projects/:project_id/deployments/:deployment_id", s.getDeploymentController.GetDeployment)
 	protected.GET("/projects/:project_id/deployments/:deployment_id/logs_download", s.downloadDeploymentLogsController.DownloadDeploymentLogs)
@@ -54,7 +89,9 @@ func (s *Server) RegisterRoutes() http.Handler {
 	external := r.Group("/api/external")
 	external.Use(s.externalAPIMiddleware.RequireAPIKey())
 	external.PUT("/training-datasets/:training_dataset_id/update-status", s.updateTrainingDatasetStatusController.UpdateStatus)
+	external.PUT("/training-datasets/:training_dataset_id/progress", s.updateTrainingDatasetProgressController.UpdateProgress)
 	external.PUT("/finetunes/:finetune_id/update-status", s.updateFinetuneStatusController.UpdateStatus)
+	external.PUT("/finetunes/:finetune_id/metrics", s.updateFinetuneMetricsController.UpdateMetrics)
 
 	// Public OpenAI-compatible API routes (deployment API key protected)
 	publicAPI := r.Group("/public/:project_id")
@@ -116,10 +153,28 @@ func (s *Server) RegisterRoutes() http.Handler {
 		training_datasets.TrainingDatasetStep4Handler(c.Writer, c.Request)
 	})
 
+	r.POST("/web/projects/:project_id/training-datasets/estimate", func(c *gin.Context) {
+		training_datasets.TrainingDatasetEstimateHandler(c.Writer, c.Request)
+	})
+
 	r.GET("/web/projects/:project_id/training-datasets/:training_dataset_id", func(c *gin.Context) {
 		training_datasets.TrainingDatasetIndexHandler(c.Writer, c.Request)
 	})
 
+	r.GET("/web/projects/:project_id/training-datasets/:training_dataset_id/items", func(c *gin.Context) {
+		training_datasets.TrainingDataItemsHandler(c.Writer, c.Request)
+	})
+	r.GET("/web/projects/:project_id/training-datasets/:training_dataset_id/progress/stream", func(c *gin.Context) {
+		training_datasets.TrainingDatasetProgressStreamHandler(c.Writer, c.Request)
+	})
+	r.GET("/web/projects/:project_id/training-datasets/:training_dataset_id/diff/:other_training_dataset_id", func(c *gin.Context) {
+		training_datasets.TrainingDatasetDiffHandler(c.Writer, c.Request)
+	})
+
+	r.GET("/web/projects/:project_id/prompts", func(c *gin.Context) {
+		prompts.PromptsIndexHandler(c.Writer, c.Request)
+	})
+
 	r.POST("/web/projects/:project_id/finetunes/create", func(c *gin.Context) {
 		training_datasets.CreateFinetuneHandler(c.Writer, c.Request)
 	})
@@ -127,6 +182,9 @@ func (s *Server) RegisterRoutes() http.Handler {
 	r.GET("/web/projects/:project_id/finetunes/:finetune_id", func(c *gin.Context) {
 		finetunes.FinetuneIndexHandler(c.Writer, c.Request)
 	})
+	r.GET("/web/projects/:project_id/finetunes/:finetune_id/metrics/stream", func(c *gin.Context) {
+		finetunes.FinetuneMetricsStreamHandler(c.Writer, c.Request)
+	})
 
 	r.GET("/web/projects/:project_id/deployments/:deployment_id", func(c *gin.Context) {
 		deployments.DeploymentIndexHandler(c.Writer, c.Request)
@@ -20,15 +20,51 @@ type Server struct {
 	createProjectController                  *web.CreateProjectController
 	getProjectController                     *web.GetProjectController
 	listProjectsController                   *web.ListProjectsController
+	createCorpusController                   *web.CreateCorpusController
+	listCorporaController                    *web.ListCorporaController
+	getCorpusController                      *web.GetCorpusController
+	uploadCorpusDocumentController           *web.UploadCorpusDocumentController
+	deleteCorpusDocumentController           *web.DeleteCorpusDocumentController
+	updateCorpusFilesSubsetController        *web.UpdateCorpusFilesSubsetController
+	syncCorpusController                     *web.SyncCorpusController
+	previewCorpusChunksController            *web.PreviewCorpusChunksController
+	chunkCorpusController                    *web.ChunkCorpusController
 	createTrainingDatasetController          *web.CreateTrainingDatasetController
+	estimateTrainingDatasetController        *web.EstimateTrainingDatasetController
 	getTrainingDatasetController             *web.GetTrainingDatasetController
 	downloadTrainingDatasetController        *web.DownloadTrainingDatasetController
+	listTrainingDataItemsController          *web.ListTrainingDataItemsController
+	getTrainingDataItemController            *web.GetTrainingDataItemController
+	getTrainingDataItemChunkController       *web.GetTrainingDataItemChunkController
+	editTrainingDataItemController           *web.EditTrainingDataItemController
+	deleteTrainingDataItemController         *web.DeleteTrainingDataItemController
+	restoreTrainingDataItemController        *web.RestoreTrainingDataItemController
+	generateTrainingDatasetSplitsController  *web.GenerateTrainingDatasetSplitsController
+	deduplicateTrainingDatasetController     *web.DeduplicateTrainingDatasetController
+	getTrainingDatasetDiffController         *web.GetTrainingDatasetDiffController
+	scoreTrainingDatasetController           *web.ScoreTrainingDatasetController
+	getTrainingDatasetQualityScoresController *web.GetTrainingDatasetQualityScoresController
+	getTrainingDatasetStatsController         *web.GetTrainingDatasetStatsController
+	getTrainingDatasetProgressController      *web.GetTrainingDatasetProgressController
+	getTrainingDatasetPartialResultsController *web.GetTrainingDatasetPartialResultsController
+	updateTrainingDatasetProgressController   *web.UpdateTrainingDatasetProgressController
+	scanTrainingDatasetPIIController          *web.ScanTrainingDatasetPIIController
+	listTrainingDatasetPIIReportsController   *web.ListTrainingDatasetPIIReportsController
 	uploadTrainingDatasetController          *web.UploadTrainingDatasetController
 	uploadNewTrainingDatasetVersionController *web.UploadNewTrainingDatasetVersionController
 	updateTrainingDatasetStatusController    *web.UpdateTrainingDatasetStatusController
+	abortTrainingDatasetController           *web.AbortTrainingDatasetController
+	resumeTrainingDatasetController          *web.ResumeTrainingDatasetController
+	generateMoreTrainingDatasetController    *web.GenerateMoreTrainingDatasetController
+	listPromptsController                    *web.ListPromptsController
+	createPromptVersionController            *web.CreatePromptVersionController
+	getPromptDiffController                  *web.GetPromptDiffController
 	updateFinetuneStatusController           *web.UpdateFinetuneStatusController
+	abortFinetuneController                  *web.AbortFinetuneController
 	createFinetuneController                 *web.CreateFinetuneController
 	getFinetuneController                    *web.GetFinetuneController
+	getFinetuneMetricsController             *web.GetFinetuneMetricsController
+	updateFinetuneMetricsController          *web.UpdateFinetuneMetricsController
 	finetuneCompletionController             *web.FinetuneCompletionController
 	downloadModelController                  *web.DownloadModelController
 	analyzePromptController                  *web.AnalyzePromptController
@@ -43,7 +79,7 @@ type Server struct {
 	externalAPIMiddleware                    *ExternalAPIMiddleware
 }
 
-func NewServer(db database.Service, loginController *web.LoginController, createProjectController *web.CreateProjectController, getProjectController *web.GetProjectController, listProjectsController *web.ListProjectsController, createTrainingDatasetController *web.CreateTrainingDatasetController, getTrainingDatasetController *web.GetTrainingDatasetController, downloadTrainingDatasetController *web.DownloadTrainingDatasetController, uploadTrainingDatasetController *web.UploadTrainingDatasetController, uploadNewTrainingDatasetVersionController *web.UploadNewTrainingDatasetVersionController, updateTrainingDatasetStatusController *web.UpdateTrainingDatasetStatusController, updateFinetuneStatusController *web.UpdateFinetuneStatusController, createFinetuneController *web.CreateFinetuneController, getFinetuneController *web.GetFinetuneController, finetuneCompletionController *web.FinetuneCompletionController, downloadModelController *web.DownloadModelController, analyzePromptController *web.AnalyzePromptController, createDeploymentController *web.CreateDeploymentController, getDeploymentController *web.GetDeploymentController, downloadDeploymentLogsController *web.DownloadDeploymentLogsController, publicCompletionController *web.PublicCompletionController, publicChatCompletionController *web.PublicChatCompletionController, publicListModelsController *web.PublicListModelsController, authMiddleware *AuthMiddleware, apiKeyMiddleware *APIKeyMiddleware, externalAPIMiddleware *ExternalAPIMiddleware) *http.Server {
+func NewServer(db database.Service, loginController *web.LoginController, createProjectController *web.CreateProjectController, getProjectController *web.GetProjectController, listProjectsController *web.ListProjectsController, createCorpusController *web.CreateCorpusController, listCorporaController *web.ListCorporaController, getCorpusController *web.GetCorpusController, uploadCorpusDocumentController *web.UploadCorpusDocumentController, deleteCorpusDocumentController *web.DeleteCorpusDocumentController, updateCorpusFilesSubsetController *web.UpdateCorpusFilesSubsetController, syncCorpusController *web.SyncCorpusController, previewCorpusChunksController *web.PreviewCorpusChunksController, chunkCorpusController *web.ChunkCorpusController, createTrainingDatasetController *web.CreateTrainingDatasetController, estimateTrainingDatasetController *web.EstimateTrainingDatasetController, getTrainingDatasetController *web.GetTrainingDatasetController, downloadTrainingDatasetController *web.DownloadTrainingDatasetController, listTrainingDataItemsController *web.ListTrainingDataItemsController, getTrainingDataItemController *web.GetTrainingDataItemController, getTrainingDataItemChunkController *web.GetTrainingDataItemChunkController, editTrainingDataItemController *web.EditTrainingDataItemController, deleteTrainingDataItemController *web.DeleteTrainingDataItemController, restoreTrainingDataItemController *web.RestoreTrainingDataItemController, generateTrainingDatasetSplitsController *web.GenerateTrainingDatasetSplitsController, deduplicateTrainingDatasetController *web.DeduplicateTrainingDatasetController, getTrainingDatasetDiffController *web.GetTrainingDatasetDiffController, scoreTrainingDatasetController *web.ScoreTrainingDatasetController, getTrainingDatasetQualityScoresController *web.GetTrainingDatasetQualityScoresController, getTrainingDatasetStatsController *web.GetTrainingDatasetStatsController, getTrainingDatasetProgressController *web.GetTrainingDatasetProgressController, getTrainingDatasetPartialResultsController *web.GetTrainingDatasetPartialResultsController, updateTrainingDatasetProgressController *web.UpdateTrainingDatasetProgressController, scanTrainingDatasetPIIController *web.ScanTrainingDatasetPIIController, listTrainingDatasetPIIReportsController *web.ListTrainingDatasetPIIReportsController, uploadTrainingDatasetController *web.UploadTrainingDatasetController, uploadNewTrainingDatasetVersionController *web.UploadNewTrainingDatasetVersionController, updateTrainingDatasetStatusController *web.UpdateTrainingDatasetStatusController, abortTrainingDatasetController *web.AbortTrainingDatasetController, resumeTrainingDatasetController *web.ResumeTrainingDatasetController, generateMoreTrainingDatasetController *web.GenerateMoreTrainingDatasetController, listPromptsController *web.ListPromptsController, createPromptVersionController *web.CreatePromptVersionController, getPromptDiffController *web.GetPromptDiffController, updateFinetuneStatusController *web.UpdateFinetuneStatusController, abortFinetuneController *web.AbortFinetuneController, createFinetuneController *web.CreateFinetuneController, getFinetuneController *web.GetFinetuneController, getFinetuneMetricsController *web.GetFinetuneMetricsController, updateFinetuneMetricsController *web.UpdateFinetuneMetricsController, finetuneCompletionController *web.FinetuneCompletionController, downloadModelController *web.DownloadModelController, analyzePromptController *web.AnalyzePromptController, createDeploymentController *web.CreateDeploymentController, getDeploymentController *web.GetDeploymentController, downloadDeploymentLogsController *web.DownloadDeploymentLogsController, publicCompletionController *web.PublicCompletionController, publicChatCompletionController *web.PublicChatCompletionController, publicListModelsController *web.PublicListModelsController, authMiddleware *AuthMiddleware, apiKeyMiddleware *APIKeyMiddleware, externalAPIMiddleware *ExternalAPIMiddleware) *http.Server {
 	port, _ := strconv.Atoi(os.Getenv("PORT"))
 	serverInstance := &Server{
 		port:                                     port,
@@ -52,15 +88,51 @@ func NewServer(db database.Service, loginController *web.LoginController, create
 		createProjectController:                  createProjectController,
 		getProjectController:                     getProjectController,
 		listProjectsController:                   listProjectsController,
+		createCorpusController:                   createCorpusController,
+		listCorporaController:                    listCorporaController,
+		getCorpusController:                      getCorpusController,
+		uploadCorpusDocumentController:           uploadCorpusDocumentController,
+		deleteCorpusDocumentController:           deleteCorpusDocumentController,
+		updateCorpusFilesSubsetController:        updateCorpusFilesSubsetController,
+		syncCorpusController:                     syncCorpusController,
+		previewCorpusChunksController:            previewCorpusChunksController,
+		chunkCorpusController:                    chunkCorpusController,
 		createTrainingDatasetController:          createTrainingDatasetController,
+		estimateTrainingDatasetController:        estimateTrainingDatasetController,
 		getTrainingDatasetController:             getTrainingDatasetController,
 		downloadTrainingDatasetController:        downloadTrainingDatasetController,
+		listTrainingDataItemsController:          listTrainingDataItemsController,
+		getTrainingDataItemController:            getTrainingDataItemController,
+		getTrainingDataItemChunkController:       getTrainingDataItemChunkController,
+		editTrainingDataItemController:           editTrainingDataItemController,
+		deleteTrainingDataItemController:         deleteTrainingDataItemController,
+		restoreTrainingDataItemController:        restoreTrainingDataItemController,
+		generateTrainingDatasetSplitsController:  generateTrainingDatasetSplitsController,
+		deduplicateTrainingDatasetController:     deduplicateTrainingDatasetController,
+		getTrainingDatasetDiffController:         getTrainingDatasetDiffController,
+		scoreTrainingDatasetController:           scoreTrainingDatasetController,
+		getTrainingDatasetQualityScoresController: getTrainingDatasetQualityScoresController,
+		getTrainingDatasetStatsController:         getTrainingDatasetStatsController,
+		getTrainingDatasetProgressController:      getTrainingDatasetProgressController,
+		getTrainingDatasetPartialResultsController: getTrainingDatasetPartialResultsController,
+		updateTrainingDatasetProgressController:   updateTrainingDatasetProgressController,
+		scanTrainingDatasetPIIController:          scanTrainingDatasetPIIController,
+		listTrainingDatasetPIIReportsController:   listTrainingDatasetPIIReportsController,
 		uploadTrainingDatasetController:          uploadTrainingDatasetController,
 		uploadNewTrainingDatasetVersionController: uploadNewTrainingDatasetVersionController,
 		updateTrainingDatasetStatusController:    updateTrainingDatasetStatusController,
+		abortTrainingDatasetController:           abortTrainingDatasetController,
+		resumeTrainingDatasetController:          resumeTrainingDatasetController,
+		generateMoreTrainingDatasetController:    generateMoreTrainingDatasetController,
+		listPromptsController:                    listPromptsController,
+		createPromptVersionController:            createPromptVersionController,
+		getPromptDiffController:                  getPromptDiffController,
 		updateFinetuneStatusController:           updateFinetuneStatusController,
+		abortFinetuneController:                  abortFinetuneController,
 		createFinetuneController:                 createFinetuneController,
 		getFinetuneController:                    getFinetuneController,
+		getFinetuneMetricsController:             getFinetuneMetricsController,
+		updateFinetuneMetricsController:          updateFinetuneMetricsController,
 		finetuneCompletionController:             finetuneCompletionController,
 		downloadModelController:                  downloadModelController,
 		analyzePromptController:                  analyzePromptController,
@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"ai-platform/internal/application/port/in"
+)
+
+const (
+	DefaultFinetuneSchedulerPollInterval = 5 * time.Second
+	DefaultFinetuneMaxConcurrent         = 4
+	DefaultFinetuneMaxConcurrentPerUser  = 2
+)
+
+// FinetuneScheduler moves the queued finetunes to the outbox dispatcher when a slot is free
+type FinetuneScheduler struct {
+	ScheduleFinetunesUseCase in.ScheduleFinetunesUseCase
+	PollInterval             time.Duration
+	MaxConcurrent            int
+	MaxConcurrentPerUser     int
+}
+
+// Run schedules the queued finetunes until the context is cancelled
+func (s *FinetuneScheduler) Run(ctx context.Context) {
+	pollInterval := s.PollInterval
+	if pollInterval == 0 {
+		pollInterval = DefaultFinetuneSchedulerPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.schedule(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *FinetuneScheduler) schedule(ctx context.Context) {
+	result, err := s.ScheduleFinetunesUseCase.Execute(ctx, in.ScheduleFinetunesCommand{
+		MaxConcurrent:        s.MaxConcurrent,
+		MaxConcurrentPerUser: s.MaxConcurrentPerUser,
+	})
+	if err != nil {
+		log.Printf("failed to schedule finetunes: %v", err)
+	}
+	if result != nil && result.Dispatched > 0 {
+		log.Printf("finetunes scheduled: %d dispatched, %d queued", result.Dispatched, result.Queued)
+	}
+}
@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"ai-platform/internal/application/port/in"
+)
+
+const (
+	DefaultOutboxPollInterval = 5 * time.Second
+	outboxBatchSize           = 20
+	// outboxLease must be longer than the delivery of a batch, an expired lease lets the job be delivered again
+	outboxLease = 5 * time.Minute
+)
+
+// OutboxDispatcher delivers the jobs stored with the training datasets and finetunes to S3 and Runpod
+type OutboxDispatcher struct {
+	DispatchOutboxJobsUseCase in.DispatchOutboxJobsUseCase
+	PollInterval              time.Duration
+}
+
+// Run dispatches the due jobs until the context is cancelled
+func (d *OutboxDispatcher) Run(ctx context.Context) {
+	pollInterval := d.PollInterval
+	if pollInterval == 0 {
+		pollInterval = DefaultOutboxPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *OutboxDispatcher) dispatch(ctx context.Context) {
+	for ctx.Err() == nil {
+		result, err := d.DispatchOutboxJobsUseCase.Execute(ctx, in.DispatchOutboxJobsCommand{
+			Limit: outboxBatchSize,
+			Lease: outboxLease,
+		})
+		if err != nil {
+			log.Printf("failed to dispatch outbox jobs: %v", err)
+		}
+		if result == nil {
+			return
+		}
+
+		if result.Retrying > 0 || result.Failed > 0 {
+			log.Printf("outbox jobs dispatched: %d delivered, %d retrying, %d failed, %d cancelled",
+				result.Delivered, result.Retrying, result.Failed, result.Cancelled)
+		}
+
+		// A full batch means more jobs may be due
+		if result.Delivered+result.Retrying+result.Failed+result.Cancelled < outboxBatchSize {
+			return
+		}
+	}
+}
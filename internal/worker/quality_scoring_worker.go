@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"ai-platform/internal/application/port/in"
+)
+
+const (
+	DefaultQualityScoringPollInterval = 5 * time.Second
+	// qualityScoringLease is renewed while a job runs, a job whose worker stopped is resumed after it expired
+	qualityScoringLease = 2 * time.Minute
+)
+
+// QualityScoringWorker runs the quality scoring jobs of the training datasets, several workers share the jobs
+type QualityScoringWorker struct {
+	RunQualityScoringJobUseCase in.RunQualityScoringJobUseCase
+	PollInterval                time.Duration
+}
+
+// Run polls for jobs until the context is cancelled
+func (w *QualityScoringWorker) Run(ctx context.Context) {
+	pollInterval := w.PollInterval
+	if pollInterval == 0 {
+		pollInterval = DefaultQualityScoringPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *QualityScoringWorker) poll(ctx context.Context) {
+	// Jobs are run one after the other until none is waiting
+	for ctx.Err() == nil {
+		result, err := w.RunQualityScoringJobUseCase.Execute(ctx, in.RunQualityScoringJobCommand{Lease: qualityScoringLease})
+		if result == nil || result.Job == nil {
+			if err != nil {
+				log.Printf("failed to run quality scoring job: %v", err)
+			}
+			return
+		}
+
+		job := result.Job
+		if err != nil {
+			log.Printf("quality scoring job %s for training dataset %s failed: %v", job.ID, job.TrainingDatasetID, err)
+			continue
+		}
+		log.Printf("quality scoring job %s for training dataset %s done, %d items scored, %d failed",
+			job.ID, job.TrainingDatasetID, job.ItemsScored, job.ItemsFailed)
+	}
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"ai-platform/internal/application/port/in"
+	"ai-platform/internal/application/port/out/clients"
+)
+
+const DefaultPollInterval = 10 * time.Second
+
+// TrainingDatasetWorker consumes the submitted training dataset jobs, like the external runner does.
+// Only one of them should be running against the same bucket.
+type TrainingDatasetWorker struct {
+	TrainingDatasetJobQueueClient clients.TrainingDatasetJobQueueClient
+	RunTrainingDatasetJobUseCase  in.RunTrainingDatasetJobUseCase
+	PollInterval                  time.Duration
+}
+
+// Run polls for jobs until the context is cancelled
+func (w *TrainingDatasetWorker) Run(ctx context.Context) {
+	pollInterval := w.PollInterval
+	if pollInterval == 0 {
+		pollInterval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *TrainingDatasetWorker) poll(ctx context.Context) {
+	jobs, err := w.TrainingDatasetJobQueueClient.ReceiveJobs(ctx)
+	if err != nil {
+		log.Printf("failed to receive training dataset jobs: %v", err)
+		return
+	}
+
+	for _, received := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		w.run(ctx, received)
+
+		// The job is only removed once its run ended, the job of a worker that stopped during the run is received
+		// again and fails its training dataset, which the user can then resume
+		if err := w.TrainingDatasetJobQueueClient.DeleteJob(context.WithoutCancel(ctx), received.Key); err != nil {
+			log.Printf("failed to delete training dataset job %s: %v", received.Key, err)
+		}
+	}
+}
+
+func (w *TrainingDatasetWorker) run(ctx context.Context, received clients.ReceivedTrainingDatasetJob) {
+	log.Printf("running training dataset job for training dataset %s", received.Job.TrainingDatasetID)
+	result, err := w.RunTrainingDatasetJobUseCase.Execute(ctx, in.RunTrainingDatasetJobCommand{Job: received.Job})
+	if err != nil {
+		log.Printf("training dataset job for training dataset %s failed: %v", received.Job.TrainingDatasetID, err)
+		return
+	}
+	if result.Aborted {
+		log.Printf("training dataset job for training dataset %s aborted after %d examples", received.Job.TrainingDatasetID, result.GeneratedExamples)
+		return
+	}
+	log.Printf("training dataset job for training dataset %s done, %d examples generated, %d requests failed",
+		received.Job.TrainingDatasetID, result.GeneratedExamples, result.FailedRequests)
+}
@@ -0,0 +1,7 @@
+-- Add split assignment to training_data_items, existing items are part of the training split
+ALTER TABLE training_data_items ADD COLUMN split VARCHAR(20) NOT NULL DEFAULT 'train';
+
+ALTER TABLE training_data_items ADD CONSTRAINT chk_training_data_items_split
+    CHECK (split IN ('train', 'validation', 'test'));
+
+CREATE INDEX idx_training_data_items_split ON training_data_items(training_dataset_id, split);
@@ -0,0 +1,2 @@
+-- Add the reason why a training data item was deleted, e.g. when it was detected as a duplicate
+ALTER TABLE training_data_items ADD COLUMN deleted_reason TEXT;
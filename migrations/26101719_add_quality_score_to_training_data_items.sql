@@ -0,0 +1,3 @@
+-- Add the LLM judge quality score and its rationale to training data items
+ALTER TABLE training_data_items ADD COLUMN quality_score DOUBLE PRECISION;
+ALTER TABLE training_data_items ADD COLUMN quality_rationale TEXT;
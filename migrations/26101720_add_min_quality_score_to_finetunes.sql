@@ -0,0 +1,2 @@
+-- Store the minimum quality score used to select the training data items of a finetune
+ALTER TABLE finetunes ADD COLUMN training_dataset_min_quality_score DOUBLE PRECISION;
@@ -0,0 +1,14 @@
+-- Create pii_reports table, one report per PII detection run over a training dataset
+CREATE TABLE pii_reports (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    training_dataset_id UUID NOT NULL REFERENCES training_datasets(id) ON DELETE CASCADE,
+    finetune_id UUID REFERENCES finetunes(id) ON DELETE SET NULL,
+    redacted BOOLEAN NOT NULL DEFAULT false,
+    items_scanned INT NOT NULL,
+    items_with_pii INT NOT NULL,
+    counts_json JSONB NOT NULL DEFAULT '{}',
+    findings_json JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_pii_reports_training_dataset_id ON pii_reports(training_dataset_id);
@@ -0,0 +1,2 @@
+-- Redact personal data from the logged input and output of a deployment
+ALTER TABLE deployments ADD COLUMN redact_pii BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,15 @@
+-- Search over the values of training data items
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Full-text search on whole words
+CREATE INDEX idx_training_data_items_values_fts ON training_data_items
+    USING GIN (to_tsvector('simple', values_json));
+
+-- Substring search with ILIKE
+CREATE INDEX idx_training_data_items_values_trgm ON training_data_items
+    USING GIN (values_json gin_trgm_ops);
+
+-- Keyset pagination orders the items of a dataset by (created_at, id)
+CREATE INDEX idx_training_data_items_dataset_created_at_id ON training_data_items(training_dataset_id, created_at, id);
+
+CREATE INDEX idx_training_data_items_source_document ON training_data_items(training_dataset_id, source_document);
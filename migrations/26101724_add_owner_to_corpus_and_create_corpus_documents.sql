@@ -0,0 +1,30 @@
+-- Corpora can be owned by a user and optionally scoped to a project, corpora without owner are shared with everyone
+ALTER TABLE corpus ADD COLUMN owner_id UUID REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE corpus ADD COLUMN project_id UUID REFERENCES projects(id) ON DELETE CASCADE;
+
+-- Names only have to be unique per owner
+ALTER TABLE corpus DROP CONSTRAINT corpus_name_key;
+CREATE UNIQUE INDEX idx_corpus_owner_name ON corpus(COALESCE(owner_id, '00000000-0000-0000-0000-000000000000'::uuid), name);
+CREATE INDEX idx_corpus_owner_id ON corpus(owner_id);
+CREATE INDEX idx_corpus_project_id ON corpus(project_id);
+
+-- Create corpus_documents table with the metadata of the files in the S3 prefix of a corpus
+CREATE TABLE corpus_documents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    corpus_id UUID NOT NULL REFERENCES corpus(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    format VARCHAR(20) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    language_iso VARCHAR(10),
+    page_count INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_corpus_documents_corpus_file_name UNIQUE (corpus_id, file_name),
+    CONSTRAINT chk_corpus_documents_format CHECK (format IN ('pdf', 'markdown', 'text', 'json'))
+);
+
+CREATE INDEX idx_corpus_documents_corpus_id ON corpus_documents(corpus_id);
+
+-- Create trigger to update updated_at column
+CREATE TRIGGER update_corpus_documents_updated_at BEFORE UPDATE ON corpus_documents
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
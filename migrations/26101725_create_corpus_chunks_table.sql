@@ -0,0 +1,19 @@
+-- Create corpus_chunks table with the passages the corpus documents are split into, per chunking config
+CREATE TABLE corpus_chunks (
+    id UUID PRIMARY KEY,
+    corpus_id UUID NOT NULL REFERENCES corpus(id) ON DELETE CASCADE,
+    config_key VARCHAR(100) NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    chunk_index INT NOT NULL,
+    start_offset INT NOT NULL,
+    end_offset INT NOT NULL,
+    token_count INT NOT NULL,
+    text TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_corpus_chunks_position UNIQUE (corpus_id, config_key, file_name, chunk_index)
+);
+
+CREATE INDEX idx_corpus_chunks_range ON corpus_chunks(corpus_id, config_key, file_name, start_offset, end_offset);
+
+-- The chunking config a training dataset was generated with
+ALTER TABLE training_datasets ADD COLUMN chunking_config_json TEXT;
@@ -0,0 +1,12 @@
+-- Create training_dataset_progress table with the live progress of a running generation, one row per training dataset
+CREATE TABLE training_dataset_progress (
+    training_dataset_id UUID PRIMARY KEY REFERENCES training_datasets(id) ON DELETE CASCADE,
+    items_generated INT NOT NULL DEFAULT 0,
+    chunks_processed INT NOT NULL DEFAULT 0,
+    chunks_total INT,
+    tokens_in INT NOT NULL DEFAULT 0,
+    tokens_out INT NOT NULL DEFAULT 0,
+    eta_seconds DOUBLE PRECISION,
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
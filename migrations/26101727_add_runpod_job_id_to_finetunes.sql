@@ -0,0 +1,2 @@
+-- Store the Runpod job of a finetune, it is needed to cancel the job when the finetune is aborted
+ALTER TABLE finetunes ADD COLUMN runpod_job_id VARCHAR(255);
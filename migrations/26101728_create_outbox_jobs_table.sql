@@ -0,0 +1,21 @@
+-- Create outbox_jobs table with the jobs that are delivered to S3 or Runpod after their entity is committed
+CREATE TABLE outbox_jobs (
+    id UUID PRIMARY KEY,
+    type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    payload_json JSONB NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'PENDING',
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_outbox_jobs_pending ON outbox_jobs(next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX idx_outbox_jobs_entity ON outbox_jobs(entity_id);
+
+-- The reason shown to the user when a training dataset or finetune failed
+ALTER TABLE training_datasets ADD COLUMN failure_reason TEXT;
+ALTER TABLE finetunes ADD COLUMN failure_reason TEXT;
@@ -0,0 +1,2 @@
+-- A version created with "generate more" keeps the items of the version it extends and appends the new examples
+ALTER TABLE training_datasets ADD COLUMN extends_training_dataset_id UUID REFERENCES training_datasets(id) ON DELETE SET NULL;
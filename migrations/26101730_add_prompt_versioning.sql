@@ -0,0 +1,29 @@
+-- Prompts are versioned per project, every edit of the generation prompt is a new version that points to the previous one
+ALTER TABLE prompts ADD COLUMN project_id UUID REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE prompts ADD COLUMN previous_prompt_id UUID REFERENCES prompts(id) ON DELETE SET NULL;
+
+UPDATE prompts p SET project_id = td.project_id
+FROM training_datasets td WHERE td.generate_prompt_id = p.id;
+
+-- Existing prompts were all created as version 1, number them per project in the order they were created
+UPDATE prompts p SET version = numbered.version
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY project_id ORDER BY created_at, id) AS version
+    FROM prompts WHERE project_id IS NOT NULL
+) numbered
+WHERE p.id = numbered.id;
+
+UPDATE prompts p SET previous_prompt_id = previous.id
+FROM prompts previous
+WHERE previous.project_id = p.project_id AND previous.version = p.version - 1;
+
+CREATE UNIQUE INDEX idx_prompts_project_version ON prompts(project_id, version);
+
+-- The prompt version that generated an item, uploaded items and corrections have none
+ALTER TABLE training_data_items ADD COLUMN generate_prompt_id UUID REFERENCES prompts(id) ON DELETE SET NULL;
+
+UPDATE training_data_items i SET generate_prompt_id = td.generate_prompt_id
+FROM training_datasets td
+WHERE i.training_dataset_id = td.id AND i.corrects_id IS NULL AND i.generation_time_seconds > 0;
+
+CREATE INDEX idx_training_data_items_generate_prompt_id ON training_data_items(generate_prompt_id);
@@ -0,0 +1,13 @@
+-- Conversation datasets have items with a list of messages instead of one value per field name
+ALTER TABLE training_datasets ADD COLUMN type VARCHAR(20) NOT NULL DEFAULT 'records';
+
+ALTER TABLE training_datasets ADD CONSTRAINT chk_training_datasets_type
+    CHECK (type IN ('records', 'conversation'));
+
+ALTER TABLE training_datasets ADD COLUMN conversation_config_json TEXT;
+
+ALTER TABLE training_data_items ADD COLUMN messages_json TEXT;
+
+-- Substring search over the messages of conversations with ILIKE
+CREATE INDEX idx_training_data_items_messages_trgm ON training_data_items
+    USING GIN (messages_json gin_trgm_ops);
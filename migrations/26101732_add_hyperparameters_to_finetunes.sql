@@ -0,0 +1,2 @@
+-- Training settings of a finetune, finetunes created before have none stored
+ALTER TABLE finetunes ADD COLUMN hyperparameters_json TEXT;
@@ -0,0 +1,12 @@
+-- Create finetune_metrics table with the training metrics the trainer reports every few steps, one row per step
+CREATE TABLE finetune_metrics (
+    finetune_id UUID NOT NULL REFERENCES finetunes(id) ON DELETE CASCADE,
+    step INT NOT NULL,
+    epoch DOUBLE PRECISION NOT NULL DEFAULT 0,
+    train_loss DOUBLE PRECISION,
+    eval_loss DOUBLE PRECISION,
+    learning_rate DOUBLE PRECISION,
+    gpu_memory_gb DOUBLE PRECISION,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (finetune_id, step)
+);
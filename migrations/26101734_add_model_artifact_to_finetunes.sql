@@ -0,0 +1,3 @@
+-- Add the size and SHA-256 checksum of the GGUF, recorded when a finetune is DONE and verified on download
+ALTER TABLE finetunes ADD COLUMN model_file_size_bytes BIGINT;
+ALTER TABLE finetunes ADD COLUMN model_sha256 TEXT;
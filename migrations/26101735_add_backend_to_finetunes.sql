@@ -0,0 +1,3 @@
+-- Finetunes are trained by a pluggable backend, the job ID is no longer specific to Runpod
+ALTER TABLE finetunes RENAME COLUMN runpod_job_id TO backend_job_id;
+ALTER TABLE finetunes ADD COLUMN backend TEXT NOT NULL DEFAULT 'RUNPOD';
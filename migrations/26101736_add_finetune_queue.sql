@@ -0,0 +1,18 @@
+-- Finetunes wait in QUEUED until the scheduler has a free slot for them
+ALTER TABLE finetunes DROP CONSTRAINT finetunes_status_check;
+ALTER TABLE finetunes ADD CONSTRAINT finetunes_status_check
+    CHECK (status IN ('QUEUED', 'PLANNING', 'RUNNING', 'ABORTED', 'FAILED', 'DONE', 'DELETED'));
+ALTER TABLE finetunes ADD COLUMN priority TEXT NOT NULL DEFAULT 'NORMAL';
+
+CREATE INDEX idx_finetunes_scheduled ON finetunes(status) WHERE status IN ('QUEUED', 'PLANNING', 'RUNNING');
+
+-- Create finetune_dispatch_events table with the history of a finetune from the queue to its backend
+CREATE TABLE finetune_dispatch_events (
+    id UUID PRIMARY KEY,
+    finetune_id UUID NOT NULL REFERENCES finetunes(id) ON DELETE CASCADE,
+    type VARCHAR(50) NOT NULL,
+    message TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_finetune_dispatch_events_finetune ON finetune_dispatch_events(finetune_id, created_at);
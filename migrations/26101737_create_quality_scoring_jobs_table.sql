@@ -0,0 +1,24 @@
+-- Create quality_scoring_jobs table with the runs of the LLM judge over a training dataset
+CREATE TABLE quality_scoring_jobs (
+    id UUID PRIMARY KEY,
+    training_dataset_id UUID NOT NULL REFERENCES training_datasets(id) ON DELETE CASCADE,
+    status VARCHAR(50) NOT NULL DEFAULT 'PENDING',
+    model TEXT NOT NULL,
+    rubric TEXT NOT NULL,
+    rescore BOOLEAN NOT NULL DEFAULT FALSE,
+    items_total INT NOT NULL DEFAULT 0,
+    items_scored INT NOT NULL DEFAULT 0,
+    items_failed INT NOT NULL DEFAULT 0,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    lease_until TIMESTAMP,
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Only one job per training dataset can be waiting or running, a second run would pay the judge twice
+CREATE UNIQUE INDEX idx_quality_scoring_jobs_active ON quality_scoring_jobs(training_dataset_id)
+    WHERE status IN ('PENDING', 'RUNNING');
+CREATE INDEX idx_quality_scoring_jobs_training_dataset ON quality_scoring_jobs(training_dataset_id, created_at);